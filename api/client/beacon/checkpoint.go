@@ -66,23 +66,29 @@ func fname(prefix string, vu *detect.VersionedUnmarshaler, slot primitives.Slot,
 // DownloadFinalizedData downloads the most recently finalized state, and the block most recently applied to that state.
 // This pair can be used to initialize a new beacon node via checkpoint sync.
 func DownloadFinalizedData(ctx context.Context, client *Client) (*OriginData, error) {
-	sb, err := client.GetState(ctx, IdFinalized)
+	return downloadOriginData(ctx, client, IdFinalized)
+}
+
+// downloadOriginData downloads the state identified by stateId, and the block most recently applied to that state.
+func downloadOriginData(ctx context.Context, client *Client, stateId StateOrBlockId) (*OriginData, error) {
+	sb, err := client.GetState(ctx, stateId)
 	if err != nil {
 		return nil, err
 	}
 	vu, err := detect.FromState(sb)
 	if err != nil {
-		return nil, errors.Wrap(err, "error detecting chain config for finalized state")
+		return nil, errors.Wrapf(err, "error detecting chain config for state id = %s", stateId)
 	}
 
 	log.WithFields(logrus.Fields{
-		"name": vu.Config.ConfigName,
-		"fork": version.String(vu.Fork),
-	}).Info("Detected supported config in remote finalized state")
+		"name":  vu.Config.ConfigName,
+		"fork":  version.String(vu.Fork),
+		"state": stateId,
+	}).Info("Detected supported config in remote checkpoint state")
 
 	s, err := vu.UnmarshalBeaconState(sb)
 	if err != nil {
-		return nil, errors.Wrap(err, "error unmarshaling finalized state to correct version")
+		return nil, errors.Wrapf(err, "error unmarshaling state id = %s to correct version", stateId)
 	}
 
 	slot := s.LatestBlockHeader().Slot
@@ -109,7 +115,7 @@ func DownloadFinalizedData(ctx context.Context, client *Client) (*OriginData, er
 	}
 	sr, err := s.HashTreeRoot(ctx)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to compute htr for finalized state at slot=%d", s.Slot())
+		return nil, errors.Wrapf(err, "failed to compute htr for checkpoint state at slot=%d", s.Slot())
 	}
 
 	log.
@@ -26,15 +26,17 @@ import (
 )
 
 const (
-	getSignedBlockPath       = "/eth/v2/beacon/blocks"
-	getBlockRootPath         = "/eth/v1/beacon/blocks/{{.Id}}/root"
-	getForkForStatePath      = "/eth/v1/beacon/states/{{.Id}}/fork"
-	getWeakSubjectivityPath  = "/prysm/v1/beacon/weak_subjectivity"
-	getForkSchedulePath      = "/eth/v1/config/fork_schedule"
-	getConfigSpecPath        = "/eth/v1/config/spec"
-	getStatePath             = "/eth/v2/debug/beacon/states"
-	getNodeVersionPath       = "/eth/v1/node/version"
-	changeBLStoExecutionPath = "/eth/v1/beacon/pool/bls_to_execution_changes"
+	getSignedBlockPath         = "/eth/v2/beacon/blocks"
+	getBlockRootPath           = "/eth/v1/beacon/blocks/{{.Id}}/root"
+	getForkForStatePath        = "/eth/v1/beacon/states/{{.Id}}/fork"
+	getFinalityCheckpointsPath = "/eth/v1/beacon/states/{{.Id}}/finality_checkpoints"
+	getWeakSubjectivityPath    = "/prysm/v1/beacon/weak_subjectivity"
+	getForkSchedulePath        = "/eth/v1/config/fork_schedule"
+	getConfigSpecPath          = "/eth/v1/config/spec"
+	getStatePath               = "/eth/v2/debug/beacon/states"
+	getNodeVersionPath         = "/eth/v1/node/version"
+	changeBLStoExecutionPath   = "/eth/v1/beacon/pool/bls_to_execution_changes"
+	getForkChoiceDOTPath       = "/prysm/v1/debug/fork_choice/dot"
 )
 
 // StateOrBlockId represents the block_id / state_id parameters that several of the Eth Beacon API methods accept.
@@ -157,6 +159,25 @@ func (c *Client) GetFork(ctx context.Context, stateId StateOrBlockId) (*ethpb.Fo
 	return fr.ToConsensus()
 }
 
+var getFinalityCheckpointsTpl = idTemplate(getFinalityCheckpointsPath)
+
+// GetFinalizedCheckpoint queries the Beacon Node API for the finalized checkpoint recorded in the state identified by
+// stateId.
+func (c *Client) GetFinalizedCheckpoint(ctx context.Context, stateId StateOrBlockId) (*ethpb.Checkpoint, error) {
+	body, err := c.Get(ctx, getFinalityCheckpointsTpl(stateId))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting finality checkpoints by state id = %s", stateId)
+	}
+	fr := &structs.GetFinalityCheckpointsResponse{}
+	if err := json.Unmarshal(body, fr); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetFinalizedCheckpoint")
+	}
+	if fr.Data == nil || fr.Data.Finalized == nil {
+		return nil, errors.New("finality checkpoints response does not contain a finalized checkpoint")
+	}
+	return fr.Data.Finalized.ToConsensus()
+}
+
 // GetForkSchedule retrieve all forks, past present and future, of which this node is aware.
 func (c *Client) GetForkSchedule(ctx context.Context) (forks.OrderedSchedule, error) {
 	body, err := c.Get(ctx, getForkSchedulePath)
@@ -246,6 +267,16 @@ func (c *Client) GetState(ctx context.Context, stateId StateOrBlockId) ([]byte,
 	return b, nil
 }
 
+// GetForkChoiceDOT calls an API endpoint that is unique to prysm, which renders the current fork choice tree of the
+// beacon node as a graph in the DOT language of Graphviz.
+func (c *Client) GetForkChoiceDOT(ctx context.Context) ([]byte, error) {
+	b, err := c.Get(ctx, getForkChoiceDOTPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting fork choice graph")
+	}
+	return b, nil
+}
+
 // GetWeakSubjectivity calls a proposed API endpoint that is unique to prysm
 // This api method does the following:
 // - computes weak subjectivity epoch
@@ -0,0 +1,189 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// ErrCheckpointQuorum is returned when not enough checkpoint sync providers agree on the finalized checkpoint.
+	ErrCheckpointQuorum = errors.New("checkpoint sync providers did not reach quorum on the finalized checkpoint")
+	errInvalidQuorum    = errors.New("invalid checkpoint sync quorum")
+)
+
+// FinalizedCheckpoint is the finalized checkpoint reported by a checkpoint sync provider.
+type FinalizedCheckpoint struct {
+	Epoch primitives.Epoch
+	Root  [32]byte
+}
+
+func (c FinalizedCheckpoint) String() string {
+	return fmt.Sprintf("%#x:%d", c.Root, c.Epoch)
+}
+
+// ProviderCheckpoint is the response of a checkpoint sync provider to a finalized checkpoint request. Err is set if the
+// provider could not be queried, in which case Checkpoint is the zero value.
+type ProviderCheckpoint struct {
+	Provider   string
+	Checkpoint FinalizedCheckpoint
+	Err        error
+}
+
+func (p *ProviderCheckpoint) String() string {
+	if p.Err != nil {
+		return fmt.Sprintf("%s: error: %v", p.Provider, p.Err)
+	}
+	return fmt.Sprintf("%s: %s", p.Provider, p.Checkpoint)
+}
+
+// QuorumResult is the outcome of polling checkpoint sync providers for their finalized checkpoint.
+type QuorumResult struct {
+	// Checkpoint is the finalized checkpoint reported by the most providers.
+	Checkpoint FinalizedCheckpoint
+	// Agreeing holds the indices of the providers which reported Checkpoint.
+	Agreeing []int
+	// Responses holds the response of every provider, in the order the providers were given.
+	Responses []*ProviderCheckpoint
+}
+
+// Disagreeing returns the responses of the providers which failed to respond or reported a different checkpoint.
+func (r *QuorumResult) Disagreeing() []*ProviderCheckpoint {
+	agreeing := make(map[int]bool, len(r.Agreeing))
+	for _, i := range r.Agreeing {
+		agreeing[i] = true
+	}
+	var d []*ProviderCheckpoint
+	for i, resp := range r.Responses {
+		if !agreeing[i] {
+			d = append(d, resp)
+		}
+	}
+	return d
+}
+
+// FinalizedCheckpointQuorum asks every client for the finalized checkpoint of its head state, and returns the checkpoint
+// reported by at least quorum of them. ErrCheckpointQuorum is returned, along with the responses of all providers, when
+// no checkpoint is reported by quorum providers, or when two checkpoints are reported by the same number of providers.
+func FinalizedCheckpointQuorum(ctx context.Context, clients []*Client, quorum int) (*QuorumResult, error) {
+	if quorum < 1 || quorum > len(clients) {
+		return nil, errors.Wrapf(errInvalidQuorum, "quorum of %d with %d providers", quorum, len(clients))
+	}
+	responses := make([]*ProviderCheckpoint, len(clients))
+	var wg sync.WaitGroup
+	for i, c := range clients {
+		wg.Add(1)
+		go func(i int, c *Client) {
+			defer wg.Done()
+			resp := &ProviderCheckpoint{Provider: c.NodeURL()}
+			cp, err := c.GetFinalizedCheckpoint(ctx, IdHead)
+			if err != nil {
+				resp.Err = err
+			} else {
+				resp.Checkpoint = FinalizedCheckpoint{Epoch: cp.Epoch, Root: bytesutil.ToBytes32(cp.Root)}
+			}
+			responses[i] = resp
+		}(i, c)
+	}
+	wg.Wait()
+
+	votes := make(map[FinalizedCheckpoint][]int)
+	for i, resp := range responses {
+		if resp.Err == nil {
+			votes[resp.Checkpoint] = append(votes[resp.Checkpoint], i)
+		}
+	}
+	result := &QuorumResult{Responses: responses}
+	tied := false
+	for cp, agreeing := range votes {
+		switch {
+		case len(agreeing) > len(result.Agreeing):
+			result.Checkpoint, result.Agreeing, tied = cp, agreeing, false
+		case len(agreeing) == len(result.Agreeing):
+			tied = true
+		}
+	}
+	if tied || len(result.Agreeing) < quorum {
+		return result, errors.Wrapf(ErrCheckpointQuorum, "%d of %d providers required to agree, responses: %s",
+			quorum, len(clients), responsesString(responses))
+	}
+	return result, nil
+}
+
+func responsesString(responses []*ProviderCheckpoint) string {
+	s := make([]string, len(responses))
+	for i, resp := range responses {
+		s[i] = resp.String()
+	}
+	return strings.Join(s, "; ")
+}
+
+// DownloadFinalizedDataWithQuorum downloads the finalized state and block like DownloadFinalizedData, after checking
+// that at least quorum of the given checkpoint sync providers agree on the finalized checkpoint. The data is downloaded
+// from one of the agreeing providers, falling back to the next one on failure, and its block root is verified against
+// the checkpoint the providers agreed on. Providers which disagree are logged in detail.
+func DownloadFinalizedDataWithQuorum(ctx context.Context, clients []*Client, quorum int) (*OriginData, error) {
+	result, err := FinalizedCheckpointQuorum(ctx, clients, quorum)
+	if err != nil {
+		return nil, err
+	}
+	cp := result.Checkpoint
+	log.WithFields(logrus.Fields{
+		"epoch":     cp.Epoch,
+		"root":      fmt.Sprintf("%#x", cp.Root),
+		"agreeing":  len(result.Agreeing),
+		"providers": len(clients),
+		"quorum":    quorum,
+	}).Info("Checkpoint sync providers reached quorum on finalized checkpoint")
+	logDisagreements(result)
+
+	slot, err := slots.EpochStart(cp.Epoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error computing first slot of finalized epoch=%d", cp.Epoch)
+	}
+	// The state is requested by slot rather than as "finalized", so that the data matches the agreed checkpoint even if
+	// the provider finalizes a new epoch in the meantime.
+	for _, i := range result.Agreeing {
+		c := clients[i]
+		od, err := downloadOriginData(ctx, c, IdFromSlot(slot))
+		if err != nil {
+			log.WithError(err).WithField("provider", c.NodeURL()).Warn("Could not download checkpoint sync data, trying next provider")
+			continue
+		}
+		if od.br != cp.Root {
+			log.WithFields(logrus.Fields{
+				"provider":      c.NodeURL(),
+				"blockRoot":     fmt.Sprintf("%#x", od.br),
+				"agreedRoot":    fmt.Sprintf("%#x", cp.Root),
+				"finalizedSlot": slot,
+			}).Warn("Downloaded checkpoint sync block does not match the agreed finalized checkpoint, trying next provider")
+			continue
+		}
+		log.WithField("provider", c.NodeURL()).Info("Verified checkpoint sync data against the agreed finalized checkpoint")
+		return od, nil
+	}
+	return nil, errors.Errorf("could not download checkpoint sync data matching finalized checkpoint %s from any of the %d agreeing providers", cp, len(result.Agreeing))
+}
+
+func logDisagreements(result *QuorumResult) {
+	for _, resp := range result.Disagreeing() {
+		l := log.WithField("provider", resp.Provider)
+		if resp.Err != nil {
+			l.WithError(resp.Err).Warn("Could not get finalized checkpoint from checkpoint sync provider")
+			continue
+		}
+		l.WithFields(logrus.Fields{
+			"epoch":       resp.Checkpoint.Epoch,
+			"root":        fmt.Sprintf("%#x", resp.Checkpoint.Root),
+			"quorumEpoch": result.Checkpoint.Epoch,
+			"quorumRoot":  fmt.Sprintf("%#x", result.Checkpoint.Root),
+		}).Warn("Checkpoint sync provider disagrees with the quorum on the finalized checkpoint")
+	}
+}
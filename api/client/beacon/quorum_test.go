@@ -0,0 +1,145 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	blocktest "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+type quorumTestData struct {
+	epoch primitives.Epoch
+	slot  primitives.Slot
+	state []byte
+	block []byte
+	root  [32]byte
+}
+
+func newQuorumTestData(t *testing.T) *quorumTestData {
+	ctx := context.Background()
+	cfg := params.MainnetConfig().Copy()
+	epoch := cfg.AltairForkEpoch - 1
+	slot, err := slots.EpochStart(epoch)
+	require.NoError(t, err)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	fork, err := forkForEpoch(cfg, epoch)
+	require.NoError(t, err)
+	require.NoError(t, st.SetFork(fork))
+	require.NoError(t, st.SetSlot(slot))
+
+	b, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	b, err = blocktest.SetBlockSlot(b, slot)
+	require.NoError(t, err)
+	header, err := b.Header()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(header.Header))
+	sr, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	b, err = blocktest.SetBlockStateRoot(b, sr)
+	require.NoError(t, err)
+	br, err := b.Block().HashTreeRoot()
+	require.NoError(t, err)
+	mb, err := b.MarshalSSZ()
+	require.NoError(t, err)
+	ms, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	return &quorumTestData{epoch: epoch, slot: slot, state: ms, block: mb, root: br}
+}
+
+// quorumTestClient returns a client for a provider which reports the given finalized root, and serves the test data.
+// A provider reporting a nil root fails to respond.
+func quorumTestClient(t *testing.T, host string, d *quorumTestData, root *[32]byte) *Client {
+	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		res := &http.Response{Request: req, StatusCode: http.StatusOK}
+		switch {
+		case root == nil:
+			res.StatusCode = http.StatusInternalServerError
+			res.Body = io.NopCloser(bytes.NewBufferString(""))
+		case req.URL.Path == getFinalityCheckpointsTpl(IdHead):
+			cp := fmt.Sprintf(`{"epoch":"%d","root":"%#x"}`, d.epoch, *root)
+			res.Body = io.NopCloser(bytes.NewBufferString(fmt.Sprintf(`{"data":{"previous_justified":%s,"current_justified":%s,"finalized":%s}}`, cp, cp, cp)))
+		case req.URL.Path == renderGetStatePath(IdFromSlot(d.slot)):
+			res.Body = io.NopCloser(bytes.NewBuffer(d.state))
+		case req.URL.Path == renderGetBlockPath(IdFromSlot(d.slot)):
+			res.Body = io.NopCloser(bytes.NewBuffer(d.block))
+		default:
+			res.StatusCode = http.StatusNotFound
+			res.Body = io.NopCloser(bytes.NewBufferString(""))
+		}
+		return res, nil
+	}}
+	c, err := NewClient(host, client.WithRoundTripper(trans))
+	require.NoError(t, err)
+	return c
+}
+
+func TestFinalizedCheckpointQuorum(t *testing.T) {
+	ctx := context.Background()
+	d := newQuorumTestData(t)
+	other := [32]byte{'o'}
+	clients := []*Client{
+		quorumTestClient(t, "http://a:3500", d, &d.root),
+		quorumTestClient(t, "http://b:3500", d, &other),
+		quorumTestClient(t, "http://c:3500", d, &d.root),
+		quorumTestClient(t, "http://d:3500", d, nil),
+	}
+
+	result, err := FinalizedCheckpointQuorum(ctx, clients, 2)
+	require.NoError(t, err)
+	require.Equal(t, d.root, result.Checkpoint.Root)
+	require.Equal(t, d.epoch, result.Checkpoint.Epoch)
+	require.DeepEqual(t, []int{0, 2}, result.Agreeing)
+	disagreeing := result.Disagreeing()
+	require.Equal(t, 2, len(disagreeing))
+	require.Equal(t, other, disagreeing[0].Checkpoint.Root)
+	require.NotNil(t, disagreeing[1].Err)
+
+	_, err = FinalizedCheckpointQuorum(ctx, clients, 3)
+	require.ErrorIs(t, err, ErrCheckpointQuorum)
+	require.ErrorContains(t, fmt.Sprintf("http://b:3500: %#x:%d", other, d.epoch), err)
+
+	// Two checkpoints reported by the same number of providers are ambiguous.
+	_, err = FinalizedCheckpointQuorum(ctx, []*Client{clients[0], clients[1]}, 1)
+	require.ErrorIs(t, err, ErrCheckpointQuorum)
+
+	_, err = FinalizedCheckpointQuorum(ctx, clients, 5)
+	require.ErrorIs(t, err, errInvalidQuorum)
+	_, err = FinalizedCheckpointQuorum(ctx, clients, 0)
+	require.ErrorIs(t, err, errInvalidQuorum)
+}
+
+func TestDownloadFinalizedDataWithQuorum(t *testing.T) {
+	ctx := context.Background()
+	d := newQuorumTestData(t)
+	other := [32]byte{'o'}
+
+	od, err := DownloadFinalizedDataWithQuorum(ctx, []*Client{
+		quorumTestClient(t, "http://a:3500", d, &d.root),
+		quorumTestClient(t, "http://b:3500", d, &other),
+		quorumTestClient(t, "http://c:3500", d, &d.root),
+	}, 2)
+	require.NoError(t, err)
+	require.Equal(t, d.root, od.br)
+	require.DeepEqual(t, d.state, od.StateBytes())
+	require.DeepEqual(t, d.block, od.BlockBytes())
+
+	// Providers which agree on a checkpoint that does not match the block they serve are rejected.
+	_, err = DownloadFinalizedDataWithQuorum(ctx, []*Client{
+		quorumTestClient(t, "http://a:3500", d, &other),
+		quorumTestClient(t, "http://b:3500", d, &other),
+	}, 2)
+	require.ErrorContains(t, "could not download checkpoint sync data matching finalized checkpoint", err)
+}
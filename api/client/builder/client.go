@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync/atomic"
 	"text/template"
 
 	"github.com/pkg/errors"
@@ -24,6 +25,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	log "github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -31,6 +33,10 @@ const (
 	getStatus                  = "/eth/v1/builder/status"
 	postBlindedBeaconBlockPath = "/eth/v1/builder/blinded_blocks"
 	postRegisterValidatorPath  = "/eth/v1/builder/validators"
+
+	// sszPreferredAcceptHeader asks the relay for an SSZ response, while still accepting JSON from relays that
+	// don't support SSZ.
+	sszPreferredAcceptHeader = api.OctetStreamMediaType + ";q=1.0," + api.JsonMediaType + ";q=0.9"
 )
 
 var errMalformedHostname = errors.New("hostname must include port, separated by one colon, like example.com:3500")
@@ -50,6 +56,14 @@ func WithObserver(m observer) ClientOpt {
 	}
 }
 
+// WithSSZ makes the client send SSZ encoded request bodies and ask for SSZ encoded responses.
+// The client falls back to JSON for relays that don't support SSZ.
+func WithSSZ() ClientOpt {
+	return func(c *Client) {
+		c.sszEnabled = true
+	}
+}
+
 type requestLogger struct{}
 
 func (*requestLogger) observe(r *http.Request) (e error) {
@@ -93,9 +107,13 @@ type BuilderClient interface {
 
 // Client provides a collection of helper methods for calling Builder API endpoints.
 type Client struct {
-	hc      *http.Client
-	baseURL *url.URL
-	obvs    []observer
+	hc         *http.Client
+	baseURL    *url.URL
+	obvs       []observer
+	sszEnabled bool
+	// sszUnsupported is set once the relay rejects an SSZ request that it then accepts as JSON, after which requests
+	// are sent as JSON.
+	sszUnsupported atomic.Bool
 }
 
 // NewClient constructs a new client with the provided options (ex WithTimeout).
@@ -137,7 +155,8 @@ func (c *Client) NodeURL() string {
 type reqOption func(*http.Request)
 
 // do is a generic, opinionated request function to reduce boilerplate amongst the methods in this package api/client/builder.
-func (c *Client) do(ctx context.Context, method string, path string, body io.Reader, opts ...reqOption) (res []byte, err error) {
+// The response headers are returned along with the body so that callers can tell how the body is encoded.
+func (c *Client) do(ctx context.Context, method string, path string, body io.Reader, opts ...reqOption) (res []byte, header http.Header, err error) {
 	ctx, span := trace.StartSpan(ctx, "builder.client.do")
 	defer func() {
 		tracing.AnnotateError(span, err)
@@ -173,7 +192,7 @@ func (c *Client) do(ctx context.Context, method string, path string, body io.Rea
 		}
 	}()
 	if r.StatusCode != http.StatusOK {
-		err = non200Err(r)
+		err = &statusError{code: r.StatusCode, err: non200Err(r)}
 		return
 	}
 	res, err = io.ReadAll(r.Body)
@@ -181,9 +200,41 @@ func (c *Client) do(ctx context.Context, method string, path string, body io.Rea
 		err = errors.Wrap(err, "error reading http response body from builder server")
 		return
 	}
+	header = r.Header
 	return
 }
 
+// statusError is the error of a request that received a non-200 response, along with the status code of the response.
+type statusError struct {
+	code int
+	err  error
+}
+
+func (e *statusError) Error() string {
+	return e.err.Error()
+}
+
+func (e *statusError) Unwrap() error {
+	return e.err
+}
+
+// isClientError reports whether the error is the one of a request that received a 4xx response. Relays that don't
+// support SSZ reject SSZ requests with various 4xx codes, so these requests are retried with JSON.
+func isClientError(err error) bool {
+	var e *statusError
+	return errors.As(err, &e) && e.code >= 400 && e.code < 500
+}
+
+// sendSSZ reports whether request bodies should be SSZ encoded.
+func (c *Client) sendSSZ() bool {
+	return c.sszEnabled && !c.sszUnsupported.Load()
+}
+
+// isSSZResponse reports whether the response body is SSZ encoded, based on its Content-Type header.
+func isSSZResponse(header http.Header) bool {
+	return strings.HasPrefix(header.Get("Content-Type"), api.OctetStreamMediaType)
+}
+
 var execHeaderTemplate = template.Must(template.New("").Parse(getExecHeaderPath))
 
 func execHeaderPath(slot primitives.Slot, parentHash [32]byte, pubkey [48]byte) (string, error) {
@@ -205,15 +256,34 @@ func execHeaderPath(slot primitives.Slot, parentHash [32]byte, pubkey [48]byte)
 }
 
 // GetHeader is used by a proposing validator to request an execution payload header from the Builder node.
+// When SSZ is enabled the header is preferably requested as SSZ, retrying with JSON if the relay rejects the request
+// with a 4xx response.
 func (c *Client) GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubkey [48]byte) (SignedBid, error) {
 	path, err := execHeaderPath(slot, parentHash, pubkey)
 	if err != nil {
 		return nil, err
 	}
-	hb, err := c.do(ctx, http.MethodGet, path, nil)
+	var hb []byte
+	var header http.Header
+	if c.sendSSZ() {
+		hb, header, err = c.do(ctx, http.MethodGet, path, nil, func(r *http.Request) {
+			r.Header.Set("Accept", sszPreferredAcceptHeader)
+		})
+		if isClientError(err) {
+			hb, header, err = c.do(ctx, http.MethodGet, path, nil)
+			if err == nil {
+				c.disableSSZ()
+			}
+		}
+	} else {
+		hb, header, err = c.do(ctx, http.MethodGet, path, nil)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if isSSZResponse(header) {
+		return parseHeaderSSZ(hb, header.Get(api.VersionHeader))
+	}
 	v := &VersionResponse{}
 	if err := json.Unmarshal(hb, v); err != nil {
 		return nil, errors.Wrapf(err, "error unmarshaling the builder GetHeader response, using slot=%d, parentHash=%#x, pubkey=%#x", slot, parentHash, pubkey)
@@ -254,8 +324,37 @@ func (c *Client) GetHeader(ctx context.Context, slot primitives.Slot, parentHash
 	}
 }
 
+// parseHeaderSSZ decodes an SSZ encoded GetHeader response, using the fork version sent by the relay in the
+// Eth-Consensus-Version header.
+func parseHeaderSSZ(hb []byte, ver string) (SignedBid, error) {
+	switch strings.ToLower(ver) {
+	case version.String(version.Deneb):
+		p := &ethpb.SignedBuilderBidDeneb{}
+		if err := p.UnmarshalSSZ(hb); err != nil {
+			return nil, errors.Wrap(err, "error unmarshaling the builder GetHeader SSZ response")
+		}
+		return WrappedSignedBuilderBidDeneb(p)
+	case version.String(version.Capella):
+		p := &ethpb.SignedBuilderBidCapella{}
+		if err := p.UnmarshalSSZ(hb); err != nil {
+			return nil, errors.Wrap(err, "error unmarshaling the builder GetHeader SSZ response")
+		}
+		return WrappedSignedBuilderBidCapella(p)
+	case version.String(version.Bellatrix):
+		p := &ethpb.SignedBuilderBid{}
+		if err := p.UnmarshalSSZ(hb); err != nil {
+			return nil, errors.Wrap(err, "error unmarshaling the builder GetHeader SSZ response")
+		}
+		return WrappedSignedBuilderBid(p)
+	default:
+		return nil, fmt.Errorf("unsupported header version %s", strings.ToLower(ver))
+	}
+}
+
 // RegisterValidator encodes the SignedValidatorRegistrationV1 message to json (including hex-encoding the byte
 // fields with 0x prefixes) and posts to the builder validator registration endpoint.
+// When SSZ is enabled the registrations are sent as an SSZ list instead, falling back to json if the relay
+// rejects the SSZ body with a 4xx response.
 func (c *Client) RegisterValidator(ctx context.Context, svr []*ethpb.SignedValidatorRegistrationV1) error {
 	ctx, span := trace.StartSpan(ctx, "builder.client.RegisterValidator")
 	defer span.End()
@@ -266,6 +365,15 @@ func (c *Client) RegisterValidator(ctx context.Context, svr []*ethpb.SignedValid
 		tracing.AnnotateError(span, err)
 		return err
 	}
+	sszRejected := false
+	if c.sendSSZ() {
+		err := c.registerValidatorSSZ(ctx, svr)
+		if !isClientError(err) {
+			tracing.AnnotateError(span, err)
+			return err
+		}
+		sszRejected = true
+	}
 	vs := make([]*structs.SignedValidatorRegistration, len(svr))
 	for i := 0; i < len(svr); i++ {
 		vs[i] = structs.SignedValidatorRegistrationFromConsensus(svr[i])
@@ -277,10 +385,38 @@ func (c *Client) RegisterValidator(ctx context.Context, svr []*ethpb.SignedValid
 		return err
 	}
 
-	_, err = c.do(ctx, http.MethodPost, postRegisterValidatorPath, bytes.NewBuffer(body))
+	_, _, err = c.do(ctx, http.MethodPost, postRegisterValidatorPath, bytes.NewBuffer(body), func(r *http.Request) {
+		r.Header.Set("Content-Type", api.JsonMediaType)
+	})
+	if err == nil && sszRejected {
+		c.disableSSZ()
+	}
 	return err
 }
 
+func (c *Client) registerValidatorSSZ(ctx context.Context, svr []*ethpb.SignedValidatorRegistrationV1) error {
+	// The registrations are fixed size, so the SSZ list is simply their concatenation.
+	body := make([]byte, 0, len(svr)*(&ethpb.SignedValidatorRegistrationV1{}).SizeSSZ())
+	for _, r := range svr {
+		var err error
+		body, err = r.MarshalSSZTo(body)
+		if err != nil {
+			return errors.Wrap(err, "error encoding the SignedValidatorRegistration value body in RegisterValidator")
+		}
+	}
+	_, _, err := c.do(ctx, http.MethodPost, postRegisterValidatorPath, bytes.NewBuffer(body), func(r *http.Request) {
+		r.Header.Set("Content-Type", api.OctetStreamMediaType)
+	})
+	return err
+}
+
+// disableSSZ stops the client from sending SSZ requests to a relay that doesn't accept them.
+func (c *Client) disableSSZ() {
+	if c.sszUnsupported.CompareAndSwap(false, true) {
+		log.WithField("url", c.NodeURL()).Info("Builder relay does not accept SSZ requests, falling back to JSON")
+	}
+}
+
 var errResponseVersionMismatch = errors.New("builder API response uses a different version than requested in " + api.VersionHeader + " header")
 
 // SubmitBlindedBlock calls the builder API endpoint that binds the validator to the builder and submits the block.
@@ -289,6 +425,14 @@ func (c *Client) SubmitBlindedBlock(ctx context.Context, sb interfaces.ReadOnlyS
 	if !sb.IsBlinded() {
 		return nil, nil, errNotBlinded
 	}
+	sszRejected := false
+	if c.sendSSZ() {
+		ed, bb, err := c.submitBlindedBlockSSZ(ctx, sb)
+		if !isClientError(err) {
+			return ed, bb, err
+		}
+		sszRejected = true
+	}
 
 	// massage the proto struct type data into the api response type.
 	mj, err := structs.SignedBeaconBlockMessageJsoner(sb)
@@ -307,18 +451,82 @@ func (c *Client) SubmitBlindedBlock(ctx context.Context, sb interfaces.ReadOnlyS
 	}
 	// post the blinded block - the execution payload response should contain the unblinded payload, along with the
 	// blobs bundle if it is post deneb.
-	rb, err := c.do(ctx, http.MethodPost, postBlindedBeaconBlockPath, bytes.NewBuffer(body), postOpts)
+	rb, _, err := c.do(ctx, http.MethodPost, postBlindedBeaconBlockPath, bytes.NewBuffer(body), postOpts)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "error posting the blinded block to the builder api")
 	}
+	if sszRejected {
+		c.disableSSZ()
+	}
+	return parseExecutionPayloadResponse(rb, sb.Version())
+}
+
+func (c *Client) submitBlindedBlockSSZ(ctx context.Context, sb interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	body, err := sb.MarshalSSZ()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error marshaling blinded block post request to ssz")
+	}
+	postOpts := func(r *http.Request) {
+		r.Header.Add(api.VersionHeader, version.String(sb.Version()))
+		r.Header.Set("Content-Type", api.OctetStreamMediaType)
+		r.Header.Set("Accept", sszPreferredAcceptHeader)
+	}
+	rb, header, err := c.do(ctx, http.MethodPost, postBlindedBeaconBlockPath, bytes.NewBuffer(body), postOpts)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error posting the blinded block to the builder api")
+	}
+	if !isSSZResponse(header) {
+		return parseExecutionPayloadResponse(rb, sb.Version())
+	}
+	if v := header.Get(api.VersionHeader); v != "" && strings.ToLower(v) != version.String(sb.Version()) {
+		return nil, nil, errors.Wrapf(errResponseVersionMismatch, "req=%s, recv=%s", version.String(sb.Version()), strings.ToLower(v))
+	}
+	return parseExecutionPayloadSSZ(rb, sb.Version())
+}
+
+// parseExecutionPayloadSSZ decodes an SSZ encoded SubmitBlindedBlock response for a block of the given version.
+func parseExecutionPayloadSSZ(rb []byte, ver int) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	var pb proto.Message
+	var bundle *v1.BlobsBundle
+	switch ver {
+	case version.Bellatrix:
+		p := &v1.ExecutionPayload{}
+		if err := p.UnmarshalSSZ(rb); err != nil {
+			return nil, nil, errors.Wrap(err, "error unmarshaling the builder ExecutionPayload SSZ response")
+		}
+		pb = p
+	case version.Capella:
+		p := &v1.ExecutionPayloadCapella{}
+		if err := p.UnmarshalSSZ(rb); err != nil {
+			return nil, nil, errors.Wrap(err, "error unmarshaling the builder ExecutionPayloadCapella SSZ response")
+		}
+		pb = p
+	case version.Deneb:
+		p := &v1.ExecutionPayloadDenebAndBlobsBundle{}
+		if err := p.UnmarshalSSZ(rb); err != nil {
+			return nil, nil, errors.Wrap(err, "error unmarshaling the builder ExecutionPayloadDenebAndBlobsBundle SSZ response")
+		}
+		pb, bundle = p.Payload, p.BlobsBundle
+	default:
+		return nil, nil, fmt.Errorf("unsupported execution payload version %s", version.String(ver))
+	}
+	ed, err := blocks.NewWrappedExecutionData(pb, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ed, bundle, nil
+}
+
+// parseExecutionPayloadResponse decodes a json SubmitBlindedBlock response for a block of the given version.
+func parseExecutionPayloadResponse(rb []byte, ver int) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
 	// ExecutionPayloadResponse parses just the outer container and the Value key, enabling it to use the .Value
 	// key to determine which underlying data type to use to finish the unmarshaling.
 	ep := &ExecutionPayloadResponse{}
 	if err := json.Unmarshal(rb, ep); err != nil {
 		return nil, nil, errors.Wrap(err, "error unmarshaling the builder ExecutionPayloadResponse")
 	}
-	if strings.ToLower(ep.Version) != version.String(sb.Version()) {
-		return nil, nil, errors.Wrapf(errResponseVersionMismatch, "req=%s, recv=%s", strings.ToLower(ep.Version), version.String(sb.Version()))
+	if strings.ToLower(ep.Version) != version.String(ver) {
+		return nil, nil, errors.Wrapf(errResponseVersionMismatch, "req=%s, recv=%s", strings.ToLower(ep.Version), version.String(ver))
 	}
 	// This parses the rest of the response and returns the inner data field.
 	pp, err := ep.ParsePayload()
@@ -349,7 +557,7 @@ func (c *Client) SubmitBlindedBlock(ctx context.Context, sb interfaces.ReadOnlyS
 // response, and an error response may have an error message. This method will return a nil value for error in the
 // happy path, and an error with information about the server response body for a non-200 response.
 func (c *Client) Status(ctx context.Context) error {
-	_, err := c.do(ctx, http.MethodGet, getStatus, nil)
+	_, _, err := c.do(ctx, http.MethodGet, getStatus, nil)
 	return err
 }
 
@@ -379,6 +587,9 @@ func non200Err(response *http.Response) error {
 			return errors.Wrap(jsonErr, "unable to read response body")
 		}
 		return errors.Wrap(ErrNotFound, errMessage.Message)
+	case http.StatusUnsupportedMediaType:
+		log.WithError(ErrUnsupportedMediaType).Debug(msg)
+		return ErrUnsupportedMediaType
 	case http.StatusInternalServerError:
 		log.WithError(ErrNotOK).Debug(msg)
 		if jsonErr := json.Unmarshal(bodyBytes, &errMessage); jsonErr != nil {
@@ -13,6 +13,7 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
@@ -20,8 +21,10 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -121,6 +124,153 @@ func TestClient_RegisterValidator(t *testing.T) {
 	require.NoError(t, c.RegisterValidator(ctx, []*eth.SignedValidatorRegistrationV1{reg}))
 }
 
+func TestClient_RegisterValidator_SSZ(t *testing.T) {
+	ctx := context.Background()
+	expectedPath := "/eth/v1/builder/validators"
+	regs := []*eth.SignedValidatorRegistrationV1{
+		{
+			Message: &eth.ValidatorRegistrationV1{
+				FeeRecipient: ezDecode(t, params.BeaconConfig().EthBurnAddressHex),
+				GasLimit:     23,
+				Timestamp:    42,
+				Pubkey:       ezDecode(t, "0x93247f2209abcacf57b75a51dafae777f9dd38bc7053d1af526f220a7489a6d3a2753e5f3e8b1cfe39b56f43611df74a"),
+			},
+			Signature: ezDecode(t, "0x1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505cc411d61252fb6cb3fa0017b679f8bb2305b26a285fa2737f175668d0dff91cc1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505"),
+		},
+		{
+			Message: &eth.ValidatorRegistrationV1{
+				FeeRecipient: ezDecode(t, params.BeaconConfig().EthBurnAddressHex),
+				GasLimit:     24,
+				Timestamp:    43,
+				Pubkey:       ezDecode(t, "0x93247f2209abcacf57b75a51dafae777f9dd38bc7053d1af526f220a7489a6d3a2753e5f3e8b1cfe39b56f43611df74a"),
+			},
+			Signature: ezDecode(t, "0x1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505cc411d61252fb6cb3fa0017b679f8bb2305b26a285fa2737f175668d0dff91cc1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505"),
+		},
+	}
+	var expectedBody []byte
+	for _, r := range regs {
+		b, err := r.MarshalSSZ()
+		require.NoError(t, err)
+		expectedBody = append(expectedBody, b...)
+	}
+
+	t.Run("ssz", func(t *testing.T) {
+		hc := &http.Client{
+			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.Equal(t, expectedPath, r.URL.Path)
+				require.Equal(t, api.OctetStreamMediaType, r.Header.Get("Content-Type"))
+				require.DeepEqual(t, expectedBody, body)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBuffer(nil)),
+					Request:    r.Clone(ctx),
+				}, nil
+			}),
+		}
+		c := &Client{
+			hc:         hc,
+			baseURL:    &url.URL{Host: "localhost:3500", Scheme: "http"},
+			sszEnabled: true,
+		}
+		require.NoError(t, c.RegisterValidator(ctx, regs))
+	})
+	t.Run("falls back to json", func(t *testing.T) {
+		sszRequests, jsonRequests := 0, 0
+		hc := &http.Client{
+			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+				require.Equal(t, expectedPath, r.URL.Path)
+				if r.Header.Get("Content-Type") == api.OctetStreamMediaType {
+					sszRequests++
+					return &http.Response{
+						StatusCode: http.StatusUnsupportedMediaType,
+						Body:       io.NopCloser(bytes.NewBuffer(nil)),
+						Request:    r.Clone(ctx),
+					}, nil
+				}
+				jsonRequests++
+				var vs []*structs.SignedValidatorRegistration
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&vs))
+				require.Equal(t, len(regs), len(vs))
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBuffer(nil)),
+					Request:    r.Clone(ctx),
+				}, nil
+			}),
+		}
+		c := &Client{
+			hc:         hc,
+			baseURL:    &url.URL{Host: "localhost:3500", Scheme: "http"},
+			sszEnabled: true,
+		}
+		require.NoError(t, c.RegisterValidator(ctx, regs))
+		require.Equal(t, 1, sszRequests)
+		require.Equal(t, 1, jsonRequests)
+		// The relay is remembered as not supporting SSZ.
+		require.NoError(t, c.RegisterValidator(ctx, regs))
+		require.Equal(t, 1, sszRequests)
+		require.Equal(t, 2, jsonRequests)
+	})
+	t.Run("ssz rejected with 400, falls back to json", func(t *testing.T) {
+		sszRequests, jsonRequests := 0, 0
+		hc := &http.Client{
+			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+				require.Equal(t, expectedPath, r.URL.Path)
+				if r.Header.Get("Content-Type") == api.OctetStreamMediaType {
+					sszRequests++
+					return &http.Response{
+						StatusCode: http.StatusBadRequest,
+						Body:       io.NopCloser(bytes.NewBufferString("invalid JSON")),
+						Request:    r.Clone(ctx),
+					}, nil
+				}
+				jsonRequests++
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBuffer(nil)),
+					Request:    r.Clone(ctx),
+				}, nil
+			}),
+		}
+		c := &Client{
+			hc:         hc,
+			baseURL:    &url.URL{Host: "localhost:3500", Scheme: "http"},
+			sszEnabled: true,
+		}
+		require.NoError(t, c.RegisterValidator(ctx, regs))
+		require.Equal(t, 1, sszRequests)
+		require.Equal(t, 1, jsonRequests)
+		require.Equal(t, true, c.sszUnsupported.Load())
+	})
+	t.Run("json rejected too, keeps ssz", func(t *testing.T) {
+		hc := &http.Client{
+			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+				message := ErrorMessage{
+					Code:    400,
+					Message: "Invalid registration",
+				}
+				resp, err := json.Marshal(message)
+				require.NoError(t, err)
+				return &http.Response{
+					StatusCode: http.StatusBadRequest,
+					Body:       io.NopCloser(bytes.NewBuffer(resp)),
+					Request:    r.Clone(ctx),
+				}, nil
+			}),
+		}
+		c := &Client{
+			hc:         hc,
+			baseURL:    &url.URL{Host: "localhost:3500", Scheme: "http"},
+			sszEnabled: true,
+		}
+		err := c.RegisterValidator(ctx, regs)
+		require.ErrorIs(t, err, ErrBadRequest)
+		require.Equal(t, false, c.sszUnsupported.Load())
+	})
+}
+
 func TestClient_GetHeader(t *testing.T) {
 	ctx := context.Background()
 	expectedPath := "/eth/v1/builder/header/23/0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2/0x93247f2209abcacf57b75a51dafae777f9dd38bc7053d1af526f220a7489a6d3a2753e5f3e8b1cfe39b56f43611df74a"
@@ -293,6 +443,104 @@ func TestClient_GetHeader(t *testing.T) {
 		_, err := c.GetHeader(ctx, slot, bytesutil.ToBytes32(parentHash), bytesutil.ToBytes48(pubkey))
 		require.ErrorContains(t, "could not extract proto message from header: too many blob commitments: 7", err)
 	})
+	t.Run("deneb ssz", func(t *testing.T) {
+		hr := &ExecHeaderResponseDeneb{}
+		require.NoError(t, json.Unmarshal([]byte(testExampleHeaderResponseDeneb), hr))
+		expected, err := hr.ToProto()
+		require.NoError(t, err)
+		sszBid, err := expected.MarshalSSZ()
+		require.NoError(t, err)
+		hc := &http.Client{
+			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+				require.Equal(t, expectedPath, r.URL.Path)
+				require.Equal(t, sszPreferredAcceptHeader, r.Header.Get("Accept"))
+				header := http.Header{}
+				header.Set("Content-Type", api.OctetStreamMediaType)
+				header.Set(api.VersionHeader, "deneb")
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     header,
+					Body:       io.NopCloser(bytes.NewBuffer(sszBid)),
+					Request:    r.Clone(ctx),
+				}, nil
+			}),
+		}
+		c := &Client{
+			hc:         hc,
+			baseURL:    &url.URL{Host: "localhost:3500", Scheme: "http"},
+			sszEnabled: true,
+		}
+		h, err := c.GetHeader(ctx, slot, bytesutil.ToBytes32(parentHash), bytesutil.ToBytes48(pubkey))
+		require.NoError(t, err)
+		require.DeepEqual(t, expected.Signature, h.Signature())
+		bid, err := h.Message()
+		require.NoError(t, err)
+		require.DeepEqual(t, expected.Message.Value, bid.Value())
+		kzgCommitments, err := bid.BlobKzgCommitments()
+		require.NoError(t, err)
+		require.DeepEqual(t, expected.Message.BlobKzgCommitments, kzgCommitments)
+	})
+	t.Run("json response to ssz request", func(t *testing.T) {
+		hc := &http.Client{
+			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+				require.Equal(t, expectedPath, r.URL.Path)
+				require.Equal(t, sszPreferredAcceptHeader, r.Header.Get("Accept"))
+				header := http.Header{}
+				header.Set("Content-Type", api.JsonMediaType)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     header,
+					Body:       io.NopCloser(bytes.NewBufferString(testExampleHeaderResponseCapella)),
+					Request:    r.Clone(ctx),
+				}, nil
+			}),
+		}
+		c := &Client{
+			hc:         hc,
+			baseURL:    &url.URL{Host: "localhost:3500", Scheme: "http"},
+			sszEnabled: true,
+		}
+		h, err := c.GetHeader(ctx, slot, bytesutil.ToBytes32(parentHash), bytesutil.ToBytes48(pubkey))
+		require.NoError(t, err)
+		require.Equal(t, version.Capella, h.Version())
+	})
+	t.Run("ssz request rejected with 400, falls back to json", func(t *testing.T) {
+		sszRequests, jsonRequests := 0, 0
+		hc := &http.Client{
+			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+				require.Equal(t, expectedPath, r.URL.Path)
+				if r.Header.Get("Accept") == sszPreferredAcceptHeader {
+					sszRequests++
+					return &http.Response{
+						StatusCode: http.StatusBadRequest,
+						Body:       io.NopCloser(bytes.NewBufferString("unsupported accept header")),
+						Request:    r.Clone(ctx),
+					}, nil
+				}
+				jsonRequests++
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(testExampleHeaderResponseCapella)),
+					Request:    r.Clone(ctx),
+				}, nil
+			}),
+		}
+		c := &Client{
+			hc:         hc,
+			baseURL:    &url.URL{Host: "localhost:3500", Scheme: "http"},
+			sszEnabled: true,
+		}
+		h, err := c.GetHeader(ctx, slot, bytesutil.ToBytes32(parentHash), bytesutil.ToBytes48(pubkey))
+		require.NoError(t, err)
+		require.Equal(t, version.Capella, h.Version())
+		require.Equal(t, 1, sszRequests)
+		require.Equal(t, 1, jsonRequests)
+		// The relay is remembered as not supporting SSZ.
+		_, err = c.GetHeader(ctx, slot, bytesutil.ToBytes32(parentHash), bytesutil.ToBytes48(pubkey))
+		require.NoError(t, err)
+		require.Equal(t, 1, sszRequests)
+		require.Equal(t, 2, jsonRequests)
+	})
 	t.Run("unsupported version", func(t *testing.T) {
 		hc := &http.Client{
 			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
@@ -434,6 +682,118 @@ func TestSubmitBlindedBlock(t *testing.T) {
 		_, _, err = c.SubmitBlindedBlock(ctx, sbbb)
 		require.ErrorIs(t, err, errResponseVersionMismatch)
 	})
+	t.Run("deneb ssz", func(t *testing.T) {
+		test := util.NewBlindedBeaconBlockDeneb()
+		expectedBody, err := test.MarshalSSZ()
+		require.NoError(t, err)
+		ep := &ExecutionPayloadResponse{}
+		require.NoError(t, json.Unmarshal([]byte(testExampleExecutionPayloadDeneb), ep))
+		pp, err := ep.ParsePayload()
+		require.NoError(t, err)
+		payload, err := pp.PayloadProto()
+		require.NoError(t, err)
+		bundle, err := pp.(BlobBundler).BundleProto()
+		require.NoError(t, err)
+		resp, err := (&v1.ExecutionPayloadDenebAndBlobsBundle{Payload: payload.(*v1.ExecutionPayloadDeneb), BlobsBundle: bundle}).MarshalSSZ()
+		require.NoError(t, err)
+		hc := &http.Client{
+			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+				require.Equal(t, postBlindedBeaconBlockPath, r.URL.Path)
+				require.Equal(t, "deneb", r.Header.Get(api.VersionHeader))
+				require.Equal(t, api.OctetStreamMediaType, r.Header.Get("Content-Type"))
+				require.Equal(t, sszPreferredAcceptHeader, r.Header.Get("Accept"))
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.DeepEqual(t, expectedBody, body)
+				header := http.Header{}
+				header.Set("Content-Type", api.OctetStreamMediaType)
+				header.Set(api.VersionHeader, "deneb")
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     header,
+					Body:       io.NopCloser(bytes.NewBuffer(resp)),
+					Request:    r.Clone(ctx),
+				}, nil
+			}),
+		}
+		c := &Client{
+			hc:         hc,
+			baseURL:    &url.URL{Host: "localhost:3500", Scheme: "http"},
+			sszEnabled: true,
+		}
+		sbb, err := blocks.NewSignedBeaconBlock(test)
+		require.NoError(t, err)
+		ed, blobBundle, err := c.SubmitBlindedBlock(ctx, sbb)
+		require.NoError(t, err)
+		require.DeepEqual(t, payload, ed.Proto())
+		require.DeepEqual(t, bundle, blobBundle)
+	})
+	t.Run("ssz not supported, falls back to json", func(t *testing.T) {
+		hc := &http.Client{
+			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+				require.Equal(t, postBlindedBeaconBlockPath, r.URL.Path)
+				if r.Header.Get("Content-Type") == api.OctetStreamMediaType {
+					return &http.Response{
+						StatusCode: http.StatusUnsupportedMediaType,
+						Body:       io.NopCloser(bytes.NewBuffer(nil)),
+						Request:    r.Clone(ctx),
+					}, nil
+				}
+				require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(testExampleExecutionPayloadCapella)),
+					Request:    r.Clone(ctx),
+				}, nil
+			}),
+		}
+		c := &Client{
+			hc:         hc,
+			baseURL:    &url.URL{Host: "localhost:3500", Scheme: "http"},
+			sszEnabled: true,
+		}
+		sbb, err := blocks.NewSignedBeaconBlock(util.NewBlindedBeaconBlockCapella())
+		require.NoError(t, err)
+		ep, _, err := c.SubmitBlindedBlock(ctx, sbb)
+		require.NoError(t, err)
+		withdrawals, err := ep.Withdrawals()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(withdrawals))
+		require.Equal(t, true, c.sszUnsupported.Load())
+	})
+	t.Run("ssz rejected with 400, falls back to json", func(t *testing.T) {
+		hc := &http.Client{
+			Transport: roundtrip(func(r *http.Request) (*http.Response, error) {
+				require.Equal(t, postBlindedBeaconBlockPath, r.URL.Path)
+				if r.Header.Get("Content-Type") == api.OctetStreamMediaType {
+					return &http.Response{
+						StatusCode: http.StatusBadRequest,
+						Body:       io.NopCloser(bytes.NewBufferString("invalid JSON")),
+						Request:    r.Clone(ctx),
+					}, nil
+				}
+				require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(testExampleExecutionPayloadCapella)),
+					Request:    r.Clone(ctx),
+				}, nil
+			}),
+		}
+		c := &Client{
+			hc:         hc,
+			baseURL:    &url.URL{Host: "localhost:3500", Scheme: "http"},
+			sszEnabled: true,
+		}
+		sbb, err := blocks.NewSignedBeaconBlock(util.NewBlindedBeaconBlockCapella())
+		require.NoError(t, err)
+		ep, _, err := c.SubmitBlindedBlock(ctx, sbb)
+		require.NoError(t, err)
+		withdrawals, err := ep.Withdrawals()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(withdrawals))
+		require.Equal(t, true, c.sszUnsupported.Load())
+	})
 	t.Run("not blinded", func(t *testing.T) {
 		sbb, err := blocks.NewSignedBeaconBlock(&eth.SignedBeaconBlockBellatrix{Block: &eth.BeaconBlockBellatrix{Body: &eth.BeaconBlockBodyBellatrix{ExecutionPayload: &v1.ExecutionPayload{}}}})
 		require.NoError(t, err)
@@ -71,3 +71,39 @@ type AddrRequest struct {
 type PeersResponse struct {
 	Peers []*Peer `json:"peers"`
 }
+
+type PeerScoresResponse struct {
+	Data []*PeerScore `json:"data"`
+}
+
+type PeerScore struct {
+	PeerId             string                 `json:"peer_id"`
+	Address            string                 `json:"address"`
+	State              string                 `json:"state"`
+	Direction          string                 `json:"direction"`
+	Trusted            bool                   `json:"trusted"`
+	Score              string                 `json:"score"`
+	BadResponsesScore  string                 `json:"bad_responses_score"`
+	BlockProviderScore string                 `json:"block_provider_score"`
+	PeerStatusScore    string                 `json:"peer_status_score"`
+	GossipScore        string                 `json:"gossip_score"`
+	BehaviourPenalty   string                 `json:"behaviour_penalty"`
+	TopicScores        map[string]*TopicScore `json:"topic_scores"`
+	BadResponses       string                 `json:"bad_responses"`
+	ProcessedBlocks    string                 `json:"processed_blocks"`
+	Banned             bool                   `json:"banned"`
+	BanReasons         []string               `json:"ban_reasons"`
+	BanTimeLeft        string                 `json:"ban_time_left"`
+}
+
+type TopicScore struct {
+	TimeInMesh               string `json:"time_in_mesh"`
+	FirstMessageDeliveries   string `json:"first_message_deliveries"`
+	MeshMessageDeliveries    string `json:"mesh_message_deliveries"`
+	InvalidMessageDeliveries string `json:"invalid_message_deliveries"`
+}
+
+type BanPeerRequest struct {
+	Duration string `json:"duration"`
+	Reason   string `json:"reason"`
+}
@@ -15,6 +15,34 @@ type BlockRewards struct {
 	AttesterSlashings string `json:"attester_slashings"`
 }
 
+type ProposerRewardsResponse struct {
+	Data                *ProposerRewards `json:"data"`
+	ExecutionOptimistic bool             `json:"execution_optimistic"`
+	Finalized           bool             `json:"finalized"`
+}
+
+type ProposerRewards struct {
+	ProposerIndex    string                   `json:"proposer_index"`
+	ConsensusRewards *BlockRewards            `json:"consensus_rewards"`
+	ExecutionRewards *ExecutionPayloadRewards `json:"execution_rewards"`
+}
+
+// ExecutionPayloadRewards are the priority fees paid to the fee recipient of an execution payload. For payloads built
+// by a builder, the fee recipient is usually the builder, which pays the proposer in the last transaction of the
+// payload. That payment, when found, is reported as BuilderPayment.
+type ExecutionPayloadRewards struct {
+	BlockHash      string          `json:"block_hash"`
+	FeeRecipient   string          `json:"fee_recipient"`
+	PriorityFees   string          `json:"priority_fees"`
+	PayloadSource  string          `json:"payload_source"`
+	BuilderPayment *BuilderPayment `json:"builder_payment,omitempty"`
+}
+
+type BuilderPayment struct {
+	Recipient string `json:"recipient"`
+	Value     string `json:"value"`
+}
+
 type AttestationRewardsResponse struct {
 	Data                AttestationRewards `json:"data"`
 	ExecutionOptimistic bool               `json:"execution_optimistic"`
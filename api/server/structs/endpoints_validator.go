@@ -118,3 +118,24 @@ type GetValidatorPerformanceResponse struct {
 	MissingValidators             [][]byte `json:"missing_validators,omitempty"`
 	InactivityScores              []uint64 `json:"inactivity_scores,omitempty"`
 }
+
+type SimulateBlockResponse struct {
+	Version   string                    `json:"version"`
+	Data      json.RawMessage           `json:"data"`
+	Breakdown *BlockSimulationBreakdown `json:"breakdown"`
+}
+
+type BlockSimulationBreakdown struct {
+	Attestations              string        `json:"attestations"`
+	AggregationBits           string        `json:"aggregation_bits"`
+	ProposerSlashings         string        `json:"proposer_slashings"`
+	AttesterSlashings         string        `json:"attester_slashings"`
+	VoluntaryExits            string        `json:"voluntary_exits"`
+	Deposits                  string        `json:"deposits"`
+	BlsToExecutionChanges     string        `json:"bls_to_execution_changes"`
+	SyncAggregateParticipants string        `json:"sync_aggregate_participants"`
+	SyncCommitteeSize         string        `json:"sync_committee_size"`
+	ConsensusRewards          *BlockRewards `json:"consensus_rewards"`
+	ConsensusBlockValue       string        `json:"consensus_block_value"`
+	ExecutionPayloadValue     string        `json:"execution_payload_value"`
+}
@@ -0,0 +1,74 @@
+package blockchain
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// restoreForkChoiceSnapshot restores the fork choice store from the snapshot saved in the database, so that the
+// non-finalized blocks do not have to be replayed. It returns false if there is no snapshot, or if it is corrupt or
+// does not match the database, in which case fork choice is left untouched. The caller must hold the fork choice lock.
+func (s *Service) restoreForkChoiceSnapshot(ctx context.Context, finalized *forkchoicetypes.Checkpoint) bool {
+	start := time.Now()
+	snapshot, err := s.cfg.BeaconDB.ForkChoiceSnapshot(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not read fork choice snapshot, rebuilding fork choice from the database")
+		return false
+	}
+	if len(snapshot) == 0 {
+		return false
+	}
+	if err := s.cfg.ForkChoiceStore.RestoreSnapshot(ctx, snapshot, finalized, s.cfg.BeaconDB.HasBlock); err != nil {
+		log.WithError(err).Warn("Could not restore fork choice snapshot, rebuilding fork choice from the database")
+		return false
+	}
+	log.WithFields(logrus.Fields{
+		"nodes":     s.cfg.ForkChoiceStore.NodeCount(),
+		"justified": s.cfg.ForkChoiceStore.JustifiedCheckpoint().Epoch,
+		"finalized": finalized.Epoch,
+		"duration":  time.Since(start),
+	}).Info("Restored fork choice from snapshot")
+	return true
+}
+
+// saveForkChoiceSnapshot saves a snapshot of the fork choice store in the database.
+func (s *Service) saveForkChoiceSnapshot(ctx context.Context) error {
+	s.cfg.ForkChoiceStore.RLock()
+	snapshot, err := s.cfg.ForkChoiceStore.Snapshot()
+	s.cfg.ForkChoiceStore.RUnlock()
+	if err != nil {
+		return errors.Wrap(err, "could not snapshot fork choice")
+	}
+	if err := s.cfg.BeaconDB.SaveForkChoiceSnapshot(ctx, snapshot); err != nil {
+		return errors.Wrap(err, "could not save fork choice snapshot")
+	}
+	log.WithField("size", len(snapshot)).Debug("Saved fork choice snapshot")
+	return nil
+}
+
+// runForkChoiceSnapshots saves a snapshot of the fork choice store at the start of every epoch, so that a node which
+// did not shut down cleanly restarts from a recent snapshot.
+func (s *Service) runForkChoiceSnapshots() {
+	ticker := slots.NewSlotTicker(s.genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			if !slots.IsEpochStart(slot) {
+				continue
+			}
+			if err := s.saveForkChoiceSnapshot(s.ctx); err != nil {
+				log.WithError(err).Error("Could not save fork choice snapshot")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
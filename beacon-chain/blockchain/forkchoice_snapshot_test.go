@@ -0,0 +1,85 @@
+package blockchain
+
+import (
+	"testing"
+
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestStartFromSavedState_ForkChoiceSnapshot(t *testing.T) {
+	hook := logTest.NewGlobal()
+	genesis := util.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	finalizedSlot := params.BeaconConfig().SlotsPerEpoch*2 + 1
+	finalizedBlock := util.NewBeaconBlock()
+	finalizedBlock.Block.Slot = finalizedSlot
+	finalizedBlock.Block.ParentRoot = bytesutil.PadTo(genesisRoot[:], 32)
+	finalizedRoot, err := finalizedBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	finalizedState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, finalizedState.SetSlot(finalizedSlot))
+	require.NoError(t, finalizedState.SetGenesisValidatorsRoot(params.BeaconConfig().ZeroHash[:]))
+	childBlock := util.NewBeaconBlock()
+	childBlock.Block.Slot = finalizedSlot + 1
+	childBlock.Block.ParentRoot = finalizedRoot[:]
+	childRoot, err := childBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	c, tr := minimalTestService(t, WithFinalizedStateAtStartUp(finalizedState))
+	ctx, beaconDB := tr.ctx, tr.db
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	util.SaveBlock(t, ctx, beaconDB, genesis)
+	util.SaveBlock(t, ctx, beaconDB, finalizedBlock)
+	util.SaveBlock(t, ctx, beaconDB, childBlock)
+	require.NoError(t, beaconDB.SaveState(ctx, finalizedState, genesisRoot))
+	require.NoError(t, beaconDB.SaveState(ctx, finalizedState, finalizedRoot))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: slots.ToEpoch(finalizedSlot), Root: finalizedRoot[:]}))
+
+	// Without a snapshot, fork choice starts from the finalized block.
+	require.NoError(t, c.StartFromSavedState(finalizedState))
+	require.Equal(t, 1, c.cfg.ForkChoiceStore.NodeCount())
+	jc := &ethpb.Checkpoint{Epoch: c.cfg.ForkChoiceStore.JustifiedCheckpoint().Epoch}
+	fc := &ethpb.Checkpoint{Epoch: slots.ToEpoch(finalizedSlot), Root: finalizedRoot[:]}
+	st, root, err := prepareForkchoiceState(ctx, childBlock.Block.Slot, childRoot, finalizedRoot, [32]byte{'c'}, jc, fc)
+	require.NoError(t, err)
+	require.NoError(t, c.cfg.ForkChoiceStore.InsertNode(ctx, st, root))
+	require.NoError(t, c.saveForkChoiceSnapshot(ctx))
+
+	// A restarted node restores the child block from the snapshot.
+	restart := func() *Service {
+		fcs := doublylinkedtree.New()
+		s, err := NewService(ctx,
+			WithFinalizedStateAtStartUp(finalizedState),
+			WithDatabase(beaconDB),
+			WithForkChoiceStore(fcs),
+			WithStateGen(stategen.New(beaconDB, fcs)),
+			WithAttestationService(tr.attSrv),
+			WithClockSynchronizer(startup.NewClockSynchronizer()),
+		)
+		require.NoError(t, err)
+		require.NoError(t, s.StartFromSavedState(finalizedState))
+		return s
+	}
+	s := restart()
+	require.LogsContain(t, hook, "Restored fork choice from snapshot")
+	require.Equal(t, 2, s.cfg.ForkChoiceStore.NodeCount())
+	require.Equal(t, true, s.cfg.ForkChoiceStore.HasNode(childRoot))
+
+	// A corrupt snapshot falls back to starting from the finalized block.
+	require.NoError(t, beaconDB.SaveForkChoiceSnapshot(ctx, []byte{1, 2, 3}))
+	s = restart()
+	require.LogsContain(t, hook, "Could not restore fork choice snapshot")
+	require.Equal(t, 1, s.cfg.ForkChoiceStore.NodeCount())
+	require.Equal(t, true, s.cfg.ForkChoiceStore.HasNode(finalizedRoot))
+}
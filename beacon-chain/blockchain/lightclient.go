@@ -5,20 +5,23 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
 	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/v5/proto/migration"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
-const (
-	finalityBranchNumOfLeaves = 6
-)
-
 // CreateLightClientFinalityUpdate - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/full-node.md#create_light_client_finality_update
 // def create_light_client_finality_update(update: LightClientUpdate) -> LightClientFinalityUpdate:
 //
@@ -215,8 +218,12 @@ func NewLightClientFinalityUpdateFromBeaconState(
 			BodyRoot:      make([]byte, 32),
 		}
 
-		finalityBranch = make([][]byte, finalityBranchNumOfLeaves)
-		for i := 0; i < finalityBranchNumOfLeaves; i++ {
+		numOfLeaves := fieldparams.FinalityBranchDepth
+		if attestedState.Version() >= version.Electra {
+			numOfLeaves = fieldparams.FinalityBranchDepthElectra
+		}
+		finalityBranch = make([][]byte, numOfLeaves)
+		for i := 0; i < numOfLeaves; i++ {
 			finalityBranch[i] = make([]byte, 32)
 		}
 	}
@@ -243,3 +250,639 @@ func NewLightClientUpdateFromOptimisticUpdate(update *ethpbv2.LightClientOptimis
 		SignatureSlot:  update.SignatureSlot,
 	}
 }
+
+// NewLightClientBootstrapFromBeaconState creates a light client bootstrap for the given block, whose post-state is the
+// provided state, in the type of the fork of the block, as per create_light_client_bootstrap in the light client full
+// node specs.
+func NewLightClientBootstrapFromBeaconState(
+	ctx context.Context,
+	state state.BeaconState,
+	block interfaces.ReadOnlySignedBeaconBlock) (ethpb.LightClientBootstrap, error) {
+	// assert compute_epoch_at_slot(state.slot) >= ALTAIR_FORK_EPOCH
+	if slots.ToEpoch(state.Slot()) < params.BeaconConfig().AltairForkEpoch {
+		return nil, fmt.Errorf("light client bootstrap is not supported before Altair, invalid slot %d", state.Slot())
+	}
+
+	// assert state.slot == state.latest_block_header.slot
+	header := state.LatestBlockHeader()
+	if state.Slot() != header.Slot {
+		return nil, fmt.Errorf("state slot %d not equal to latest block header slot %d", state.Slot(), header.Slot)
+	}
+
+	// header.state_root = hash_tree_root(state)
+	stateRoot, err := state.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get state root: %v", err)
+	}
+	header.StateRoot = stateRoot[:]
+
+	// assert hash_tree_root(header) == hash_tree_root(block.message)
+	headerRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return nil, fmt.Errorf("could not get header root: %v", err)
+	}
+	blockRoot, err := block.Block().HashTreeRoot()
+	if err != nil {
+		return nil, fmt.Errorf("could not get block root: %v", err)
+	}
+	if headerRoot != blockRoot {
+		return nil, fmt.Errorf("header root %#x not equal to block root %#x", headerRoot, blockRoot)
+	}
+
+	currentSyncCommittee, err := state.CurrentSyncCommittee()
+	if err != nil {
+		return nil, fmt.Errorf("could not get current sync committee: %v", err)
+	}
+	currentSyncCommitteeBranch, err := state.CurrentSyncCommitteeProof(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get current sync committee proof: %v", err)
+	}
+
+	execution, err := newLightClientExecution(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get execution header")
+	}
+	switch block.Version() {
+	case version.Altair, version.Bellatrix:
+		return &ethpb.LightClientBootstrapAltair{
+			Header:                     &ethpb.LightClientHeaderAltair{Beacon: header},
+			CurrentSyncCommittee:       currentSyncCommittee,
+			CurrentSyncCommitteeBranch: currentSyncCommitteeBranch,
+		}, nil
+	case version.Capella:
+		return &ethpb.LightClientBootstrapCapella{
+			Header: &ethpb.LightClientHeaderCapella{
+				Beacon:          header,
+				Execution:       execution.executionCapella(),
+				ExecutionBranch: execution.branch,
+			},
+			CurrentSyncCommittee:       currentSyncCommittee,
+			CurrentSyncCommitteeBranch: currentSyncCommitteeBranch,
+		}, nil
+	case version.Deneb:
+		return &ethpb.LightClientBootstrapDeneb{
+			Header: &ethpb.LightClientHeaderDeneb{
+				Beacon:          header,
+				Execution:       execution.executionDeneb(),
+				ExecutionBranch: execution.branch,
+			},
+			CurrentSyncCommittee:       currentSyncCommittee,
+			CurrentSyncCommitteeBranch: currentSyncCommitteeBranch,
+		}, nil
+	case version.Electra:
+		return &ethpb.LightClientBootstrapElectra{
+			Header: &ethpb.LightClientHeaderElectra{
+				Beacon:          header,
+				Execution:       execution.header,
+				ExecutionBranch: execution.branch,
+			},
+			CurrentSyncCommittee:       currentSyncCommittee,
+			CurrentSyncCommitteeBranch: currentSyncCommitteeBranch,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported block version %s", version.String(block.Version()))
+	}
+}
+
+// NewLightClientUpdateFromBeaconState creates a full light client update, including the next sync committee, in the
+// type of the fork of the attested block, as per create_light_client_update in the light client full node specs.
+// The finalized block is nil when the attested state has no finalized block to prove.
+func NewLightClientUpdateFromBeaconState(
+	ctx context.Context,
+	state state.BeaconState,
+	block interfaces.ReadOnlySignedBeaconBlock,
+	attestedState state.BeaconState,
+	attestedBlock interfaces.ReadOnlySignedBeaconBlock,
+	finalizedBlock interfaces.ReadOnlySignedBeaconBlock) (ethpb.LightClientUpdate, error) {
+	result, err := NewLightClientFinalityUpdateFromBeaconState(ctx, state, block, attestedState, finalizedBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	// assert hash_tree_root(attested_header) == hash_tree_root(attested_block.message)
+	attestedBlockRoot, err := attestedBlock.Block().HashTreeRoot()
+	if err != nil {
+		return nil, fmt.Errorf("could not get attested block root: %v", err)
+	}
+	if attestedBlockRoot != block.Block().ParentRoot() {
+		return nil, fmt.Errorf("attested block root %#x not equal to block parent root %#x", attestedBlockRoot, block.Block().ParentRoot())
+	}
+
+	nextSyncCommittee, nextSyncCommitteeBranch, err := lightClientNextSyncCommittee(ctx, block, attestedState)
+	if err != nil {
+		return nil, err
+	}
+
+	// The finalized header is left empty for the genesis block, as per create_light_client_update.
+	if finalizedBlock != nil && !finalizedBlock.IsNil() && finalizedBlock.Block().Slot() == 0 {
+		finalizedBlock = nil
+	}
+	attested, err := newLightClientExecution(attestedBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attested execution header")
+	}
+	finalized, err := newLightClientExecution(finalizedBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized execution header")
+	}
+
+	attestedHeader := migration.V1HeaderToV1Alpha1(result.AttestedHeader)
+	finalizedHeader := migration.V1HeaderToV1Alpha1(result.FinalizedHeader)
+	syncAggregate := &ethpb.SyncAggregate{
+		SyncCommitteeBits:      result.SyncAggregate.SyncCommitteeBits,
+		SyncCommitteeSignature: result.SyncAggregate.SyncCommitteeSignature,
+	}
+	switch attestedBlock.Version() {
+	case version.Altair, version.Bellatrix:
+		return &ethpb.LightClientUpdateAltair{
+			AttestedHeader:          &ethpb.LightClientHeaderAltair{Beacon: attestedHeader},
+			NextSyncCommittee:       nextSyncCommittee,
+			NextSyncCommitteeBranch: nextSyncCommitteeBranch,
+			FinalizedHeader:         &ethpb.LightClientHeaderAltair{Beacon: finalizedHeader},
+			FinalityBranch:          result.FinalityBranch,
+			SyncAggregate:           syncAggregate,
+			SignatureSlot:           result.SignatureSlot,
+		}, nil
+	case version.Capella:
+		return &ethpb.LightClientUpdateCapella{
+			AttestedHeader: &ethpb.LightClientHeaderCapella{
+				Beacon:          attestedHeader,
+				Execution:       attested.executionCapella(),
+				ExecutionBranch: attested.branch,
+			},
+			NextSyncCommittee:       nextSyncCommittee,
+			NextSyncCommitteeBranch: nextSyncCommitteeBranch,
+			FinalizedHeader: &ethpb.LightClientHeaderCapella{
+				Beacon:          finalizedHeader,
+				Execution:       finalized.executionCapella(),
+				ExecutionBranch: finalized.branch,
+			},
+			FinalityBranch: result.FinalityBranch,
+			SyncAggregate:  syncAggregate,
+			SignatureSlot:  result.SignatureSlot,
+		}, nil
+	case version.Deneb:
+		return &ethpb.LightClientUpdateDeneb{
+			AttestedHeader: &ethpb.LightClientHeaderDeneb{
+				Beacon:          attestedHeader,
+				Execution:       attested.executionDeneb(),
+				ExecutionBranch: attested.branch,
+			},
+			NextSyncCommittee:       nextSyncCommittee,
+			NextSyncCommitteeBranch: nextSyncCommitteeBranch,
+			FinalizedHeader: &ethpb.LightClientHeaderDeneb{
+				Beacon:          finalizedHeader,
+				Execution:       finalized.executionDeneb(),
+				ExecutionBranch: finalized.branch,
+			},
+			FinalityBranch: result.FinalityBranch,
+			SyncAggregate:  syncAggregate,
+			SignatureSlot:  result.SignatureSlot,
+		}, nil
+	case version.Electra:
+		return &ethpb.LightClientUpdateElectra{
+			AttestedHeader: &ethpb.LightClientHeaderElectra{
+				Beacon:          attestedHeader,
+				Execution:       attested.header,
+				ExecutionBranch: attested.branch,
+			},
+			NextSyncCommittee:       nextSyncCommittee,
+			NextSyncCommitteeBranch: nextSyncCommitteeBranch,
+			FinalizedHeader: &ethpb.LightClientHeaderElectra{
+				Beacon:          finalizedHeader,
+				Execution:       finalized.header,
+				ExecutionBranch: finalized.branch,
+			},
+			FinalityBranch: result.FinalityBranch,
+			SyncAggregate:  syncAggregate,
+			SignatureSlot:  result.SignatureSlot,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported attested block version %s", version.String(attestedBlock.Version()))
+	}
+}
+
+// lightClientNextSyncCommittee returns the next sync committee of the attested state and its branch when the block is
+// signed in the sync committee period of the attested state, and an empty committee with a zero branch otherwise.
+func lightClientNextSyncCommittee(
+	ctx context.Context,
+	block interfaces.ReadOnlySignedBeaconBlock,
+	attestedState state.BeaconState) (*ethpb.SyncCommittee, [][]byte, error) {
+	// update_signature_period = compute_sync_committee_period(compute_epoch_at_slot(block.message.slot))
+	updateSignaturePeriod := syncCommitteePeriodAtSlot(block.Block().Slot())
+
+	// update_attested_period = compute_sync_committee_period(compute_epoch_at_slot(attested_header.slot))
+	updateAttestedPeriod := syncCommitteePeriodAtSlot(attestedState.Slot())
+
+	// `next_sync_committee` is only useful if the message is signed by the current sync committee
+	if updateAttestedPeriod == updateSignaturePeriod {
+		nextSyncCommittee, err := attestedState.NextSyncCommittee()
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not get next sync committee: %v", err)
+		}
+		nextSyncCommitteeBranch, err := attestedState.NextSyncCommitteeProof(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not get next sync committee proof: %v", err)
+		}
+		return nextSyncCommittee, nextSyncCommitteeBranch, nil
+	}
+
+	syncCommitteeSize := params.BeaconConfig().SyncCommitteeSize
+	pubKeys := make([][]byte, syncCommitteeSize)
+	for i := uint64(0); i < syncCommitteeSize; i++ {
+		pubKeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	nextSyncCommittee := &ethpb.SyncCommittee{
+		Pubkeys:         pubKeys,
+		AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+	}
+	depth := fieldparams.NextSyncCommitteeBranchDepth
+	if attestedState.Version() >= version.Electra {
+		depth = fieldparams.NextSyncCommitteeBranchDepthElectra
+	}
+	nextSyncCommitteeBranch := make([][]byte, depth)
+	for i := 0; i < depth; i++ {
+		nextSyncCommitteeBranch[i] = make([]byte, fieldparams.RootLength)
+	}
+	return nextSyncCommittee, nextSyncCommitteeBranch, nil
+}
+
+// IsBetterUpdate returns true if newUpdate should replace oldUpdate as the best light client update
+// of a sync committee period.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#is_better_update
+func IsBetterUpdate(newUpdate, oldUpdate ethpb.LightClientUpdate) bool {
+	// The bits of the proto type are sized for mainnet, so the committee size comes from the config.
+	maxActiveParticipants := params.BeaconConfig().SyncCommitteeSize
+	newNumActiveParticipants := newUpdate.GetSyncAggregate().SyncCommitteeBits.Count()
+	oldNumActiveParticipants := oldUpdate.GetSyncAggregate().SyncCommitteeBits.Count()
+	newHasSupermajority := newNumActiveParticipants*3 >= maxActiveParticipants*2
+	oldHasSupermajority := oldNumActiveParticipants*3 >= maxActiveParticipants*2
+
+	// Compare supermajority (> 2/3) sync committee participation
+	if newHasSupermajority != oldHasSupermajority {
+		return newHasSupermajority
+	}
+	if !newHasSupermajority && newNumActiveParticipants != oldNumActiveParticipants {
+		return newNumActiveParticipants > oldNumActiveParticipants
+	}
+
+	newAttestedSlot := newUpdate.GetAttestedHeaderVal().GetBeacon().Slot
+	oldAttestedSlot := oldUpdate.GetAttestedHeaderVal().GetBeacon().Slot
+
+	// Compare presence of relevant sync committee
+	newHasRelevantSyncCommittee := isSyncCommitteeUpdate(newUpdate) &&
+		syncCommitteePeriodAtSlot(newAttestedSlot) == syncCommitteePeriodAtSlot(newUpdate.GetSignatureSlot())
+	oldHasRelevantSyncCommittee := isSyncCommitteeUpdate(oldUpdate) &&
+		syncCommitteePeriodAtSlot(oldAttestedSlot) == syncCommitteePeriodAtSlot(oldUpdate.GetSignatureSlot())
+	if newHasRelevantSyncCommittee != oldHasRelevantSyncCommittee {
+		return newHasRelevantSyncCommittee
+	}
+
+	// Compare indication of any finality
+	newHasFinality := isFinalityUpdate(newUpdate)
+	oldHasFinality := isFinalityUpdate(oldUpdate)
+	if newHasFinality != oldHasFinality {
+		return newHasFinality
+	}
+
+	// Compare sync committee finality
+	if newHasFinality {
+		newHasSyncCommitteeFinality :=
+			syncCommitteePeriodAtSlot(newUpdate.GetFinalizedHeaderVal().GetBeacon().Slot) == syncCommitteePeriodAtSlot(newAttestedSlot)
+		oldHasSyncCommitteeFinality :=
+			syncCommitteePeriodAtSlot(oldUpdate.GetFinalizedHeaderVal().GetBeacon().Slot) == syncCommitteePeriodAtSlot(oldAttestedSlot)
+		if newHasSyncCommitteeFinality != oldHasSyncCommitteeFinality {
+			return newHasSyncCommitteeFinality
+		}
+	}
+
+	// Tiebreaker 1: Sync committee participation beyond supermajority
+	if newNumActiveParticipants != oldNumActiveParticipants {
+		return newNumActiveParticipants > oldNumActiveParticipants
+	}
+
+	// Tiebreaker 2: Prefer older data (fewer changes to best)
+	if newAttestedSlot != oldAttestedSlot {
+		return newAttestedSlot < oldAttestedSlot
+	}
+	return newUpdate.GetSignatureSlot() < oldUpdate.GetSignatureSlot()
+}
+
+// isSyncCommitteeUpdate returns true if the update carries a next sync committee branch.
+func isSyncCommitteeUpdate(update ethpb.LightClientUpdate) bool {
+	return !isZeroBranch(update.GetNextSyncCommitteeBranch())
+}
+
+// isFinalityUpdate returns true if the update carries a finality branch.
+func isFinalityUpdate(update ethpb.LightClientUpdate) bool {
+	return !isZeroBranch(update.GetFinalityBranch())
+}
+
+func isZeroBranch(branch [][]byte) bool {
+	for _, node := range branch {
+		if !bytesutil.ZeroRoot(node) {
+			return false
+		}
+	}
+	return true
+}
+
+func syncCommitteePeriodAtSlot(slot primitives.Slot) uint64 {
+	return slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+}
+
+// lightClientExecution is the execution part of a LightClientHeader. The header is held in the Electra shape, whose
+// fields are a superset of those of the earlier forks.
+type lightClientExecution struct {
+	header *enginev1.ExecutionPayloadHeaderElectra
+	branch [][]byte
+}
+
+// NewLightClientFinalityUpdateForFork returns the finality update in the type of the fork of the attested block,
+// adding the execution payload headers of the attested and finalized blocks to the headers from Capella on.
+// The finalized block is nil when the update carries no finalized header.
+func NewLightClientFinalityUpdateForFork(
+	update *ethpbv2.LightClientFinalityUpdate,
+	attestedBlock interfaces.ReadOnlySignedBeaconBlock,
+	finalizedBlock interfaces.ReadOnlySignedBeaconBlock) (ethpb.LightClientFinalityUpdate, error) {
+	attestedHeader := migration.V1HeaderToV1Alpha1(update.AttestedHeader)
+	finalizedHeader := migration.V1HeaderToV1Alpha1(update.FinalizedHeader)
+	syncAggregate := lightClientSyncAggregate(update.SyncAggregate)
+	if attestedBlock.Version() < version.Capella {
+		return &ethpb.LightClientFinalityUpdateAltair{
+			AttestedHeader:  &ethpb.LightClientHeaderAltair{Beacon: attestedHeader},
+			FinalizedHeader: &ethpb.LightClientHeaderAltair{Beacon: finalizedHeader},
+			FinalityBranch:  update.FinalityBranch,
+			SyncAggregate:   syncAggregate,
+			SignatureSlot:   update.SignatureSlot,
+		}, nil
+	}
+	attested, err := newLightClientExecution(attestedBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attested execution header")
+	}
+	finalized, err := newLightClientExecution(finalizedBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized execution header")
+	}
+	switch attestedBlock.Version() {
+	case version.Capella:
+		return &ethpb.LightClientFinalityUpdateCapella{
+			AttestedHeader:  attested.headerCapella(attestedHeader),
+			FinalizedHeader: finalized.headerCapella(finalizedHeader),
+			FinalityBranch:  update.FinalityBranch,
+			SyncAggregate:   syncAggregate,
+			SignatureSlot:   update.SignatureSlot,
+		}, nil
+	case version.Deneb:
+		return &ethpb.LightClientFinalityUpdateDeneb{
+			AttestedHeader:  attested.headerDeneb(attestedHeader),
+			FinalizedHeader: finalized.headerDeneb(finalizedHeader),
+			FinalityBranch:  update.FinalityBranch,
+			SyncAggregate:   syncAggregate,
+			SignatureSlot:   update.SignatureSlot,
+		}, nil
+	default:
+		return &ethpb.LightClientFinalityUpdateElectra{
+			AttestedHeader:  attested.headerElectra(attestedHeader),
+			FinalizedHeader: finalized.headerElectra(finalizedHeader),
+			FinalityBranch:  update.FinalityBranch,
+			SyncAggregate:   syncAggregate,
+			SignatureSlot:   update.SignatureSlot,
+		}, nil
+	}
+}
+
+// NewLightClientOptimisticUpdateForFork returns the optimistic update in the type of the fork of the attested block,
+// adding the execution payload header of the attested block to the header from Capella on.
+func NewLightClientOptimisticUpdateForFork(
+	update *ethpbv2.LightClientOptimisticUpdate,
+	attestedBlock interfaces.ReadOnlySignedBeaconBlock) (ethpb.LightClientOptimisticUpdate, error) {
+	attestedHeader := migration.V1HeaderToV1Alpha1(update.AttestedHeader)
+	syncAggregate := lightClientSyncAggregate(update.SyncAggregate)
+	if attestedBlock.Version() < version.Capella {
+		return &ethpb.LightClientOptimisticUpdateAltair{
+			AttestedHeader: &ethpb.LightClientHeaderAltair{Beacon: attestedHeader},
+			SyncAggregate:  syncAggregate,
+			SignatureSlot:  update.SignatureSlot,
+		}, nil
+	}
+	attested, err := newLightClientExecution(attestedBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attested execution header")
+	}
+	switch attestedBlock.Version() {
+	case version.Capella:
+		return &ethpb.LightClientOptimisticUpdateCapella{
+			AttestedHeader: attested.headerCapella(attestedHeader),
+			SyncAggregate:  syncAggregate,
+			SignatureSlot:  update.SignatureSlot,
+		}, nil
+	case version.Deneb:
+		return &ethpb.LightClientOptimisticUpdateDeneb{
+			AttestedHeader: attested.headerDeneb(attestedHeader),
+			SyncAggregate:  syncAggregate,
+			SignatureSlot:  update.SignatureSlot,
+		}, nil
+	default:
+		return &ethpb.LightClientOptimisticUpdateElectra{
+			AttestedHeader: attested.headerElectra(attestedHeader),
+			SyncAggregate:  syncAggregate,
+			SignatureSlot:  update.SignatureSlot,
+		}, nil
+	}
+}
+
+// lightClientSyncAggregate converts the sync aggregate of an API update to its consensus type.
+func lightClientSyncAggregate(syncAggregate *ethpbv1.SyncAggregate) *ethpb.SyncAggregate {
+	if syncAggregate == nil {
+		return nil
+	}
+	return &ethpb.SyncAggregate{
+		SyncCommitteeBits:      syncAggregate.SyncCommitteeBits,
+		SyncCommitteeSignature: syncAggregate.SyncCommitteeSignature,
+	}
+}
+
+// newLightClientExecution returns the execution payload header of the block and its proof of inclusion in the block
+// body, as per block_to_light_client_header in the Capella light client full node spec. Blocks without an execution
+// payload header, which include a nil block and blocks from before Capella, get an empty header and a zero branch.
+func newLightClientExecution(block interfaces.ReadOnlySignedBeaconBlock) (*lightClientExecution, error) {
+	if block == nil || block.IsNil() || block.Version() < version.Capella {
+		branch := make([][]byte, fieldparams.ExecutionBranchDepth)
+		for i := range branch {
+			branch[i] = make([]byte, fieldparams.RootLength)
+		}
+		return &lightClientExecution{header: emptyExecutionHeader(), branch: branch}, nil
+	}
+	body := block.Block().Body()
+	execution, err := body.Execution()
+	if err != nil {
+		return nil, err
+	}
+	header, err := executionHeaderElectra(execution, block.Version())
+	if err != nil {
+		return nil, err
+	}
+	branch, err := blocks.MerkleProofExecutionPayload(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute execution payload proof")
+	}
+	return &lightClientExecution{header: header, branch: branch}, nil
+}
+
+// executionHeaderElectra returns the header of the execution data of a block of the given version in the Electra
+// shape, with the fields that do not exist in that version zeroed.
+func executionHeaderElectra(execution interfaces.ExecutionData, v int) (*enginev1.ExecutionPayloadHeaderElectra, error) {
+	header := execution.Proto()
+	if !execution.IsBlinded() {
+		var err error
+		switch v {
+		case version.Capella:
+			header, err = blocks.PayloadToHeaderCapella(execution)
+		case version.Deneb:
+			header, err = blocks.PayloadToHeaderDeneb(execution)
+		default:
+			payload, ok := execution.(interfaces.ExecutionDataElectra)
+			if !ok {
+				return nil, fmt.Errorf("unexpected execution payload of type %T", execution)
+			}
+			header, err = blocks.PayloadToHeaderElectra(payload)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	switch h := header.(type) {
+	case *enginev1.ExecutionPayloadHeaderCapella:
+		return &enginev1.ExecutionPayloadHeaderElectra{
+			ParentHash:             h.ParentHash,
+			FeeRecipient:           h.FeeRecipient,
+			StateRoot:              h.StateRoot,
+			ReceiptsRoot:           h.ReceiptsRoot,
+			LogsBloom:              h.LogsBloom,
+			PrevRandao:             h.PrevRandao,
+			BlockNumber:            h.BlockNumber,
+			GasLimit:               h.GasLimit,
+			GasUsed:                h.GasUsed,
+			Timestamp:              h.Timestamp,
+			ExtraData:              h.ExtraData,
+			BaseFeePerGas:          h.BaseFeePerGas,
+			BlockHash:              h.BlockHash,
+			TransactionsRoot:       h.TransactionsRoot,
+			WithdrawalsRoot:        h.WithdrawalsRoot,
+			DepositReceiptsRoot:    make([]byte, fieldparams.RootLength),
+			WithdrawalRequestsRoot: make([]byte, fieldparams.RootLength),
+		}, nil
+	case *enginev1.ExecutionPayloadHeaderDeneb:
+		return &enginev1.ExecutionPayloadHeaderElectra{
+			ParentHash:             h.ParentHash,
+			FeeRecipient:           h.FeeRecipient,
+			StateRoot:              h.StateRoot,
+			ReceiptsRoot:           h.ReceiptsRoot,
+			LogsBloom:              h.LogsBloom,
+			PrevRandao:             h.PrevRandao,
+			BlockNumber:            h.BlockNumber,
+			GasLimit:               h.GasLimit,
+			GasUsed:                h.GasUsed,
+			Timestamp:              h.Timestamp,
+			ExtraData:              h.ExtraData,
+			BaseFeePerGas:          h.BaseFeePerGas,
+			BlockHash:              h.BlockHash,
+			TransactionsRoot:       h.TransactionsRoot,
+			WithdrawalsRoot:        h.WithdrawalsRoot,
+			BlobGasUsed:            h.BlobGasUsed,
+			ExcessBlobGas:          h.ExcessBlobGas,
+			DepositReceiptsRoot:    make([]byte, fieldparams.RootLength),
+			WithdrawalRequestsRoot: make([]byte, fieldparams.RootLength),
+		}, nil
+	case *enginev1.ExecutionPayloadHeaderElectra:
+		return h, nil
+	default:
+		return nil, fmt.Errorf("unexpected execution payload header of type %T", header)
+	}
+}
+
+func emptyExecutionHeader() *enginev1.ExecutionPayloadHeaderElectra {
+	return &enginev1.ExecutionPayloadHeaderElectra{
+		ParentHash:             make([]byte, fieldparams.RootLength),
+		FeeRecipient:           make([]byte, fieldparams.FeeRecipientLength),
+		StateRoot:              make([]byte, fieldparams.RootLength),
+		ReceiptsRoot:           make([]byte, fieldparams.RootLength),
+		LogsBloom:              make([]byte, fieldparams.LogsBloomLength),
+		PrevRandao:             make([]byte, fieldparams.RootLength),
+		ExtraData:              make([]byte, 0),
+		BaseFeePerGas:          make([]byte, fieldparams.RootLength),
+		BlockHash:              make([]byte, fieldparams.RootLength),
+		TransactionsRoot:       make([]byte, fieldparams.RootLength),
+		WithdrawalsRoot:        make([]byte, fieldparams.RootLength),
+		DepositReceiptsRoot:    make([]byte, fieldparams.RootLength),
+		WithdrawalRequestsRoot: make([]byte, fieldparams.RootLength),
+	}
+}
+
+func (e *lightClientExecution) executionCapella() *enginev1.ExecutionPayloadHeaderCapella {
+	return &enginev1.ExecutionPayloadHeaderCapella{
+		ParentHash:       e.header.ParentHash,
+		FeeRecipient:     e.header.FeeRecipient,
+		StateRoot:        e.header.StateRoot,
+		ReceiptsRoot:     e.header.ReceiptsRoot,
+		LogsBloom:        e.header.LogsBloom,
+		PrevRandao:       e.header.PrevRandao,
+		BlockNumber:      e.header.BlockNumber,
+		GasLimit:         e.header.GasLimit,
+		GasUsed:          e.header.GasUsed,
+		Timestamp:        e.header.Timestamp,
+		ExtraData:        e.header.ExtraData,
+		BaseFeePerGas:    e.header.BaseFeePerGas,
+		BlockHash:        e.header.BlockHash,
+		TransactionsRoot: e.header.TransactionsRoot,
+		WithdrawalsRoot:  e.header.WithdrawalsRoot,
+	}
+}
+
+func (e *lightClientExecution) executionDeneb() *enginev1.ExecutionPayloadHeaderDeneb {
+	return &enginev1.ExecutionPayloadHeaderDeneb{
+		ParentHash:       e.header.ParentHash,
+		FeeRecipient:     e.header.FeeRecipient,
+		StateRoot:        e.header.StateRoot,
+		ReceiptsRoot:     e.header.ReceiptsRoot,
+		LogsBloom:        e.header.LogsBloom,
+		PrevRandao:       e.header.PrevRandao,
+		BlockNumber:      e.header.BlockNumber,
+		GasLimit:         e.header.GasLimit,
+		GasUsed:          e.header.GasUsed,
+		Timestamp:        e.header.Timestamp,
+		ExtraData:        e.header.ExtraData,
+		BaseFeePerGas:    e.header.BaseFeePerGas,
+		BlockHash:        e.header.BlockHash,
+		TransactionsRoot: e.header.TransactionsRoot,
+		WithdrawalsRoot:  e.header.WithdrawalsRoot,
+		BlobGasUsed:      e.header.BlobGasUsed,
+		ExcessBlobGas:    e.header.ExcessBlobGas,
+	}
+}
+
+func (e *lightClientExecution) headerCapella(beacon *ethpb.BeaconBlockHeader) *ethpb.LightClientHeaderCapella {
+	return &ethpb.LightClientHeaderCapella{
+		Beacon:          beacon,
+		Execution:       e.executionCapella(),
+		ExecutionBranch: e.branch,
+	}
+}
+
+func (e *lightClientExecution) headerDeneb(beacon *ethpb.BeaconBlockHeader) *ethpb.LightClientHeaderDeneb {
+	return &ethpb.LightClientHeaderDeneb{
+		Beacon:          beacon,
+		Execution:       e.executionDeneb(),
+		ExecutionBranch: e.branch,
+	}
+}
+
+func (e *lightClientExecution) headerElectra(beacon *ethpb.BeaconBlockHeader) *ethpb.LightClientHeaderElectra {
+	return &ethpb.LightClientHeaderElectra{
+		Beacon:          beacon,
+		Execution:       e.header,
+		ExecutionBranch: e.branch,
+	}
+}
@@ -1,19 +1,31 @@
 package blockchain
 
 import (
+	"bytes"
 	"context"
 	"testing"
+	"time"
 
+	"github.com/prysmaticlabs/go-bitfield"
+	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/container/trie"
 	v1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
 	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v5/proto/migration"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"google.golang.org/protobuf/proto"
 )
 
 type testlc struct {
@@ -22,6 +34,7 @@ type testlc struct {
 	state          state.BeaconState
 	block          interfaces.ReadOnlySignedBeaconBlock
 	attestedState  state.BeaconState
+	attestedBlock  interfaces.ReadOnlySignedBeaconBlock
 	attestedHeader *ethpb.BeaconBlockHeader
 }
 
@@ -93,6 +106,7 @@ func (l *testlc) setupTest() *testlc {
 
 	l.state = state
 	l.attestedState = attestedState
+	l.attestedBlock = signedParent
 	l.attestedHeader = attestedHeader
 	l.block = signedBlock
 	l.ctx = ctx
@@ -100,22 +114,22 @@ func (l *testlc) setupTest() *testlc {
 	return l
 }
 
-func (l *testlc) checkAttestedHeader(update *ethpbv2.LightClientUpdate) {
-	require.Equal(l.t, l.attestedHeader.Slot, update.AttestedHeader.Slot, "Attested header slot is not equal")
-	require.Equal(l.t, l.attestedHeader.ProposerIndex, update.AttestedHeader.ProposerIndex, "Attested header proposer index is not equal")
-	require.DeepSSZEqual(l.t, l.attestedHeader.ParentRoot, update.AttestedHeader.ParentRoot, "Attested header parent root is not equal")
-	require.DeepSSZEqual(l.t, l.attestedHeader.BodyRoot, update.AttestedHeader.BodyRoot, "Attested header body root is not equal")
+func (l *testlc) checkAttestedHeader(header *v1.BeaconBlockHeader) {
+	require.Equal(l.t, l.attestedHeader.Slot, header.Slot, "Attested header slot is not equal")
+	require.Equal(l.t, l.attestedHeader.ProposerIndex, header.ProposerIndex, "Attested header proposer index is not equal")
+	require.DeepSSZEqual(l.t, l.attestedHeader.ParentRoot, header.ParentRoot, "Attested header parent root is not equal")
+	require.DeepSSZEqual(l.t, l.attestedHeader.BodyRoot, header.BodyRoot, "Attested header body root is not equal")
 
 	attestedStateRoot, err := l.attestedState.HashTreeRoot(l.ctx)
 	require.NoError(l.t, err)
-	require.DeepSSZEqual(l.t, attestedStateRoot[:], update.AttestedHeader.StateRoot, "Attested header state root is not equal")
+	require.DeepSSZEqual(l.t, attestedStateRoot[:], header.StateRoot, "Attested header state root is not equal")
 }
 
-func (l *testlc) checkSyncAggregate(update *ethpbv2.LightClientUpdate) {
+func (l *testlc) checkSyncAggregate(bits bitfield.Bitvector512, signature []byte) {
 	syncAggregate, err := l.block.Block().Body().SyncAggregate()
 	require.NoError(l.t, err)
-	require.DeepSSZEqual(l.t, syncAggregate.SyncCommitteeBits, update.SyncAggregate.SyncCommitteeBits, "SyncAggregate bits is not equal")
-	require.DeepSSZEqual(l.t, syncAggregate.SyncCommitteeSignature, update.SyncAggregate.SyncCommitteeSignature, "SyncAggregate signature is not equal")
+	require.DeepSSZEqual(l.t, syncAggregate.SyncCommitteeBits, bits, "SyncAggregate bits is not equal")
+	require.DeepSSZEqual(l.t, syncAggregate.SyncCommitteeSignature, signature, "SyncAggregate signature is not equal")
 }
 
 func TestLightClient_NewLightClientOptimisticUpdateFromBeaconState(t *testing.T) {
@@ -127,8 +141,8 @@ func TestLightClient_NewLightClientOptimisticUpdateFromBeaconState(t *testing.T)
 
 	require.Equal(t, l.block.Block().Slot(), update.SignatureSlot, "Signature slot is not equal")
 
-	l.checkSyncAggregate(update)
-	l.checkAttestedHeader(update)
+	l.checkSyncAggregate(update.SyncAggregate.SyncCommitteeBits, update.SyncAggregate.SyncCommitteeSignature)
+	l.checkAttestedHeader(update.AttestedHeader)
 
 	require.Equal(t, (*v1.BeaconBlockHeader)(nil), update.FinalizedHeader, "Finalized header is not nil")
 	require.DeepSSZEqual(t, ([][]byte)(nil), update.FinalityBranch, "Finality branch is not nil")
@@ -143,8 +157,8 @@ func TestLightClient_NewLightClientFinalityUpdateFromBeaconState(t *testing.T) {
 
 	require.Equal(t, l.block.Block().Slot(), update.SignatureSlot, "Signature slot is not equal")
 
-	l.checkSyncAggregate(update)
-	l.checkAttestedHeader(update)
+	l.checkSyncAggregate(update.SyncAggregate.SyncCommitteeBits, update.SyncAggregate.SyncCommitteeSignature)
+	l.checkAttestedHeader(update.AttestedHeader)
 
 	zeroHash := params.BeaconConfig().ZeroHash[:]
 	require.NotNil(t, update.FinalizedHeader, "Finalized header is nil")
@@ -153,8 +167,435 @@ func TestLightClient_NewLightClientFinalityUpdateFromBeaconState(t *testing.T) {
 	require.DeepSSZEqual(t, zeroHash, update.FinalizedHeader.ParentRoot, "Finalized header parent root is not zero")
 	require.DeepSSZEqual(t, zeroHash, update.FinalizedHeader.StateRoot, "Finalized header state root is not zero")
 	require.DeepSSZEqual(t, zeroHash, update.FinalizedHeader.BodyRoot, "Finalized header body root is not zero")
-	require.Equal(t, finalityBranchNumOfLeaves, len(update.FinalityBranch), "Invalid finality branch leaves")
+	require.Equal(t, fieldparams.FinalityBranchDepth, len(update.FinalityBranch), "Invalid finality branch leaves")
 	for _, leaf := range update.FinalityBranch {
 		require.DeepSSZEqual(t, zeroHash, leaf, "Leaf is not zero")
 	}
 }
+
+func TestLightClient_NewLightClientBootstrapFromBeaconState(t *testing.T) {
+	l := newTestLc(t).setupTest()
+
+	bootstrap, err := NewLightClientBootstrapFromBeaconState(l.ctx, l.attestedState, l.attestedBlock)
+	require.NoError(t, err)
+	capellaBootstrap, ok := bootstrap.(*ethpb.LightClientBootstrapCapella)
+	require.Equal(t, true, ok, "Unexpected bootstrap of type %T", bootstrap)
+	_, err = capellaBootstrap.MarshalSSZ()
+	require.NoError(t, err)
+
+	l.checkAttestedHeader(migration.V1Alpha1HeaderToV1(capellaBootstrap.Header.Beacon))
+	currentSyncCommittee, err := l.attestedState.CurrentSyncCommittee()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, currentSyncCommittee.Pubkeys, capellaBootstrap.CurrentSyncCommittee.Pubkeys, "Current sync committee is not equal")
+	require.Equal(t, fieldparams.NextSyncCommitteeBranchDepth, len(capellaBootstrap.CurrentSyncCommitteeBranch), "Invalid current sync committee branch length")
+	require.Equal(t, fieldparams.ExecutionBranchDepth, len(capellaBootstrap.Header.ExecutionBranch), "Invalid execution branch length")
+
+	// The state must be the post-state of the block.
+	_, err = NewLightClientBootstrapFromBeaconState(l.ctx, l.state, l.attestedBlock)
+	require.ErrorContains(t, "not equal to block root", err)
+}
+
+func TestLightClient_NewLightClientUpdateFromBeaconState(t *testing.T) {
+	l := newTestLc(t).setupTest()
+
+	update, err := NewLightClientUpdateFromBeaconState(l.ctx, l.state, l.block, l.attestedState, l.attestedBlock, nil)
+	require.NoError(t, err)
+	capellaUpdate, ok := update.(*ethpb.LightClientUpdateCapella)
+	require.Equal(t, true, ok, "Unexpected update of type %T", update)
+	_, err = capellaUpdate.MarshalSSZ()
+	require.NoError(t, err)
+
+	l.checkSyncAggregate(capellaUpdate.SyncAggregate.SyncCommitteeBits, capellaUpdate.SyncAggregate.SyncCommitteeSignature)
+	l.checkAttestedHeader(migration.V1Alpha1HeaderToV1(capellaUpdate.AttestedHeader.Beacon))
+	require.Equal(t, fieldparams.FinalityBranchDepth, len(capellaUpdate.FinalityBranch), "Invalid finality branch length")
+	require.DeepEqual(t, testBranch(fieldparams.ExecutionBranchDepth, true), capellaUpdate.FinalizedHeader.ExecutionBranch)
+
+	// The attested and signature slots are in the same period, so the next sync committee is included.
+	nextSyncCommittee, err := l.attestedState.NextSyncCommittee()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, nextSyncCommittee.Pubkeys, capellaUpdate.NextSyncCommittee.Pubkeys, "Next sync committee is not equal")
+	require.Equal(t, fieldparams.NextSyncCommitteeBranchDepth, len(capellaUpdate.NextSyncCommitteeBranch), "Invalid next sync committee branch length")
+}
+
+func TestLightClient_NewLightClientUpdateFromBeaconState_Electra(t *testing.T) {
+	ctx := context.Background()
+	slot := primitives.Slot(params.BeaconConfig().AltairForkEpoch * primitives.Epoch(params.BeaconConfig().SlotsPerEpoch)).Add(1)
+
+	attestedState, err := util.NewBeaconStateElectra()
+	require.NoError(t, err)
+	require.NoError(t, attestedState.SetSlot(slot))
+	parent := util.NewBeaconBlockElectra()
+	parent.Block.Slot = slot
+	signedParent, err := blocks.NewSignedBeaconBlock(parent)
+	require.NoError(t, err)
+	parentHeader, err := signedParent.Header()
+	require.NoError(t, err)
+	require.NoError(t, attestedState.SetLatestBlockHeader(parentHeader.Header))
+	attestedStateRoot, err := attestedState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	parent.Block.StateRoot = attestedStateRoot[:]
+	signedParent, err = blocks.NewSignedBeaconBlock(parent)
+	require.NoError(t, err)
+	parentRoot, err := signedParent.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	st, err := util.NewBeaconStateElectra()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	block := util.NewBeaconBlockElectra()
+	block.Block.Slot = slot
+	block.Block.ParentRoot = parentRoot[:]
+	for i := uint64(0); i < params.BeaconConfig().MinSyncCommitteeParticipants; i++ {
+		block.Block.Body.SyncAggregate.SyncCommitteeBits.SetBitAt(i, true)
+	}
+	signedBlock, err := blocks.NewSignedBeaconBlock(block)
+	require.NoError(t, err)
+	h, err := signedBlock.Header()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(h.Header))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	block.Block.StateRoot = stateRoot[:]
+	signedBlock, err = blocks.NewSignedBeaconBlock(block)
+	require.NoError(t, err)
+
+	update, err := NewLightClientUpdateFromBeaconState(ctx, st, signedBlock, attestedState, signedParent, nil)
+	require.NoError(t, err)
+	electraUpdate, ok := update.(*ethpb.LightClientUpdateElectra)
+	require.Equal(t, true, ok, "Unexpected update of type %T", update)
+	_, err = electraUpdate.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, fieldparams.NextSyncCommitteeBranchDepthElectra, len(electraUpdate.NextSyncCommitteeBranch))
+	require.Equal(t, fieldparams.FinalityBranchDepthElectra, len(electraUpdate.FinalityBranch))
+
+	// The next sync committee branch proves the committee against the state root of the attested header.
+	nextSyncCommitteeRoot, err := electraUpdate.NextSyncCommittee.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, true, trie.VerifyMerkleProof(
+		electraUpdate.AttestedHeader.Beacon.StateRoot, nextSyncCommitteeRoot[:], 23, electraUpdate.NextSyncCommitteeBranch))
+
+	bootstrap, err := NewLightClientBootstrapFromBeaconState(ctx, attestedState, signedParent)
+	require.NoError(t, err)
+	electraBootstrap, ok := bootstrap.(*ethpb.LightClientBootstrapElectra)
+	require.Equal(t, true, ok, "Unexpected bootstrap of type %T", bootstrap)
+	_, err = electraBootstrap.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, fieldparams.NextSyncCommitteeBranchDepthElectra, len(electraBootstrap.CurrentSyncCommitteeBranch))
+}
+
+func testLightClientBeaconHeader(t *testing.T, blk interfaces.ReadOnlySignedBeaconBlock) *v1.BeaconBlockHeader {
+	header, err := blk.Header()
+	require.NoError(t, err)
+	return migration.V1Alpha1SignedHeaderToV1(header).GetMessage()
+}
+
+func TestLightClient_NewLightClientFinalityUpdateForFork(t *testing.T) {
+	attested := util.NewBeaconBlockDeneb()
+	attested.Block.Slot = 10
+	attested.Block.Body.ExecutionPayload.BlockNumber = 5
+	attested.Block.Body.ExecutionPayload.BlobGasUsed = 6
+	attestedBlock, err := blocks.NewSignedBeaconBlock(attested)
+	require.NoError(t, err)
+	finalized := util.NewBeaconBlockCapella()
+	finalized.Block.Slot = 2
+	finalized.Block.Body.ExecutionPayload.BlockNumber = 3
+	finalizedBlock, err := blocks.NewSignedBeaconBlock(finalized)
+	require.NoError(t, err)
+
+	update := &ethpbv2.LightClientFinalityUpdate{
+		AttestedHeader:  testLightClientBeaconHeader(t, attestedBlock),
+		FinalizedHeader: testLightClientBeaconHeader(t, finalizedBlock),
+		FinalityBranch:  testBranch(fieldparams.FinalityBranchDepth, false),
+		SyncAggregate:   &v1.SyncAggregate{SyncCommitteeBits: bitfield.NewBitvector512(), SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength)},
+		SignatureSlot:   11,
+	}
+	msg, err := NewLightClientFinalityUpdateForFork(update, attestedBlock, finalizedBlock)
+	require.NoError(t, err)
+	denebUpdate, ok := msg.(*ethpb.LightClientFinalityUpdateDeneb)
+	require.Equal(t, true, ok)
+	_, err = denebUpdate.MarshalSSZ()
+	require.NoError(t, err)
+	require.DeepEqual(t, migration.V1HeaderToV1Alpha1(update.AttestedHeader), denebUpdate.AttestedHeader.Beacon)
+	require.DeepEqual(t, update.FinalityBranch, denebUpdate.FinalityBranch)
+	require.Equal(t, uint64(5), denebUpdate.AttestedHeader.Execution.BlockNumber)
+	require.Equal(t, uint64(6), denebUpdate.AttestedHeader.Execution.BlobGasUsed)
+	require.Equal(t, uint64(3), denebUpdate.FinalizedHeader.Execution.BlockNumber)
+	require.Equal(t, uint64(0), denebUpdate.FinalizedHeader.Execution.BlobGasUsed)
+
+	// The execution branch proves the payload header against the body root of the beacon header.
+	executionRoot, err := denebUpdate.AttestedHeader.Execution.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, true, trie.VerifyMerkleProof(
+		denebUpdate.AttestedHeader.Beacon.BodyRoot, executionRoot[:], 9, denebUpdate.AttestedHeader.ExecutionBranch))
+	finalizedExecution, err := finalizedBlock.Block().Body().Execution()
+	require.NoError(t, err)
+	finalizedExecutionRoot, err := finalizedExecution.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, true, trie.VerifyMerkleProof(
+		denebUpdate.FinalizedHeader.Beacon.BodyRoot, finalizedExecutionRoot[:], 9, denebUpdate.FinalizedHeader.ExecutionBranch))
+
+	// Blinded blocks, as stored in the database, give the same headers.
+	blindedAttested, err := attestedBlock.ToBlinded()
+	require.NoError(t, err)
+	blindedFinalized, err := finalizedBlock.ToBlinded()
+	require.NoError(t, err)
+	blindedMsg, err := NewLightClientFinalityUpdateForFork(update, blindedAttested, blindedFinalized)
+	require.NoError(t, err)
+	require.DeepEqual(t, msg, blindedMsg)
+
+	// Without a finalized block, the finalized header has an empty execution header.
+	msg, err = NewLightClientFinalityUpdateForFork(update, attestedBlock, nil)
+	require.NoError(t, err)
+	denebUpdate, ok = msg.(*ethpb.LightClientFinalityUpdateDeneb)
+	require.Equal(t, true, ok)
+	require.DeepEqual(t, make([]byte, fieldparams.RootLength), denebUpdate.FinalizedHeader.Execution.BlockHash)
+	require.DeepEqual(t, testBranch(fieldparams.ExecutionBranchDepth, true), denebUpdate.FinalizedHeader.ExecutionBranch)
+}
+
+func TestLightClient_NewLightClientOptimisticUpdateForFork(t *testing.T) {
+	altair, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockAltair())
+	require.NoError(t, err)
+	update := &ethpbv2.LightClientOptimisticUpdate{AttestedHeader: testLightClientBeaconHeader(t, altair)}
+	msg, err := NewLightClientOptimisticUpdateForFork(update, altair)
+	require.NoError(t, err)
+	altairUpdate, ok := msg.(*ethpb.LightClientOptimisticUpdateAltair)
+	require.Equal(t, true, ok)
+	require.DeepEqual(t, migration.V1HeaderToV1Alpha1(update.AttestedHeader), altairUpdate.AttestedHeader.Beacon)
+
+	capella := util.NewBeaconBlockCapella()
+	capella.Block.Body.ExecutionPayload.BlockNumber = 7
+	capellaBlock, err := blocks.NewSignedBeaconBlock(capella)
+	require.NoError(t, err)
+	update = &ethpbv2.LightClientOptimisticUpdate{AttestedHeader: testLightClientBeaconHeader(t, capellaBlock)}
+	msg, err = NewLightClientOptimisticUpdateForFork(update, capellaBlock)
+	require.NoError(t, err)
+	capellaUpdate, ok := msg.(*ethpb.LightClientOptimisticUpdateCapella)
+	require.Equal(t, true, ok)
+	require.Equal(t, uint64(7), capellaUpdate.AttestedHeader.Execution.BlockNumber)
+	require.Equal(t, fieldparams.ExecutionBranchDepth, len(capellaUpdate.AttestedHeader.ExecutionBranch))
+}
+
+func testBranch(depth int, zero bool) [][]byte {
+	branch := make([][]byte, depth)
+	for i := range branch {
+		branch[i] = make([]byte, fieldparams.RootLength)
+		if !zero {
+			branch[i][0] = 1
+		}
+	}
+	return branch
+}
+
+func testLightClientUpdate(participants uint64, attestedSlot, signatureSlot primitives.Slot) *ethpb.LightClientUpdateAltair {
+	bits := bitfield.NewBitvector512()
+	for i := uint64(0); i < participants; i++ {
+		bits.SetBitAt(i, true)
+	}
+	return &ethpb.LightClientUpdateAltair{
+		AttestedHeader:          &ethpb.LightClientHeaderAltair{Beacon: &ethpb.BeaconBlockHeader{Slot: attestedSlot}},
+		NextSyncCommitteeBranch: testBranch(fieldparams.NextSyncCommitteeBranchDepth, true),
+		FinalizedHeader:         &ethpb.LightClientHeaderAltair{Beacon: &ethpb.BeaconBlockHeader{}},
+		FinalityBranch:          testBranch(fieldparams.FinalityBranchDepth, true),
+		SyncAggregate:           &ethpb.SyncAggregate{SyncCommitteeBits: bits},
+		SignatureSlot:           signatureSlot,
+	}
+}
+
+func TestLightClient_IsBetterUpdate(t *testing.T) {
+	slotsPerPeriod := primitives.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+	supermajority := uint64(512*2/3 + 1)
+
+	withSyncCommittee := func(u *ethpb.LightClientUpdateAltair) *ethpb.LightClientUpdateAltair {
+		u.NextSyncCommitteeBranch = testBranch(fieldparams.NextSyncCommitteeBranchDepth, false)
+		return u
+	}
+	withFinality := func(u *ethpb.LightClientUpdateAltair, finalizedSlot primitives.Slot) *ethpb.LightClientUpdateAltair {
+		u.FinalizedHeader = &ethpb.LightClientHeaderAltair{Beacon: &ethpb.BeaconBlockHeader{Slot: finalizedSlot}}
+		u.FinalityBranch = testBranch(fieldparams.FinalityBranchDepth, false)
+		return u
+	}
+
+	tests := []struct {
+		name      string
+		newUpdate *ethpb.LightClientUpdateAltair
+		oldUpdate *ethpb.LightClientUpdateAltair
+		want      bool
+	}{
+		{
+			name:      "supermajority beats no supermajority",
+			newUpdate: testLightClientUpdate(supermajority, 10, 11),
+			oldUpdate: withFinality(withSyncCommittee(testLightClientUpdate(supermajority-1, 10, 11)), 5),
+			want:      true,
+		},
+		{
+			name:      "no supermajority loses to supermajority",
+			newUpdate: testLightClientUpdate(supermajority-1, 10, 11),
+			oldUpdate: testLightClientUpdate(supermajority, 10, 11),
+			want:      false,
+		},
+		{
+			name:      "more participants without supermajority",
+			newUpdate: testLightClientUpdate(100, 10, 11),
+			oldUpdate: withFinality(withSyncCommittee(testLightClientUpdate(99, 10, 11)), 5),
+			want:      true,
+		},
+		{
+			name:      "relevant sync committee beats none",
+			newUpdate: withSyncCommittee(testLightClientUpdate(supermajority, 10, 11)),
+			oldUpdate: withFinality(testLightClientUpdate(supermajority+10, 10, 11), 5),
+			want:      true,
+		},
+		{
+			name:      "sync committee signed in the next period is not relevant",
+			newUpdate: withSyncCommittee(testLightClientUpdate(supermajority, slotsPerPeriod-1, slotsPerPeriod)),
+			oldUpdate: withFinality(testLightClientUpdate(supermajority, 10, 11), 5),
+			want:      false,
+		},
+		{
+			name:      "finality beats no finality",
+			newUpdate: withFinality(testLightClientUpdate(supermajority, 10, 11), 5),
+			oldUpdate: testLightClientUpdate(supermajority+10, 10, 11),
+			want:      true,
+		},
+		{
+			name:      "sync committee finality beats finality in an older period",
+			newUpdate: withFinality(testLightClientUpdate(supermajority, slotsPerPeriod+10, slotsPerPeriod+11), slotsPerPeriod+5),
+			oldUpdate: withFinality(testLightClientUpdate(supermajority+10, slotsPerPeriod+10, slotsPerPeriod+11), slotsPerPeriod-5),
+			want:      true,
+		},
+		{
+			name:      "more participants beyond supermajority",
+			newUpdate: testLightClientUpdate(supermajority+1, 10, 11),
+			oldUpdate: testLightClientUpdate(supermajority, 10, 11),
+			want:      true,
+		},
+		{
+			name:      "older attested header",
+			newUpdate: testLightClientUpdate(supermajority, 9, 11),
+			oldUpdate: testLightClientUpdate(supermajority, 10, 11),
+			want:      true,
+		},
+		{
+			name:      "older signature slot",
+			newUpdate: testLightClientUpdate(supermajority, 10, 11),
+			oldUpdate: testLightClientUpdate(supermajority, 10, 12),
+			want:      true,
+		},
+		{
+			name:      "identical updates",
+			newUpdate: testLightClientUpdate(supermajority, 10, 11),
+			oldUpdate: testLightClientUpdate(supermajority, 10, 11),
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, IsBetterUpdate(tt.newUpdate, tt.oldUpdate))
+		})
+	}
+}
+
+func TestService_SaveLightClientUpdate(t *testing.T) {
+	l := newTestLc(t).setupTest()
+	s, tr := minimalTestService(t)
+	ctx := tr.ctx
+
+	require.NoError(t, s.cfg.BeaconDB.SaveBlock(ctx, l.attestedBlock))
+
+	require.NoError(t, s.saveLightClientUpdate(ctx, l.block, l.state, l.attestedState))
+	period := slots.SyncCommitteePeriod(slots.ToEpoch(l.attestedHeader.Slot))
+	saved, err := s.cfg.BeaconDB.LightClientUpdate(ctx, period)
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+	require.Equal(t, version.Capella, saved.Version())
+	l.checkAttestedHeader(migration.V1Alpha1HeaderToV1(saved.GetAttestedHeaderVal().GetBeacon()))
+	l.checkSyncAggregate(saved.GetSyncAggregate().SyncCommitteeBits, saved.GetSyncAggregate().SyncCommitteeSignature)
+
+	// A better update already stored for the period is kept.
+	better, ok := proto.Clone(saved).(*ethpb.LightClientUpdateCapella)
+	require.Equal(t, true, ok)
+	better.SyncAggregate.SyncCommitteeBits = bitfield.NewBitvector512()
+	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSize; i++ {
+		better.SyncAggregate.SyncCommitteeBits.SetBitAt(i, true)
+	}
+	require.NoError(t, s.cfg.BeaconDB.SaveLightClientUpdate(ctx, period, better))
+	require.NoError(t, s.saveLightClientUpdate(ctx, l.block, l.state, l.attestedState))
+	saved, err = s.cfg.BeaconDB.LightClientUpdate(ctx, period)
+	require.NoError(t, err)
+	require.DeepEqual(t, better, saved)
+}
+
+func TestService_SaveLightClientUpdate_NonCanonicalBlock(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableLightClient: true})
+	defer resetCfg()
+
+	l := newTestLc(t).setupTest()
+	s, tr := minimalTestService(t, WithExecutionEngineCaller(&mockExecution.EngineClient{}))
+	ctx := tr.ctx
+
+	attestedRoot := l.block.Block().ParentRoot()
+	require.NoError(t, s.cfg.BeaconDB.SaveState(ctx, l.attestedState, attestedRoot))
+	require.NoError(t, s.cfg.BeaconDB.SaveBlock(ctx, l.attestedBlock))
+	require.NoError(t, s.cfg.BeaconDB.SaveGenesisBlockRoot(ctx, attestedRoot))
+	cp := &ethpb.Checkpoint{Root: make([]byte, fieldparams.RootLength)}
+	st, root, err := prepareForkchoiceState(ctx, l.attestedHeader.Slot, attestedRoot, [32]byte{}, [32]byte{}, cp, cp)
+	require.NoError(t, err)
+	require.NoError(t, tr.fcs.InsertNode(ctx, st, root))
+	require.NoError(t, tr.fcs.UpdateJustifiedCheckpoint(ctx, &forkchoicetypes.Checkpoint{Root: root}))
+	require.NoError(t, tr.fcs.UpdateFinalizedCheckpoint(&forkchoicetypes.Checkpoint{Root: root}))
+	tr.fcs.SetGenesisTime(uint64(time.Now().Unix()))
+
+	headRoot, err := l.block.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, s.cfg.BeaconDB.SaveBlock(ctx, l.block))
+	require.NoError(t, s.cfg.BeaconDB.SaveState(ctx, l.state, headRoot))
+	require.NoError(t, s.postBlockProcess(&postBlockProcessConfig{ctx, l.block, headRoot, [32]byte{}, l.state, l.attestedState, true}))
+	period := slots.SyncCommitteePeriod(slots.ToEpoch(l.attestedHeader.Slot))
+	saved, err := s.cfg.BeaconDB.LightClientUpdate(ctx, period)
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+	l.checkSyncAggregate(saved.GetSyncAggregate().SyncCommitteeBits, saved.GetSyncAggregate().SyncCommitteeSignature)
+
+	// A sibling of the head signed by the whole sync committee loses the tie break against the head on its
+	// lower root, but its update is better than that of the head and replaces it.
+	headPb, err := l.block.Proto()
+	require.NoError(t, err)
+	pb, ok := proto.Clone(headPb).(*ethpb.SignedBeaconBlockCapella)
+	require.Equal(t, true, ok)
+	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSize; i++ {
+		pb.Block.Body.SyncAggregate.SyncCommitteeBits.SetBitAt(i, true)
+	}
+	var forkRoot [32]byte
+	var fork interfaces.ReadOnlySignedBeaconBlock
+	var forkState state.BeaconState
+	for i := byte(0); ; i++ {
+		pb.Block.Body.Graffiti[0] = i
+		pb.Block.StateRoot = make([]byte, fieldparams.RootLength)
+		fork, err = blocks.NewSignedBeaconBlock(pb)
+		require.NoError(t, err)
+		forkHeader, err := fork.Header()
+		require.NoError(t, err)
+		forkState = l.state.Copy()
+		require.NoError(t, forkState.SetLatestBlockHeader(forkHeader.Header))
+		stateRoot, err := forkState.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		pb.Block.StateRoot = stateRoot[:]
+		fork, err = blocks.NewSignedBeaconBlock(pb)
+		require.NoError(t, err)
+		forkRoot, err = pb.Block.HashTreeRoot()
+		require.NoError(t, err)
+		if bytes.Compare(forkRoot[:], headRoot[:]) < 0 {
+			break
+		}
+	}
+	require.NoError(t, s.cfg.BeaconDB.SaveBlock(ctx, fork))
+	require.NoError(t, s.postBlockProcess(&postBlockProcessConfig{ctx, fork, forkRoot, [32]byte{}, forkState, l.attestedState, true}))
+	fcHead, err := tr.fcs.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, headRoot, fcHead)
+
+	stored, err := s.cfg.BeaconDB.LightClientUpdate(ctx, period)
+	require.NoError(t, err)
+	require.Equal(t, params.BeaconConfig().SyncCommitteeSize, stored.GetSyncAggregate().SyncCommitteeBits.Count())
+	require.DeepEqual(t, saved.GetAttestedHeaderVal(), stored.GetAttestedHeaderVal())
+}
@@ -51,6 +51,7 @@ type postBlockProcessConfig struct {
 	blockRoot      [32]byte
 	headRoot       [32]byte
 	postState      state.BeaconState
+	attestedState  state.BeaconState
 	isValidPayload bool
 }
 
@@ -24,6 +24,7 @@ import (
 	mathutil "github.com/prysmaticlabs/prysm/v5/math"
 	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
@@ -115,7 +116,7 @@ func (s *Service) sendStateFeedOnBlock(cfg *postBlockProcessConfig) {
 // sendLightClientFeeds sends the light client feeds when feature flag is enabled.
 func (s *Service) sendLightClientFeeds(cfg *postBlockProcessConfig) {
 	if features.Get().EnableLightClient {
-		if _, err := s.sendLightClientOptimisticUpdate(cfg.ctx, cfg.signed, cfg.postState); err != nil {
+		if _, err := s.sendLightClientOptimisticUpdate(cfg.ctx, cfg.signed, cfg.postState, cfg.attestedState); err != nil {
 			log.WithError(err).Error("Failed to send light client optimistic update")
 		}
 
@@ -123,11 +124,83 @@ func (s *Service) sendLightClientFeeds(cfg *postBlockProcessConfig) {
 		finalized := s.ForkChoicer().FinalizedCheckpoint()
 
 		// LightClientFinalityUpdate needs super majority
-		s.tryPublishLightClientFinalityUpdate(cfg.ctx, cfg.signed, finalized, cfg.postState)
+		s.tryPublishLightClientFinalityUpdate(cfg.ctx, cfg.signed, finalized, cfg.postState, cfg.attestedState)
+
+		// The update of every imported block is ranked against the stored one, so that the best update
+		// of a period is kept whichever fork becomes canonical.
+		if err := s.saveLightClientUpdate(cfg.ctx, cfg.signed, cfg.postState, cfg.attestedState); err != nil {
+			log.WithError(err).Error("Failed to save light client update")
+		}
+	}
+}
+
+// saveLightClientUpdate computes the light client update signed by the given block and stores it
+// for the sync committee period of its attested header, if it is better than the update already stored.
+func (s *Service) saveLightClientUpdate(ctx context.Context, signed interfaces.ReadOnlySignedBeaconBlock,
+	postState, attestedState state.BeaconState) error {
+	if signed.Version() < version.Altair {
+		return nil
+	}
+	syncAggregate, err := signed.Block().Body().SyncAggregate()
+	if err != nil || syncAggregate == nil {
+		return nil
+	}
+	if syncAggregate.SyncCommitteeBits.Count() < params.BeaconConfig().MinSyncCommitteeParticipants {
+		return nil
+	}
+
+	if attestedState == nil || attestedState.IsNil() {
+		return errors.New("nil attested state")
+	}
+	if slots.ToEpoch(attestedState.Slot()) < params.BeaconConfig().AltairForkEpoch {
+		return nil
+	}
+	// An update signed in a later period than its attested header is kept without a next sync committee.
+	period := slots.SyncCommitteePeriod(slots.ToEpoch(attestedState.LatestBlockHeader().Slot))
+
+	attestedBlock, err := s.getBlock(ctx, signed.Block().ParentRoot())
+	if err != nil {
+		return errors.Wrap(err, "could not get attested block")
+	}
+
+	update, err := NewLightClientUpdateFromBeaconState(
+		ctx,
+		postState,
+		signed,
+		attestedState,
+		attestedBlock,
+		s.lightClientFinalizedBlock(ctx, attestedState),
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not create light client update")
+	}
+
+	oldUpdate, err := s.cfg.BeaconDB.LightClientUpdate(ctx, period)
+	if err != nil {
+		return errors.Wrapf(err, "could not get light client update for period %d", period)
+	}
+	if oldUpdate != nil && !IsBetterUpdate(update, oldUpdate) {
+		return nil
+	}
+
+	return s.cfg.BeaconDB.SaveLightClientUpdate(ctx, period, update)
+}
+
+// lightClientFinalizedBlock returns the block of the attested state's finalized checkpoint,
+// or nil if it is not available.
+func (s *Service) lightClientFinalizedBlock(ctx context.Context, attestedState state.BeaconState) interfaces.ReadOnlySignedBeaconBlock {
+	finalizedCheckPoint := attestedState.FinalizedCheckpoint()
+	if finalizedCheckPoint == nil {
+		return nil
+	}
+	finalizedBlock, err := s.cfg.BeaconDB.Block(ctx, bytesutil.ToBytes32(finalizedCheckPoint.Root))
+	if err != nil {
+		return nil
 	}
+	return finalizedBlock
 }
 
-func (s *Service) tryPublishLightClientFinalityUpdate(ctx context.Context, signed interfaces.ReadOnlySignedBeaconBlock, finalized *forkchoicetypes.Checkpoint, postState state.BeaconState) {
+func (s *Service) tryPublishLightClientFinalityUpdate(ctx context.Context, signed interfaces.ReadOnlySignedBeaconBlock, finalized *forkchoicetypes.Checkpoint, postState, attestedState state.BeaconState) {
 	if finalized.Epoch <= s.lastPublishedLightClientEpoch {
 		return
 	}
@@ -147,7 +220,7 @@ func (s *Service) tryPublishLightClientFinalityUpdate(ctx context.Context, signe
 		return
 	}
 
-	_, err = s.sendLightClientFinalityUpdate(ctx, signed, postState)
+	_, err = s.sendLightClientFinalityUpdate(ctx, signed, postState, attestedState)
 	if err != nil {
 		log.WithError(err).Error("Failed to send light client finality update")
 	} else {
@@ -157,23 +230,9 @@ func (s *Service) tryPublishLightClientFinalityUpdate(ctx context.Context, signe
 
 // sendLightClientFinalityUpdate sends a light client finality update notification to the state feed.
 func (s *Service) sendLightClientFinalityUpdate(ctx context.Context, signed interfaces.ReadOnlySignedBeaconBlock,
-	postState state.BeaconState) (int, error) {
-	// Get attested state
-	attestedRoot := signed.Block().ParentRoot()
-	attestedState, err := s.cfg.StateGen.StateByRoot(ctx, attestedRoot)
-	if err != nil {
-		return 0, errors.Wrap(err, "could not get attested state")
-	}
-
-	// Get finalized block
-	var finalizedBlock interfaces.ReadOnlySignedBeaconBlock
-	finalizedCheckPoint := attestedState.FinalizedCheckpoint()
-	if finalizedCheckPoint != nil {
-		finalizedRoot := bytesutil.ToBytes32(finalizedCheckPoint.Root)
-		finalizedBlock, err = s.cfg.BeaconDB.Block(ctx, finalizedRoot)
-		if err != nil {
-			finalizedBlock = nil
-		}
+	postState, attestedState state.BeaconState) (int, error) {
+	if attestedState == nil || attestedState.IsNil() {
+		return 0, errors.New("nil attested state")
 	}
 
 	update, err := NewLightClientFinalityUpdateFromBeaconState(
@@ -181,7 +240,7 @@ func (s *Service) sendLightClientFinalityUpdate(ctx context.Context, signed inte
 		postState,
 		signed,
 		attestedState,
-		finalizedBlock,
+		s.lightClientFinalizedBlock(ctx, attestedState),
 	)
 
 	if err != nil {
@@ -203,12 +262,9 @@ func (s *Service) sendLightClientFinalityUpdate(ctx context.Context, signed inte
 
 // sendLightClientOptimisticUpdate sends a light client optimistic update notification to the state feed.
 func (s *Service) sendLightClientOptimisticUpdate(ctx context.Context, signed interfaces.ReadOnlySignedBeaconBlock,
-	postState state.BeaconState) (int, error) {
-	// Get attested state
-	attestedRoot := signed.Block().ParentRoot()
-	attestedState, err := s.cfg.StateGen.StateByRoot(ctx, attestedRoot)
-	if err != nil {
-		return 0, errors.Wrap(err, "could not get attested state")
+	postState, attestedState state.BeaconState) (int, error) {
+	if attestedState == nil || attestedState.IsNil() {
+		return 0, errors.New("nil attested state")
 	}
 
 	update, err := NewLightClientOptimisticUpdateFromBeaconState(
@@ -85,6 +85,12 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySig
 	if err != nil {
 		return errors.Wrap(err, "could not get block's prestate")
 	}
+	// The light client updates are attested by the post-state of the parent block, which the state
+	// transition below mutates in place.
+	var attestedState state.BeaconState
+	if features.Get().EnableLightClient {
+		attestedState = preState.Copy()
+	}
 	// Save current justified and finalized epochs for future use.
 	currStoreJustifiedEpoch := s.CurrentJustifiedCheckpt().Epoch
 	currStoreFinalizedEpoch := s.FinalizedCheckpt().Epoch
@@ -143,6 +149,7 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySig
 		signed:         blockCopy,
 		blockRoot:      blockRoot,
 		postState:      postState,
+		attestedState:  attestedState,
 		isValidPayload: isValidPayload,
 	}
 	if err := s.postBlockProcess(args); err != nil {
@@ -27,4 +27,33 @@ var (
 			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
 		},
 	)
+	relayRequestLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "builder_relay_request_latency_milliseconds",
+			Help:    "Captures RPC latency of requests to each builder relay in milliseconds",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
+		},
+		[]string{"relay", "method"},
+	)
+	relayRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_requests_total",
+			Help: "Count the number of requests to each builder relay, by method and result",
+		},
+		[]string{"relay", "method", "result"},
+	)
+	relayBidsWon = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_bids_won_total",
+			Help: "Count the number of times the bid of each builder relay was selected",
+		},
+		[]string{"relay"},
+	)
+	relayCircuitBreakerOpen = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "builder_relay_circuit_breaker_open",
+			Help: "Set to 1 while requests to a builder relay are skipped after repeated failures, 0 otherwise",
+		},
+		[]string{"relay"},
+	)
 )
@@ -1,6 +1,9 @@
 package builder
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
@@ -13,25 +16,49 @@ type Option func(s *Service) error
 
 // FlagOptions for builder service flag configurations.
 func FlagOptions(c *cli.Context) ([]Option, error) {
-	endpoint := c.String(flags.MevRelayEndpoint.Name)
-	var client *builder.Client
-	if endpoint != "" {
-		var err error
-		client, err = builder.NewClient(endpoint)
+	var clientOpts []builder.ClientOpt
+	if c.Bool(flags.EnableBuilderSSZ.Name) {
+		clientOpts = append(clientOpts, builder.WithSSZ())
+	}
+	var clients []builder.BuilderClient
+	for _, endpoint := range c.StringSlice(flags.MevRelayEndpoint.Name) {
+		if endpoint == "" {
+			continue
+		}
+		client, err := builder.NewClient(endpoint, clientOpts...)
 		if err != nil {
 			return nil, err
 		}
+		clients = append(clients, client)
 	}
 	opts := []Option{
-		WithBuilderClient(client),
+		WithBuilderClients(clients...),
+		WithGetHeaderTimeout(c.Duration(flags.MevRelayGetHeaderTimeout.Name)),
 	}
 	return opts, nil
 }
 
-// WithBuilderClient sets the builder client for the beacon chain builder service.
+// WithBuilderClient adds a builder client for the beacon chain builder service.
 func WithBuilderClient(client builder.BuilderClient) Option {
+	return WithBuilderClients(client)
+}
+
+// WithBuilderClients adds builder clients for the beacon chain builder service. Bids are requested
+// from all of them and the highest valid bid is used.
+func WithBuilderClients(clients ...builder.BuilderClient) Option {
 	return func(s *Service) error {
-		s.cfg.builderClient = client
+		s.cfg.builderClients = append(s.cfg.builderClients, clients...)
+		return nil
+	}
+}
+
+// WithGetHeaderTimeout sets how long relays are given to return a bid.
+func WithGetHeaderTimeout(timeout time.Duration) Option {
+	return func(s *Service) error {
+		if timeout <= 0 {
+			return fmt.Errorf("relay get header timeout must be positive, got %s", timeout)
+		}
+		s.cfg.getHeaderTimeout = timeout
 		return nil
 	}
 }
@@ -0,0 +1,72 @@
+package builder
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
+)
+
+const (
+	// relayFailureThreshold is the number of consecutive failed requests after which a relay is skipped.
+	relayFailureThreshold = 3
+	// relayCooldown is how long a relay is skipped for once its circuit breaker opens.
+	relayCooldown = time.Minute
+)
+
+// relay wraps a builder client with the circuit breaker state used to skip relays that keep failing.
+type relay struct {
+	client builder.BuilderClient
+	name   string
+
+	lock                sync.Mutex
+	consecutiveFailures int
+	openUntil           time.Time
+}
+
+func newRelay(client builder.BuilderClient, name string) *relay {
+	return &relay{
+		client: client,
+		name:   name,
+	}
+}
+
+// relayName returns the host and path of the relay endpoint, so that credentials embedded in the URL
+// do not end up in logs or metric labels.
+func relayName(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return endpoint
+	}
+	return u.Host + strings.TrimSuffix(u.Path, "/")
+}
+
+// available returns false while the relay's circuit breaker is open.
+func (r *relay) available(now time.Time) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return !now.Before(r.openUntil)
+}
+
+// recordSuccess closes the relay's circuit breaker.
+func (r *relay) recordSuccess() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.consecutiveFailures = 0
+	r.openUntil = time.Time{}
+	relayCircuitBreakerOpen.WithLabelValues(r.name).Set(0)
+}
+
+// recordFailure opens the relay's circuit breaker once it has failed relayFailureThreshold times in a row.
+// A relay that fails again after its cooldown is skipped for another cooldown straight away.
+func (r *relay) recordFailure(now time.Time) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.consecutiveFailures++
+	if r.consecutiveFailures >= relayFailureThreshold {
+		r.openUntil = now.Add(relayCooldown)
+		relayCircuitBreakerOpen.WithLabelValues(r.name).Set(1)
+	}
+}
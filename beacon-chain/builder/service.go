@@ -1,18 +1,25 @@
 package builder
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"math/big"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/math"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
 	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -23,6 +30,26 @@ import (
 // ErrNoBuilder is used when builder endpoint is not configured.
 var ErrNoBuilder = errors.New("builder endpoint not configured")
 
+// ErrNoRelayAvailable is used when every configured relay is skipped after repeated failures.
+var ErrNoRelayAvailable = errors.New("no builder relay available")
+
+// defaultRelayGetHeaderTimeout bounds how long relays are given to return a bid, unless configured otherwise. It is
+// kept below the proposer's builder timeout so that the bids which did arrive can still be compared.
+const defaultRelayGetHeaderTimeout = 900 * time.Millisecond
+
+// Labels of the per relay metrics.
+const (
+	getHeaderMethod          = "get_header"
+	submitBlindedBlockMethod = "submit_blinded_block"
+	registerValidatorMethod  = "register_validator"
+
+	resultSuccess   = "success"
+	resultError     = "error"
+	resultInvalid   = "invalid"
+	resultNoContent = "no_content"
+	resultSkipped   = "skipped"
+)
+
 // BlockBuilder defines the interface for interacting with the block builder
 type BlockBuilder interface {
 	SubmitBlindedBlock(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error)
@@ -34,18 +61,29 @@ type BlockBuilder interface {
 
 // config defines a config struct for dependencies into the service.
 type config struct {
-	builderClient builder.BuilderClient
-	beaconDB      db.HeadAccessDatabase
-	headFetcher   blockchain.HeadFetcher
+	builderClients   []builder.BuilderClient
+	getHeaderTimeout time.Duration
+	beaconDB         db.HeadAccessDatabase
+	headFetcher      blockchain.HeadFetcher
 }
 
 // Service defines a service that provides a client for interacting with the beacon chain and MEV relay network.
 type Service struct {
 	cfg               *config
-	c                 builder.BuilderClient
+	relays            []*relay
 	ctx               context.Context
 	cancel            context.CancelFunc
 	registrationCache *cache.RegistrationCache
+	bidsLock          sync.Mutex
+	bids              map[[32]byte]*relayBid
+}
+
+// relayBid is a bid returned by a relay, keyed by the block hash of its header once it won an auction.
+type relayBid struct {
+	relay *relay
+	slot  primitives.Slot
+	bid   builder.SignedBid
+	value *big.Int
 }
 
 // NewService instantiates a new service.
@@ -54,25 +92,40 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	s := &Service{
 		ctx:    ctx,
 		cancel: cancel,
-		cfg:    &config{},
+		cfg:    &config{getHeaderTimeout: defaultRelayGetHeaderTimeout},
+		bids:   make(map[[32]byte]*relayBid),
 	}
 	for _, opt := range opts {
 		if err := opt(s); err != nil {
 			return nil, err
 		}
 	}
-	if s.cfg.builderClient != nil && !reflect.ValueOf(s.cfg.builderClient).IsNil() {
-		s.c = s.cfg.builderClient
+	names := make(map[string]bool)
+	for i, c := range s.cfg.builderClients {
+		if c == nil || reflect.ValueOf(c).IsNil() {
+			continue
+		}
+		// Relays that only differ by the credentials of their URL are told apart by their position in the
+		// configuration.
+		name := relayName(c.NodeURL())
+		if names[name] {
+			name = fmt.Sprintf("%s#%d", name, i)
+		}
+		names[name] = true
+		r := newRelay(c, name)
+		s.relays = append(s.relays, r)
 
 		// Is the builder up?
-		if err := s.c.Status(ctx); err != nil {
-			log.WithError(err).Error("Failed to check builder status")
+		if err := c.Status(ctx); err != nil {
+			log.WithError(err).WithField("relay", r.name).Error("Failed to check builder status")
 		} else {
-			log.WithField("endpoint", s.c.NodeURL()).Info("Builder has been configured")
-			log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
-				"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+			log.WithField("endpoint", r.name).Info("Builder has been configured")
 		}
 	}
+	if len(s.relays) > 0 {
+		log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
+			"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+	}
 	return s, nil
 }
 
@@ -87,7 +140,8 @@ func (s *Service) Stop() error {
 	return nil
 }
 
-// SubmitBlindedBlock submits a blinded block to the builder relay network.
+// SubmitBlindedBlock submits a blinded block to the relay whose bid it was built from. If the bid was not
+// obtained through this service, the block is submitted to each relay in turn until one of them accepts it.
 func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.SubmitBlindedBlock")
 	defer span.End()
@@ -95,14 +149,61 @@ func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlyS
 	defer func() {
 		submitBlindedBlockLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		return nil, nil, ErrNoBuilder
 	}
 
-	return s.c.SubmitBlindedBlock(ctx, b)
+	if r := s.relayForBlock(b); r != nil {
+		return s.submitBlindedBlock(ctx, r, b)
+	}
+	var err error
+	for _, r := range s.relays {
+		var payload interfaces.ExecutionData
+		var bundle *v1.BlobsBundle
+		payload, bundle, err = s.submitBlindedBlock(ctx, r, b)
+		if err == nil {
+			return payload, bundle, nil
+		}
+		log.WithError(err).WithField("relay", r.name).Debug("Relay did not accept blinded block")
+	}
+	tracing.AnnotateError(span, err)
+	return nil, nil, err
+}
+
+func (s *Service) submitBlindedBlock(ctx context.Context, r *relay, b interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	start := time.Now()
+	payload, bundle, err := r.client.SubmitBlindedBlock(ctx, b)
+	relayRequestLatency.WithLabelValues(r.name, submitBlindedBlockMethod).Observe(float64(time.Since(start).Milliseconds()))
+	if err != nil {
+		relayRequests.WithLabelValues(r.name, submitBlindedBlockMethod, resultError).Inc()
+		r.recordFailure(time.Now())
+		return nil, nil, errors.Wrapf(err, "could not submit blinded block to relay %s", r.name)
+	}
+	relayRequests.WithLabelValues(r.name, submitBlindedBlockMethod, resultSuccess).Inc()
+	r.recordSuccess()
+	return payload, bundle, nil
+}
+
+// relayForBlock returns the relay whose bid the blinded block was built from, or nil if it is unknown.
+func (s *Service) relayForBlock(b interfaces.ReadOnlySignedBeaconBlock) *relay {
+	if b == nil || b.IsNil() {
+		return nil
+	}
+	header, err := b.Block().Body().Execution()
+	if err != nil || header == nil || header.IsNil() {
+		return nil
+	}
+	s.bidsLock.Lock()
+	defer s.bidsLock.Unlock()
+	winner, ok := s.bids[bytesutil.ToBytes32(header.BlockHash())]
+	if !ok {
+		return nil
+	}
+	return winner.relay
 }
 
-// GetHeader retrieves the header for a given slot and parent hash from the builder relay network.
+// GetHeader requests a header from every available relay in parallel and returns the valid bid with
+// the highest value. Relays which do not answer within the configured get header timeout are ignored.
 func (s *Service) GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) (builder.SignedBid, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.GetHeader")
 	defer span.End()
@@ -110,20 +211,159 @@ func (s *Service) GetHeader(ctx context.Context, slot primitives.Slot, parentHas
 	defer func() {
 		getHeaderLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		tracing.AnnotateError(span, ErrNoBuilder)
 		return nil, ErrNoBuilder
 	}
 
-	h, err := s.c.GetHeader(ctx, slot, parentHash, pubKey)
-	tracing.AnnotateError(span, err)
-	return h, err
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.getHeaderTimeout)
+	defer cancel()
+
+	type result struct {
+		bid *relayBid
+		err error
+	}
+	results := make(chan result, len(s.relays))
+	requested := 0
+	for _, r := range s.relays {
+		if !r.available(start) {
+			relayRequests.WithLabelValues(r.name, getHeaderMethod, resultSkipped).Inc()
+			continue
+		}
+		requested++
+		go func(r *relay) {
+			bid, err := s.relayHeader(ctx, r, slot, parentHash, pubKey)
+			results <- result{bid: bid, err: err}
+		}(r)
+	}
+	if requested == 0 {
+		tracing.AnnotateError(span, ErrNoRelayAvailable)
+		return nil, ErrNoRelayAvailable
+	}
+
+	var best *relayBid
+	var err error
+	for i := 0; i < requested; i++ {
+		res := <-results
+		if res.err != nil {
+			// Keep the most relevant error, a relay without a header is not a failure.
+			if err == nil || errors.Is(err, builder.ErrNoContent) {
+				err = res.err
+			}
+			continue
+		}
+		if best == nil || res.bid.value.Cmp(best.value) > 0 {
+			best = res.bid
+		}
+	}
+	if best == nil {
+		tracing.AnnotateError(span, err)
+		return nil, err
+	}
+
+	relayBidsWon.WithLabelValues(best.relay.name).Inc()
+	if err := s.saveWinningBid(best); err != nil {
+		log.WithError(err).Debug("Could not record winning relay bid")
+	}
+	log.WithFields(log.Fields{
+		"relay":     best.relay.name,
+		"slot":      slot,
+		"bidCount":  requested,
+		"gweiValue": math.WeiToGwei(best.value),
+	}).Debug("Selected builder bid")
+	return best.bid, nil
+}
+
+// relayHeader requests a header from the relay and validates the returned bid.
+func (s *Service) relayHeader(ctx context.Context, r *relay, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) (*relayBid, error) {
+	start := time.Now()
+	signedBid, err := r.client.GetHeader(ctx, slot, parentHash, pubKey)
+	relayRequestLatency.WithLabelValues(r.name, getHeaderMethod).Observe(float64(time.Since(start).Milliseconds()))
+	if errors.Is(err, builder.ErrNoContent) {
+		relayRequests.WithLabelValues(r.name, getHeaderMethod, resultNoContent).Inc()
+		r.recordSuccess()
+		return nil, err
+	}
+	if err != nil {
+		relayRequests.WithLabelValues(r.name, getHeaderMethod, resultError).Inc()
+		r.recordFailure(time.Now())
+		log.WithError(err).WithField("relay", r.name).Debug("Could not get header from relay")
+		return nil, errors.Wrapf(err, "could not get header from relay %s", r.name)
+	}
+	value, err := validateBid(signedBid, parentHash)
+	if err != nil {
+		relayRequests.WithLabelValues(r.name, getHeaderMethod, resultInvalid).Inc()
+		r.recordFailure(time.Now())
+		log.WithError(err).WithField("relay", r.name).Warn("Relay returned an invalid bid")
+		return nil, errors.Wrapf(err, "invalid bid from relay %s", r.name)
+	}
+	relayRequests.WithLabelValues(r.name, getHeaderMethod, resultSuccess).Inc()
+	r.recordSuccess()
+	return &relayBid{relay: r, slot: slot, bid: signedBid, value: value}, nil
+}
+
+// validateBid checks that the bid builds on the requested parent, carries a value and is signed by the
+// builder it claims to come from. It returns the value of the bid in wei.
+func validateBid(signedBid builder.SignedBid, parentHash [32]byte) (*big.Int, error) {
+	if signedBid == nil || signedBid.IsNil() {
+		return nil, errors.New("nil builder bid")
+	}
+	bid, err := signedBid.Message()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid")
+	}
+	if bid == nil || bid.IsNil() {
+		return nil, errors.New("nil builder bid")
+	}
+	value := bytesutil.LittleEndianBytesToBigInt(bid.Value())
+	if value.Sign() <= 0 {
+		return nil, errors.New("bid has no value")
+	}
+	header, err := bid.Header()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid header")
+	}
+	if !bytes.Equal(header.ParentHash(), parentHash[:]) {
+		return nil, fmt.Errorf("incorrect parent hash %#x != %#x", header.ParentHash(), parentHash)
+	}
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder,
+		nil, /* fork version */
+		nil /* genesis val root */)
+	if err != nil {
+		return nil, err
+	}
+	if err := signing.VerifySigningRoot(bid, bid.Pubkey(), signedBid.Signature(), d); err != nil {
+		return nil, errors.Wrap(err, "could not verify builder signature")
+	}
+	return value, nil
+}
+
+// saveWinningBid records which relay provided the winning bid, so that the blinded block built from it is
+// submitted to the same relay. Bids older than an epoch are dropped.
+func (s *Service) saveWinningBid(b *relayBid) error {
+	bid, err := b.bid.Message()
+	if err != nil {
+		return err
+	}
+	header, err := bid.Header()
+	if err != nil {
+		return err
+	}
+	s.bidsLock.Lock()
+	defer s.bidsLock.Unlock()
+	for hash, old := range s.bids {
+		if old.slot+params.BeaconConfig().SlotsPerEpoch < b.slot {
+			delete(s.bids, hash)
+		}
+	}
+	s.bids[bytesutil.ToBytes32(header.BlockHash())] = b
+	return nil
 }
 
 // Status retrieves the status of the builder relay network.
 func (s *Service) Status() error {
 	// Return early if builder isn't initialized in service.
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		return nil
 	}
 
@@ -139,7 +379,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 	defer func() {
 		registerValidatorLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		return ErrNoBuilder
 	}
 
@@ -164,7 +404,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 		valid = append(valid, r)
 		indexToRegistration[nx] = r.Message
 	}
-	if err := s.c.RegisterValidator(ctx, valid); err != nil {
+	if err := s.registerValidator(ctx, valid); err != nil {
 		return errors.Wrap(err, "could not register validator(s)")
 	}
 
@@ -179,6 +419,42 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 	}
 }
 
+// registerValidator sends the registrations to every relay in parallel. It only fails if none of the relays
+// accepted them, as a relay which missed a registration still gets it on the next epoch.
+func (s *Service) registerValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error {
+	errs := make(chan error, len(s.relays))
+	for _, r := range s.relays {
+		go func(r *relay) {
+			start := time.Now()
+			err := r.client.RegisterValidator(ctx, reg)
+			relayRequestLatency.WithLabelValues(r.name, registerValidatorMethod).Observe(float64(time.Since(start).Milliseconds()))
+			if err != nil {
+				relayRequests.WithLabelValues(r.name, registerValidatorMethod, resultError).Inc()
+				r.recordFailure(time.Now())
+				log.WithError(err).WithField("relay", r.name).Warn("Could not register validators with relay")
+				errs <- errors.Wrapf(err, "relay %s", r.name)
+				return
+			}
+			relayRequests.WithLabelValues(r.name, registerValidatorMethod, resultSuccess).Inc()
+			r.recordSuccess()
+			errs <- nil
+		}(r)
+	}
+	var err error
+	registered := 0
+	for range s.relays {
+		if e := <-errs; e != nil {
+			err = e
+		} else {
+			registered++
+		}
+	}
+	if registered == 0 {
+		return err
+	}
+	return nil
+}
+
 // RegistrationByValidatorID returns either the values from the cache or db.
 func (s *Service) RegistrationByValidatorID(ctx context.Context, id primitives.ValidatorIndex) (*ethpb.ValidatorRegistrationV1, error) {
 	if s.registrationCache != nil {
@@ -193,7 +469,7 @@ func (s *Service) RegistrationByValidatorID(ctx context.Context, id primitives.V
 
 // Configured returns true if the user has configured a builder client.
 func (s *Service) Configured() bool {
-	return s.c != nil && !reflect.ValueOf(s.c).IsNil()
+	return len(s.relays) > 0
 }
 
 func (s *Service) pollRelayerStatus(ctx context.Context) {
@@ -202,9 +478,9 @@ func (s *Service) pollRelayerStatus(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			if s.c != nil {
-				if err := s.c.Status(ctx); err != nil {
-					log.WithError(err).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
+			for _, r := range s.relays {
+				if err := r.client.Status(ctx); err != nil {
+					log.WithError(err).WithField("relay", r.name).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
 				}
 			}
 		case <-ctx.Done():
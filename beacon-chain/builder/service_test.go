@@ -2,16 +2,27 @@ package builder
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
 	buildertesting "github.com/prysmaticlabs/prysm/v5/api/client/builder/testing"
 	blockchainTesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	dbtesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
 )
 
 func Test_NewServiceWithBuilder(t *testing.T) {
@@ -68,3 +79,208 @@ func Test_BuilderMethodsWithouClient(t *testing.T) {
 	err = s.RegisterValidator(context.Background(), nil)
 	assert.ErrorContains(t, ErrNoBuilder.Error(), err)
 }
+
+// testRelay is a builder client returning a fixed bid and counting the requests it received.
+type testRelay struct {
+	url         string
+	bid         builder.SignedBid
+	getErr      error
+	registerErr error
+
+	lock       sync.Mutex
+	getCalls   int
+	submitted  int
+	registered int
+}
+
+func (r *testRelay) NodeURL() string {
+	return r.url
+}
+
+func (r *testRelay) GetHeader(_ context.Context, _ primitives.Slot, _ [32]byte, _ [48]byte) (builder.SignedBid, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.getCalls++
+	return r.bid, r.getErr
+}
+
+func (r *testRelay) RegisterValidator(_ context.Context, _ []*eth.SignedValidatorRegistrationV1) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.registered++
+	return r.registerErr
+}
+
+func (r *testRelay) SubmitBlindedBlock(_ context.Context, _ interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.submitted++
+	return nil, nil, nil
+}
+
+func (r *testRelay) Status(_ context.Context) error {
+	return nil
+}
+
+// testSignedBid returns a bid of the builder sk, signed by signer.
+func testSignedBid(t *testing.T, sk, signer bls.SecretKey, parentHash, blockHash [32]byte, value uint64) builder.SignedBid {
+	bid := &eth.BuilderBidCapella{
+		Header: &v1.ExecutionPayloadHeaderCapella{
+			ParentHash:       parentHash[:],
+			FeeRecipient:     make([]byte, 20),
+			StateRoot:        make([]byte, 32),
+			ReceiptsRoot:     make([]byte, 32),
+			LogsBloom:        make([]byte, 256),
+			PrevRandao:       make([]byte, 32),
+			BaseFeePerGas:    make([]byte, 32),
+			BlockHash:        blockHash[:],
+			TransactionsRoot: make([]byte, 32),
+			WithdrawalsRoot:  make([]byte, 32),
+		},
+		Value:  bytesutil.PadTo(bytesutil.Bytes8(value), 32),
+		Pubkey: sk.PublicKey().Marshal(),
+	}
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	sr, err := signing.ComputeSigningRoot(bid, d)
+	require.NoError(t, err)
+	signed, err := builder.WrappedSignedBuilderBidCapella(&eth.SignedBuilderBidCapella{Message: bid, Signature: signer.Sign(sr[:]).Marshal()})
+	require.NoError(t, err)
+	return signed
+}
+
+func testBlindedBlock(t *testing.T, blockHash [32]byte) interfaces.ReadOnlySignedBeaconBlock {
+	b := util.NewBlindedBeaconBlockCapella()
+	b.Block.Body.ExecutionPayloadHeader.BlockHash = blockHash[:]
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return blk
+}
+
+func Test_GetHeader_HighestValidBidWins(t *testing.T) {
+	ctx := context.Background()
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	otherSk, err := bls.RandKey()
+	require.NoError(t, err)
+	parentHash := [32]byte{'p'}
+
+	low := &testRelay{url: "https://low.example", bid: testSignedBid(t, sk, sk, parentHash, [32]byte{'a'}, 1)}
+	high := &testRelay{url: "https://0xabcd@high.example", bid: testSignedBid(t, sk, sk, parentHash, [32]byte{'b'}, 2)}
+	// A higher bid with a signature that does not match its builder key is ignored.
+	bad := &testRelay{url: "https://bad.example", bid: testSignedBid(t, sk, otherSk, parentHash, [32]byte{'c'}, 5)}
+	// A higher bid on the wrong parent is ignored.
+	wrongParent := &testRelay{url: "https://parent.example", bid: testSignedBid(t, sk, sk, [32]byte{'x'}, [32]byte{'d'}, 10)}
+	failing := &testRelay{url: "https://failing.example", getErr: errors.New("unavailable")}
+
+	s, err := NewService(ctx, WithBuilderClients(low, high, bad, wrongParent, failing))
+	require.NoError(t, err)
+	require.Equal(t, 5, len(s.relays))
+	assert.Equal(t, "high.example", s.relays[1].name)
+
+	bid, err := s.GetHeader(ctx, 1, parentHash, [48]byte{})
+	require.NoError(t, err)
+	msg, err := bid.Message()
+	require.NoError(t, err)
+	header, err := msg.Header()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{'b'}, header.BlockHash()[:1])
+
+	// The blinded block is only submitted to the relay whose bid won.
+	_, _, err = s.SubmitBlindedBlock(ctx, testBlindedBlock(t, [32]byte{'b'}))
+	require.NoError(t, err)
+	assert.Equal(t, 1, high.submitted)
+	assert.Equal(t, 0, low.submitted)
+}
+
+func Test_GetHeader_NoContent(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewService(ctx, WithBuilderClients(
+		&testRelay{url: "https://a.example", getErr: builder.ErrNoContent},
+		&testRelay{url: "https://b.example", getErr: builder.ErrNoContent},
+	))
+	require.NoError(t, err)
+	_, err = s.GetHeader(ctx, 1, [32]byte{}, [48]byte{})
+	require.ErrorIs(t, err, builder.ErrNoContent)
+}
+
+func Test_SubmitBlindedBlock_UnknownBid(t *testing.T) {
+	ctx := context.Background()
+	first := &testRelay{url: "https://a.example"}
+	second := &testRelay{url: "https://b.example"}
+	s, err := NewService(ctx, WithBuilderClients(first, second))
+	require.NoError(t, err)
+
+	// Without a recorded bid the block is submitted to the relays in turn until one accepts it.
+	_, _, err = s.SubmitBlindedBlock(ctx, testBlindedBlock(t, [32]byte{'z'}))
+	require.NoError(t, err)
+	assert.Equal(t, 1, first.submitted)
+	assert.Equal(t, 0, second.submitted)
+}
+
+func Test_RegisterValidator_MultipleRelays(t *testing.T) {
+	ctx := context.Background()
+	headFetcher := &blockchainTesting.ChainService{}
+	ok := &testRelay{url: "https://ok.example"}
+	failing := &testRelay{url: "https://failing.example", registerErr: errors.New("unavailable")}
+	s, err := NewService(ctx, WithRegistrationCache(), WithHeadFetcher(headFetcher), WithBuilderClients(ok, failing))
+	require.NoError(t, err)
+	pubkey := bytesutil.ToBytes48([]byte("pubkey"))
+	var feeRecipient [20]byte
+	reg := &eth.ValidatorRegistrationV1{Pubkey: pubkey[:], Timestamp: uint64(time.Now().UTC().Unix()), FeeRecipient: feeRecipient[:]}
+
+	// A registration accepted by one of the relays succeeds.
+	require.NoError(t, s.RegisterValidator(ctx, []*eth.SignedValidatorRegistrationV1{{Message: reg}}))
+	assert.Equal(t, 1, ok.registered)
+	assert.Equal(t, 1, failing.registered)
+
+	ok.registerErr = errors.New("unavailable")
+	require.ErrorContains(t, "could not register validator(s)", s.RegisterValidator(ctx, []*eth.SignedValidatorRegistrationV1{{Message: reg}}))
+}
+
+func Test_GetHeader_CircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+	failing := &testRelay{url: "https://failing.example", getErr: errors.New("unavailable")}
+	s, err := NewService(ctx, WithBuilderClient(failing))
+	require.NoError(t, err)
+
+	for i := 0; i < relayFailureThreshold; i++ {
+		_, err = s.GetHeader(ctx, 1, [32]byte{}, [48]byte{})
+		require.ErrorContains(t, "unavailable", err)
+	}
+	_, err = s.GetHeader(ctx, 1, [32]byte{}, [48]byte{})
+	require.ErrorIs(t, err, ErrNoRelayAvailable)
+	assert.Equal(t, relayFailureThreshold, failing.getCalls)
+
+	// Once the cooldown is over the relay is tried again, and a success closes the breaker.
+	r := s.relays[0]
+	require.Equal(t, true, r.available(time.Now().Add(relayCooldown)))
+	r.recordSuccess()
+	require.Equal(t, true, r.available(time.Now()))
+}
+
+func Test_NewService_RelayNames(t *testing.T) {
+	s, err := NewService(context.Background(), WithBuilderClients(
+		&testRelay{url: "https://0xabcd@relay.example"},
+		&testRelay{url: "https://relay.example/eu/"},
+		&testRelay{url: "https://0xef01@relay.example"},
+	))
+	require.NoError(t, err)
+	require.Equal(t, 3, len(s.relays))
+	assert.Equal(t, "relay.example", s.relays[0].name)
+	assert.Equal(t, "relay.example/eu", s.relays[1].name)
+	assert.Equal(t, "relay.example#2", s.relays[2].name)
+}
+
+func Test_WithGetHeaderTimeout(t *testing.T) {
+	s, err := NewService(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, defaultRelayGetHeaderTimeout, s.cfg.getHeaderTimeout)
+
+	s, err = NewService(context.Background(), WithGetHeaderTimeout(500*time.Millisecond))
+	require.NoError(t, err)
+	assert.Equal(t, 500*time.Millisecond, s.cfg.getHeaderTimeout)
+
+	_, err = NewService(context.Background(), WithGetHeaderTimeout(0))
+	require.ErrorContains(t, "must be positive", err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/proto/dbval"
 	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
@@ -42,3 +43,29 @@ func (s *Store) BackfillStatus(ctx context.Context) (*dbval.BackfillStatus, erro
 	})
 	return bf, err
 }
+
+// updateBackfillStatusAfterPruning records in the backfill status that the blocks below the given block were pruned,
+// so that the node no longer considers them available. Nodes synced from genesis have no backfill status until their
+// first pruning, at which point the lowest remaining block also becomes the origin of the status.
+func updateBackfillStatusAfterPruning(tx *bolt.Tx, lowest interfaces.ReadOnlySignedBeaconBlock, lowestRoot [32]byte) error {
+	bucket := tx.Bucket(blocksBucket)
+	lowSlot := uint64(lowest.Block().Slot())
+	bf := &dbval.BackfillStatus{OriginSlot: lowSlot, OriginRoot: lowestRoot[:]}
+	if enc := bucket.Get(backfillStatusKey); len(enc) > 0 {
+		if err := proto.Unmarshal(enc, bf); err != nil {
+			return err
+		}
+		if bf.LowSlot >= lowSlot {
+			return nil
+		}
+	}
+	parentRoot := lowest.Block().ParentRoot()
+	bf.LowSlot = lowSlot
+	bf.LowRoot = lowestRoot[:]
+	bf.LowParentRoot = parentRoot[:]
+	bfb, err := proto.Marshal(bf)
+	if err != nil {
+		return err
+	}
+	return bucket.Put(backfillStatusKey, bfb)
+}
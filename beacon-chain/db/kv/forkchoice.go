@@ -0,0 +1,35 @@
+package kv
+
+import (
+	"context"
+
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// ForkChoiceSnapshot returns the last saved snapshot of the fork choice store, or nil if there is none.
+func (s *Store) ForkChoiceSnapshot(ctx context.Context) ([]byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ForkChoiceSnapshot")
+	defer span.End()
+
+	var snapshot []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(chainMetadataBucket).Get(forkChoiceSnapshotKey)
+		if enc != nil {
+			snapshot = make([]byte, len(enc))
+			copy(snapshot, enc)
+		}
+		return nil
+	})
+	return snapshot, err
+}
+
+// SaveForkChoiceSnapshot saves a snapshot of the fork choice store, replacing the previous one.
+func (s *Store) SaveForkChoiceSnapshot(ctx context.Context, snapshot []byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveForkChoiceSnapshot")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainMetadataBucket).Put(forkChoiceSnapshotKey, snapshot)
+	})
+}
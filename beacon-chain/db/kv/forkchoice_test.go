@@ -0,0 +1,27 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_ForkChoiceSnapshot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	snapshot, err := db.ForkChoiceSnapshot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(snapshot))
+
+	require.NoError(t, db.SaveForkChoiceSnapshot(ctx, []byte{1, 2, 3}))
+	snapshot, err = db.ForkChoiceSnapshot(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{1, 2, 3}, snapshot)
+
+	require.NoError(t, db.SaveForkChoiceSnapshot(ctx, []byte{4}))
+	snapshot, err = db.ForkChoiceSnapshot(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{4}, snapshot)
+}
@@ -0,0 +1,410 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// maxStateDiffExponent bounds the exponents of the hierarchy, so that 2^exponent slots fit in a slot.
+const maxStateDiffExponent = 62
+
+var (
+	// ErrHierarchicalStatesDisabled is returned when saving a hierarchical state in a database that does not
+	// use state diffs.
+	ErrHierarchicalStatesDisabled = errors.New("hierarchical states are not enabled in this database")
+
+	migrationHierarchicalStatesKey = []byte("hierarchical-states-0")
+)
+
+// stateDiffHierarchy describes the layers of hierarchical states. The states of slots that are multiples of
+// 2^exponents[0] are stored as full snapshots. The states of slots that are multiples of 2^exponents[i] are
+// stored as diffs against the state of a slot that is a multiple of a larger exponent, so that rebuilding any
+// hierarchical state takes at most len(exponents)-1 diffs on top of a snapshot.
+type stateDiffHierarchy struct {
+	// exponents are sorted in decreasing order.
+	exponents []uint8
+	// cache holds the most recently rebuilt or saved states by slot, since consecutive diffs share their bases.
+	cache *lru.Cache
+}
+
+// WithStateDiffExponents enables hierarchical states in a new database, using the given layer exponents. A database
+// remembers its exponents, so the option may be omitted afterwards, but it may not be changed.
+func WithStateDiffExponents(exponents []int) KVStoreOption {
+	return func(s *Store) {
+		s.requestedStateDiffExponents = exponents
+	}
+}
+
+// ValidateStateDiffExponents checks that exponents can be used as the layers of hierarchical states, and returns them
+// sorted in decreasing order.
+func ValidateStateDiffExponents(exponents []int) ([]uint8, error) {
+	if len(exponents) == 0 {
+		return nil, errors.New("no state diff exponents")
+	}
+	sorted := make([]uint8, len(exponents))
+	for i, e := range exponents {
+		if e < 0 || e > maxStateDiffExponent {
+			return nil, fmt.Errorf("state diff exponent %d is not between 0 and %d", e, maxStateDiffExponent)
+		}
+		sorted[i] = uint8(e)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i] == sorted[i-1] {
+			return nil, fmt.Errorf("duplicate state diff exponent %d", sorted[i])
+		}
+	}
+	return sorted, nil
+}
+
+// setupStateDiffs enables hierarchical states if they are requested or were enabled in a previous run, and checks
+// that the requested exponents match the ones the database was created with.
+func (s *Store) setupStateDiffs() error {
+	var requested []uint8
+	if s.requestedStateDiffExponents != nil {
+		var err error
+		if requested, err = ValidateStateDiffExponents(s.requestedStateDiffExponents); err != nil {
+			return err
+		}
+	}
+
+	var exponents []uint8
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(chainMetadataBucket)
+		saved := bkt.Get(stateDiffExponentsKey)
+		switch {
+		case saved != nil && requested != nil && !bytes.Equal(saved, requested):
+			return fmt.Errorf("database uses state diff exponents %s, not %s", formatExponents(saved), formatExponents(requested))
+		case saved != nil:
+			exponents = append([]uint8{}, saved...)
+			return nil
+		case requested != nil:
+			exponents = requested
+			return bkt.Put(stateDiffExponentsKey, requested)
+		default:
+			return nil
+		}
+	}); err != nil {
+		return err
+	}
+
+	if exponents != nil {
+		s.stateDiffs = &stateDiffHierarchy{
+			exponents: exponents,
+			cache:     lruwrpr.New(len(exponents) + 1),
+		}
+		log.WithField("exponents", formatExponents(exponents)).Info("Hierarchical state storage enabled")
+	}
+	return nil
+}
+
+// level returns the layer of the hierarchy a slot belongs to, and false if the slot is not in any layer.
+func (h *stateDiffHierarchy) level(slot primitives.Slot) (int, bool) {
+	for i, e := range h.exponents {
+		if uint64(slot)%(uint64(1)<<e) == 0 {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// HierarchicalStateInterval returns the number of slots between consecutive states of the finest layer of
+// hierarchical states, or 0 if the database does not use hierarchical states.
+func (s *Store) HierarchicalStateInterval() primitives.Slot {
+	if s.stateDiffs == nil {
+		return 0
+	}
+	return primitives.Slot(1) << s.stateDiffs.exponents[len(s.stateDiffs.exponents)-1]
+}
+
+// HierarchicalStateSlot returns the highest slot lower than or equal to the given slot at which a hierarchical state
+// is stored, and false if there is none.
+func (s *Store) HierarchicalStateSlot(ctx context.Context, slot primitives.Slot) (primitives.Slot, bool, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HierarchicalStateSlot")
+	defer span.End()
+
+	if s.stateDiffs == nil {
+		return 0, false, nil
+	}
+
+	var found bool
+	var highest primitives.Slot
+	err := s.db.View(func(tx *bolt.Tx) error {
+		for _, bkt := range []*bolt.Bucket{tx.Bucket(stateSnapshotsBucket), tx.Bucket(stateDiffsBucket)} {
+			if k, ok := highestKeyAtOrBelow(bkt, slot); ok && (!found || k > highest) {
+				highest, found = k, true
+			}
+		}
+		return nil
+	})
+	return highest, found, err
+}
+
+// HierarchicalState returns the hierarchical state stored at the highest slot lower than or equal to the given slot,
+// or nil if there is none. The state has been advanced to its slot, and contains all the blocks up to it.
+func (s *Store) HierarchicalState(ctx context.Context, slot primitives.Slot) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.HierarchicalState")
+	defer span.End()
+
+	stored, ok, err := s.HierarchicalStateSlot(ctx, slot)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return s.hierarchicalStateAt(ctx, stored)
+}
+
+// hierarchicalStateAt rebuilds the hierarchical state stored at the given slot, applying its diff to the state it
+// is based on, recursively.
+func (s *Store) hierarchicalStateAt(ctx context.Context, slot primitives.Slot) (state.BeaconState, error) {
+	if cached, ok := s.stateDiffs.cache.Get(slot); ok {
+		st, ok := cached.(state.BeaconState)
+		if ok {
+			return st.Copy(), nil
+		}
+	}
+
+	var snapshot, diff []byte
+	key := bytesutil.SlotToBytesBigEndian(slot)
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		snapshot = bytesutil.SafeCopyBytes(tx.Bucket(stateSnapshotsBucket).Get(key))
+		diff = bytesutil.SafeCopyBytes(tx.Bucket(stateDiffsBucket).Get(key))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var st state.BeaconState
+	switch {
+	case snapshot != nil:
+		d, err := unmarshalStateDiff(snapshot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode state snapshot at slot %d", slot)
+		}
+		if st, err = applyStateDiff(nil, d); err != nil {
+			return nil, errors.Wrapf(err, "could not rebuild state snapshot at slot %d", slot)
+		}
+	case diff != nil:
+		d, err := unmarshalStateDiff(diff)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode state diff at slot %d", slot)
+		}
+		if d.parentSlot >= slot {
+			return nil, errors.Errorf("state diff at slot %d is based on later slot %d", slot, d.parentSlot)
+		}
+		base, err := s.hierarchicalStateAt(ctx, d.parentSlot)
+		if err != nil {
+			return nil, err
+		}
+		if st, err = applyStateDiff(base, d); err != nil {
+			return nil, errors.Wrapf(err, "could not apply state diff at slot %d", slot)
+		}
+	default:
+		return nil, errors.Wrapf(ErrNotFoundState, "no hierarchical state at slot %d", slot)
+	}
+
+	s.stateDiffs.cache.Add(slot, st.Copy())
+	return st, nil
+}
+
+// SaveHierarchicalState saves a finalized state, whose slot must be in a layer of the hierarchy. The state is saved
+// as a snapshot if its slot is in the coarsest layer, or if there is no state to base it on. Otherwise it is saved as
+// a diff against the state of the closest coarser layer, or against the latest snapshot.
+func (s *Store) SaveHierarchicalState(ctx context.Context, st state.BeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveHierarchicalState")
+	defer span.End()
+
+	if s.stateDiffs == nil {
+		return ErrHierarchicalStatesDisabled
+	}
+	if st == nil || st.IsNil() {
+		return errors.New("nil state")
+	}
+	slot := st.Slot()
+	level, ok := s.stateDiffs.level(slot)
+	if !ok {
+		return fmt.Errorf("slot %d is not a multiple of %d", slot, s.HierarchicalStateInterval())
+	}
+
+	var parentSlot primitives.Slot
+	hasParent := false
+	if level > 0 {
+		if err := s.db.View(func(tx *bolt.Tx) error {
+			parentSlot, hasParent = s.stateDiffs.parent(tx, slot, level)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	var base state.BeaconState
+	if hasParent {
+		var err error
+		if base, err = s.hierarchicalStateAt(ctx, parentSlot); err != nil {
+			return errors.Wrap(err, "could not rebuild base state")
+		}
+	}
+	d, err := computeStateDiff(base, st)
+	if err != nil {
+		return errors.Wrap(err, "could not compute state diff")
+	}
+	enc, err := d.marshal()
+	if err != nil {
+		return errors.Wrap(err, "could not encode state diff")
+	}
+
+	key := bytesutil.SlotToBytesBigEndian(slot)
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		target, other := tx.Bucket(stateSnapshotsBucket), tx.Bucket(stateDiffsBucket)
+		if hasParent {
+			target, other = other, target
+		}
+		if err := other.Delete(key); err != nil {
+			return err
+		}
+		return target.Put(key, enc)
+	}); err != nil {
+		return err
+	}
+
+	s.stateDiffs.cache.Add(slot, st.Copy())
+	return nil
+}
+
+// parent returns the slot of the state that the state of the given slot, in the given layer, is based on.
+func (h *stateDiffHierarchy) parent(tx *bolt.Tx, slot primitives.Slot, level int) (primitives.Slot, bool) {
+	snapshots, diffs := tx.Bucket(stateSnapshotsBucket), tx.Bucket(stateDiffsBucket)
+	for i := level - 1; i >= 0; i-- {
+		candidate := slot - slot%(primitives.Slot(1)<<h.exponents[i])
+		key := bytesutil.SlotToBytesBigEndian(candidate)
+		if snapshots.Get(key) != nil || diffs.Get(key) != nil {
+			return candidate, true
+		}
+	}
+	// Coarser states are missing when the node started from a checkpoint, or was migrated from archived points.
+	// Falling back to the latest snapshot keeps the chain of diffs short until the next coarser state is saved.
+	if slot == 0 {
+		return 0, false
+	}
+	return highestKeyAtOrBelow(snapshots, slot-1)
+}
+
+// highestKeyAtOrBelow returns the highest slot key of bkt lower than or equal to slot.
+func highestKeyAtOrBelow(bkt *bolt.Bucket, slot primitives.Slot) (primitives.Slot, bool) {
+	c := bkt.Cursor()
+	k, _ := c.Seek(bytesutil.SlotToBytesBigEndian(slot))
+	if k == nil || bytesutil.BytesToSlotBigEndian(k) > slot {
+		k, _ = c.Prev()
+	}
+	if k == nil {
+		return 0, false
+	}
+	return bytesutil.BytesToSlotBigEndian(k), true
+}
+
+// MigrateToHierarchicalStates converts the archived states of finalized blocks into hierarchical states, and deletes
+// the converted states, except for the genesis, justified, finalized and origin checkpoint states which are still
+// needed in full. Archived states whose slot is not in the hierarchy are kept as they are. This is a no-op if the
+// database does not use hierarchical states, or was already migrated.
+func (s *Store) MigrateToHierarchicalStates(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.MigrateToHierarchicalStates")
+	defer span.End()
+
+	if s.stateDiffs == nil {
+		return nil
+	}
+
+	type archivedState struct {
+		root [32]byte
+		slot primitives.Slot
+	}
+	var archived []archivedState
+	var migrated bool
+	var originRoot []byte
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		migrated = bytes.Equal(tx.Bucket(migrationsBucket).Get(migrationHierarchicalStatesKey), migrationCompleted)
+		if migrated {
+			return nil
+		}
+		originRoot = bytesutil.SafeCopyBytes(tx.Bucket(blocksBucket).Get(originCheckpointBlockRootKey))
+		return tx.Bucket(stateBucket).ForEach(func(k, _ []byte) error {
+			slot, err := s.slotByBlockRoot(ctx, tx, k)
+			if err != nil {
+				return errors.Wrapf(err, "could not get slot of state %#x", k)
+			}
+			archived = append(archived, archivedState{root: bytesutil.ToBytes32(k), slot: slot})
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+	if migrated {
+		return nil
+	}
+
+	// Diffs must be saved after the states they are based on.
+	sort.Slice(archived, func(i, j int) bool { return archived[i].slot < archived[j].slot })
+
+	log.WithField("states", len(archived)).Info("Migrating archived states to hierarchical states")
+	converted := 0
+	for _, a := range archived {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !s.IsFinalizedBlock(ctx, a.root) {
+			continue
+		}
+		st, err := s.State(ctx, a.root)
+		if err != nil {
+			return errors.Wrapf(err, "could not get state %#x", a.root)
+		}
+		if st == nil || st.IsNil() {
+			continue
+		}
+		if _, ok := s.stateDiffs.level(st.Slot()); !ok {
+			continue
+		}
+		if err := s.SaveHierarchicalState(ctx, st); err != nil {
+			return errors.Wrapf(err, "could not save hierarchical state at slot %d", st.Slot())
+		}
+		converted++
+		if bytes.Equal(a.root[:], originRoot) {
+			continue
+		}
+		if err := s.DeleteState(ctx, a.root); err != nil && !errors.Is(err, ErrDeleteJustifiedAndFinalized) {
+			return errors.Wrapf(err, "could not delete state %#x", a.root)
+		}
+		log.WithFields(logrus.Fields{
+			"slot":      st.Slot(),
+			"converted": converted,
+		}).Debug("Migrated archived state")
+	}
+
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(migrationsBucket).Put(migrationHierarchicalStatesKey, migrationCompleted)
+	}); err != nil {
+		return err
+	}
+	log.WithField("converted", converted).Info("Migrated archived states to hierarchical states")
+	return nil
+}
+
+func formatExponents(exponents []uint8) string {
+	s := make([]string, len(exponents))
+	for i, e := range exponents {
+		s[i] = fmt.Sprint(e)
+	}
+	return strings.Join(s, ",")
+}
@@ -0,0 +1,199 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	bolt "go.etcd.io/bbolt"
+)
+
+func setupHierarchicalDB(t testing.TB, exponents ...int) *Store {
+	db, err := NewKVStore(context.Background(), t.TempDir(), WithStateDiffExponents(exponents))
+	require.NoError(t, err, "Failed to instantiate DB")
+	t.Cleanup(func() {
+		require.NoError(t, db.Close(), "Failed to close database")
+	})
+	return db
+}
+
+// isStateDiff returns true if the hierarchical state at slot is stored as a diff, and false if it is a snapshot.
+func isStateDiff(t *testing.T, db *Store, slot primitives.Slot) bool {
+	var snapshot, diff bool
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		key := bytesutil.SlotToBytesBigEndian(slot)
+		snapshot = tx.Bucket(stateSnapshotsBucket).Get(key) != nil
+		diff = tx.Bucket(stateDiffsBucket).Get(key) != nil
+		return nil
+	}))
+	require.NotEqual(t, snapshot, diff, "State at slot %d must be either a snapshot or a diff", slot)
+	return diff
+}
+
+func TestValidateStateDiffExponents(t *testing.T) {
+	exponents, err := ValidateStateDiffExponents([]int{5, 13, 9})
+	require.NoError(t, err)
+	require.DeepEqual(t, []uint8{13, 9, 5}, exponents)
+
+	_, err = ValidateStateDiffExponents(nil)
+	require.ErrorContains(t, "no state diff exponents", err)
+	_, err = ValidateStateDiffExponents([]int{5, 5})
+	require.ErrorContains(t, "duplicate state diff exponent", err)
+	_, err = ValidateStateDiffExponents([]int{63})
+	require.ErrorContains(t, "is not between", err)
+}
+
+func TestStore_StateDiffExponentsPersisted(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	db, err := NewKVStore(ctx, dir, WithStateDiffExponents([]int{3, 5}))
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(8), db.HierarchicalStateInterval())
+	require.NoError(t, db.Close())
+
+	// The exponents are remembered.
+	db, err = NewKVStore(ctx, dir)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(8), db.HierarchicalStateInterval())
+	require.NoError(t, db.Close())
+
+	// And may not change.
+	_, err = NewKVStore(ctx, dir, WithStateDiffExponents([]int{3, 6}))
+	require.ErrorContains(t, "database uses state diff exponents 5,3, not 6,3", err)
+}
+
+func TestStore_HierarchicalStatesDisabled(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	assert.Equal(t, primitives.Slot(0), db.HierarchicalStateInterval())
+
+	st, _ := util.DeterministicGenesisStateAltair(t, 16)
+	require.ErrorIs(t, db.SaveHierarchicalState(ctx, st), ErrHierarchicalStatesDisabled)
+	got, err := db.HierarchicalState(ctx, 100)
+	require.NoError(t, err)
+	assert.Equal(t, nil, got)
+}
+
+func TestStore_SaveHierarchicalState(t *testing.T) {
+	ctx := context.Background()
+	db := setupHierarchicalDB(t, 2, 3, 4)
+
+	st, _ := util.DeterministicGenesisStateAltair(t, 16)
+	states := make(map[primitives.Slot]state.BeaconState)
+	for slot := primitives.Slot(0); slot <= 36; slot += 4 {
+		mutateState(t, st, slot)
+		states[slot] = st.Copy()
+		require.NoError(t, db.SaveHierarchicalState(ctx, st))
+	}
+
+	// Slots that are multiples of 16 are snapshots, and the others are diffs.
+	for slot := range states {
+		assert.Equal(t, slot%16 != 0, isStateDiff(t, db, slot), "Unexpected storage at slot %d", slot)
+	}
+
+	// Rebuild the states from the database, without the cache.
+	db.stateDiffs.cache.Purge()
+	for slot := primitives.Slot(0); slot <= 40; slot++ {
+		want := slot - slot%4
+		if want > 36 {
+			want = 36
+		}
+		got, err := db.HierarchicalState(ctx, slot)
+		require.NoError(t, err)
+		requireSameState(t, states[want], got)
+
+		stored, ok, err := db.HierarchicalStateSlot(ctx, slot)
+		require.NoError(t, err)
+		assert.Equal(t, true, ok)
+		assert.Equal(t, want, stored)
+	}
+
+	require.NoError(t, st.SetSlot(41))
+	require.ErrorContains(t, "slot 41 is not a multiple of 4", db.SaveHierarchicalState(ctx, st))
+}
+
+func TestStore_SaveHierarchicalState_MissingCoarserStates(t *testing.T) {
+	ctx := context.Background()
+	db := setupHierarchicalDB(t, 2, 4)
+
+	// A node that started from a checkpoint has no coarser state at first.
+	st, _ := util.DeterministicGenesisStateAltair(t, 16)
+	require.NoError(t, st.SetSlot(20))
+	require.NoError(t, db.SaveHierarchicalState(ctx, st))
+	assert.Equal(t, false, isStateDiff(t, db, 20))
+
+	// Later states are based on the latest snapshot.
+	states := []state.BeaconState{st.Copy()}
+	for _, slot := range []primitives.Slot{24, 28, 32, 36} {
+		mutateState(t, st, slot)
+		states = append(states, st.Copy())
+		require.NoError(t, db.SaveHierarchicalState(ctx, st))
+	}
+	assert.Equal(t, true, isStateDiff(t, db, 24))
+	assert.Equal(t, false, isStateDiff(t, db, 32))
+	assert.Equal(t, true, isStateDiff(t, db, 36))
+
+	_, ok, err := db.HierarchicalStateSlot(ctx, 19)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+
+	db.stateDiffs.cache.Purge()
+	for _, want := range states {
+		got, err := db.HierarchicalState(ctx, want.Slot())
+		require.NoError(t, err)
+		requireSameState(t, want, got)
+	}
+}
+
+func TestStore_MigrateToHierarchicalStates(t *testing.T) {
+	ctx := context.Background()
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	db := setupHierarchicalDB(t, 3, 4)
+
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+	blks := makeBlocks(t, 0, slotsPerEpoch*3, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+
+	st, _ := util.DeterministicGenesisStateAltair(t, 16)
+	states := make(map[primitives.Slot]state.BeaconState)
+	roots := make(map[primitives.Slot][32]byte)
+	for _, slot := range []primitives.Slot{8, 12, 16, 24, primitives.Slot(slotsPerEpoch * 2)} {
+		mutateState(t, st, slot)
+		root, err := blks[slot].Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveState(ctx, st, root))
+		states[slot], roots[slot] = st.Copy(), root
+	}
+	finalized := primitives.Slot(slotsPerEpoch * 2)
+	finalizedRoot := roots[finalized]
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: finalizedRoot[:]}))
+
+	require.NoError(t, db.MigrateToHierarchicalStates(ctx))
+
+	// Aligned states are converted, and all but the finalized one are deleted.
+	for _, slot := range []primitives.Slot{8, 16, 24, finalized} {
+		got, err := db.HierarchicalState(ctx, slot)
+		require.NoError(t, err)
+		requireSameState(t, states[slot], got)
+		assert.Equal(t, slot == finalized, db.HasState(ctx, roots[slot]), "Unexpected archived state at slot %d", slot)
+	}
+	// Unaligned states are kept.
+	assert.Equal(t, true, db.HasState(ctx, roots[12]))
+
+	// The migration runs once.
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(stateDiffsBucket).Delete(bytesutil.SlotToBytesBigEndian(24))
+	}))
+	require.NoError(t, db.MigrateToHierarchicalStates(ctx))
+	stored, _, err := db.HierarchicalStateSlot(ctx, 24)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(16), stored)
+}
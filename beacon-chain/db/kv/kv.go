@@ -90,6 +90,9 @@ type Store struct {
 	validatorEntryCache *ristretto.Cache
 	stateSummaryCache   *stateSummaryCache
 	ctx                 context.Context
+
+	requestedStateDiffExponents []int
+	stateDiffs                  *stateDiffHierarchy
 }
 
 // StoreDatafilePath is the canonical construction of a full
@@ -118,6 +121,12 @@ var Buckets = [][]byte{
 
 	feeRecipientBucket,
 	registrationBucket,
+	payloadSourcesBucket,
+
+	lightClientUpdatesBucket,
+
+	stateSnapshotsBucket,
+	stateDiffsBucket,
 }
 
 // KVStoreOption is a functional option that modifies a kv.Store.
@@ -187,6 +196,12 @@ func NewKVStore(ctx context.Context, dirPath string, opts ...KVStoreOption) (*St
 	}); err != nil {
 		return nil, err
 	}
+	if err := kv.setupStateDiffs(); err != nil {
+		if closeErr := kv.db.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close database")
+		}
+		return nil, err
+	}
 	if err = prometheus.Register(createBoltCollector(kv.db)); err != nil {
 		return nil, err
 	}
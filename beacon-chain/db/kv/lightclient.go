@@ -0,0 +1,134 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveLightClientUpdate saves the light client update for the given sync committee period,
+// replacing any update previously stored for that period.
+func (s *Store) SaveLightClientUpdate(ctx context.Context, period uint64, update ethpb.LightClientUpdate) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveLightClientUpdate")
+	defer span.End()
+
+	enc, err := encodeLightClientUpdate(update)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(lightClientUpdatesBucket)
+		return bkt.Put(bytesutil.Uint64ToBytesBigEndian(period), enc)
+	})
+}
+
+// LightClientUpdate retrieves the light client update stored for the given sync committee period.
+// It returns nil if no update is stored for that period.
+func (s *Store) LightClientUpdate(ctx context.Context, period uint64) (ethpb.LightClientUpdate, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.LightClientUpdate")
+	defer span.End()
+
+	var update ethpb.LightClientUpdate
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(lightClientUpdatesBucket).Get(bytesutil.Uint64ToBytesBigEndian(period))
+		if enc == nil {
+			return nil
+		}
+		var err error
+		update, err = decodeLightClientUpdate(enc)
+		return err
+	})
+	return update, err
+}
+
+// LightClientUpdates retrieves the light client updates stored for the sync committee periods
+// in [startPeriod, endPeriod], keyed by period. Periods without a stored update are omitted.
+func (s *Store) LightClientUpdates(ctx context.Context, startPeriod, endPeriod uint64) (map[uint64]ethpb.LightClientUpdate, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.LightClientUpdates")
+	defer span.End()
+
+	if startPeriod > endPeriod {
+		return nil, errors.Errorf("start period %d is greater than end period %d", startPeriod, endPeriod)
+	}
+	updates := make(map[uint64]ethpb.LightClientUpdate)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(lightClientUpdatesBucket).Cursor()
+		for k, v := c.Seek(bytesutil.Uint64ToBytesBigEndian(startPeriod)); k != nil; k, v = c.Next() {
+			period := bytesutil.BytesToUint64BigEndian(k)
+			if period > endPeriod {
+				break
+			}
+			update, err := decodeLightClientUpdate(v)
+			if err != nil {
+				return err
+			}
+			updates[period] = update
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updates, nil
+}
+
+// encodeLightClientUpdate prefixes the SSZ encoding of the update with the key of its fork, the way blocks are stored,
+// and snappy compresses the result.
+func encodeLightClientUpdate(update ethpb.LightClientUpdate) ([]byte, error) {
+	if update == nil {
+		return nil, errors.New("nil light client update")
+	}
+	var key []byte
+	switch update.Version() {
+	case version.Altair:
+		key = altairKey
+	case version.Capella:
+		key = capellaKey
+	case version.Deneb:
+		key = denebKey
+	case version.Electra:
+		key = electraKey
+	default:
+		return nil, fmt.Errorf("unsupported light client update version %s", version.String(update.Version()))
+	}
+	enc, err := update.MarshalSSZ()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal light client update")
+	}
+	dbfmt := make([]byte, len(key)+len(enc))
+	copy(dbfmt, key)
+	copy(dbfmt[len(key):], enc)
+	return snappy.Encode(nil, dbfmt), nil
+}
+
+func decodeLightClientUpdate(enc []byte) (ethpb.LightClientUpdate, error) {
+	enc, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not snappy decode light client update")
+	}
+	var update ethpb.LightClientUpdate
+	var key []byte
+	switch {
+	case hasAltairKey(enc):
+		update, key = &ethpb.LightClientUpdateAltair{}, altairKey
+	case hasCapellaKey(enc):
+		update, key = &ethpb.LightClientUpdateCapella{}, capellaKey
+	case hasDenebKey(enc):
+		update, key = &ethpb.LightClientUpdateDeneb{}, denebKey
+	case hasElectraKey(enc):
+		update, key = &ethpb.LightClientUpdateElectra{}, electraKey
+	default:
+		return nil, errors.New("light client update has no fork key")
+	}
+	if err := update.UnmarshalSSZ(enc[len(key):]); err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal %s light client update", version.String(update.Version()))
+	}
+	return update, nil
+}
@@ -0,0 +1,85 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"google.golang.org/protobuf/proto"
+)
+
+func testLightClientUpdate(t *testing.T, v int, slot primitives.Slot) ethpb.LightClientUpdate {
+	switch v {
+	case version.Altair:
+		update := util.NewLightClientUpdateAltair()
+		update.AttestedHeader.Beacon.Slot = slot
+		update.SignatureSlot = slot + 1
+		return update
+	case version.Deneb:
+		update := util.NewLightClientUpdateDeneb()
+		update.AttestedHeader.Beacon.Slot = slot
+		update.AttestedHeader.Execution.BlockNumber = uint64(slot)
+		update.SignatureSlot = slot + 1
+		return update
+	default:
+		t.Fatalf("unsupported version %s", version.String(v))
+		return nil
+	}
+}
+
+func TestStore_LightClientUpdate_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	update, err := db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, nil, update)
+
+	want := testLightClientUpdate(t, version.Altair, 10)
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 1, want))
+	update, err = db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(want, update), "Wanted %v, received %v", want, update)
+
+	// A later save for the same period overwrites the stored update, whatever its fork.
+	want = testLightClientUpdate(t, version.Deneb, 20)
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 1, want))
+	update, err = db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(want, update), "Wanted %v, received %v", want, update)
+}
+
+func TestStore_SaveLightClientUpdate_Nil(t *testing.T) {
+	db := setupDB(t)
+	require.ErrorContains(t, "nil light client update", db.SaveLightClientUpdate(context.Background(), 1, nil))
+}
+
+func TestStore_LightClientUpdates(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	for _, period := range []uint64{1, 2, 4, 256, 257} {
+		require.NoError(t, db.SaveLightClientUpdate(ctx, period, testLightClientUpdate(t, version.Deneb, primitives.Slot(period))))
+	}
+
+	updates, err := db.LightClientUpdates(ctx, 2, 256)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(updates))
+	for _, period := range []uint64{2, 4, 256} {
+		update, ok := updates[period]
+		require.Equal(t, true, ok, "Missing update for period %d", period)
+		assert.Equal(t, primitives.Slot(period), update.GetAttestedHeaderVal().GetBeacon().Slot)
+	}
+
+	updates, err = db.LightClientUpdates(ctx, 5, 255)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(updates))
+
+	_, err = db.LightClientUpdates(ctx, 3, 2)
+	require.ErrorContains(t, "start period 3 is greater than end period 2", err)
+}
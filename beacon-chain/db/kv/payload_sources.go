@@ -0,0 +1,41 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// PayloadFromBuilder returns whether the execution payload of a block proposed through this node was built by a
+// builder rather than by the local execution client.
+// `ErrNotFoundPayloadSource` is returned if the proposal of the block was not recorded.
+func (s *Store) PayloadFromBuilder(ctx context.Context, blockRoot [32]byte) (bool, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.PayloadFromBuilder")
+	defer span.End()
+	var fromBuilder bool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(payloadSourcesBucket).Get(blockRoot[:])
+		if len(enc) != 1 {
+			return errors.Wrapf(ErrNotFoundPayloadSource, "block root %#x", blockRoot)
+		}
+		fromBuilder = enc[0] == 1
+		return nil
+	})
+	return fromBuilder, err
+}
+
+// SavePayloadFromBuilder records whether the execution payload of a block proposed through this node was built by a
+// builder.
+func (s *Store) SavePayloadFromBuilder(ctx context.Context, blockRoot [32]byte, fromBuilder bool) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SavePayloadFromBuilder")
+	defer span.End()
+	enc := []byte{0}
+	if fromBuilder {
+		enc[0] = 1
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(payloadSourcesBucket).Put(blockRoot[:], enc)
+	})
+}
@@ -0,0 +1,26 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_PayloadFromBuilder(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	_, err := db.PayloadFromBuilder(ctx, [32]byte{'a'})
+	require.ErrorIs(t, err, ErrNotFoundPayloadSource)
+
+	require.NoError(t, db.SavePayloadFromBuilder(ctx, [32]byte{'a'}, true))
+	require.NoError(t, db.SavePayloadFromBuilder(ctx, [32]byte{'b'}, false))
+	fromBuilder, err := db.PayloadFromBuilder(ctx, [32]byte{'a'})
+	require.NoError(t, err)
+	assert.Equal(t, true, fromBuilder)
+	fromBuilder, err = db.PayloadFromBuilder(ctx, [32]byte{'b'})
+	require.NoError(t, err)
+	assert.Equal(t, false, fromBuilder)
+}
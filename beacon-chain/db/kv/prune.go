@@ -0,0 +1,200 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// pruneSlotsPerTx is the number of slots of blocks deleted in a single transaction, so that pruning a large
+// range does not hold the write lock of the database for too long.
+const pruneSlotsPerTx = 64
+
+// DeleteHistoricalDataBeforeSlot deletes the blocks below the given slot, along with their states, state summaries,
+// payload sources and indices. The cutoff is capped at the start of the finalized epoch, and the genesis, origin checkpoint and
+// finalized blocks are always kept. The backfill status is updated so that the lowest remaining block is reported
+// as the lowest available one. It returns the number of blocks deleted.
+func (s *Store) DeleteHistoricalDataBeforeSlot(ctx context.Context, cutoff primitives.Slot) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteHistoricalDataBeforeSlot")
+	defer span.End()
+
+	finalized, err := s.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	finalizedSlot, err := slots.EpochStart(finalized.Epoch)
+	if err != nil {
+		return 0, err
+	}
+	if cutoff > finalizedSlot {
+		cutoff = finalizedSlot
+	}
+	if cutoff == 0 {
+		return 0, nil
+	}
+
+	keep := [][]byte{finalized.Root}
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		for _, key := range [][]byte{genesisBlockRootKey, originCheckpointBlockRootKey} {
+			if r := bkt.Get(key); len(r) == 32 {
+				keep = append(keep, bytes.Clone(r))
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	var total int
+	var from primitives.Slot
+	for {
+		if ctx.Err() != nil {
+			return total, ctx.Err()
+		}
+		var pruned int
+		var more bool
+		if err := s.db.Update(func(tx *bolt.Tx) error {
+			pruned, from, more, err = s.deleteBlocksBeforeSlot(ctx, tx, from, cutoff, keep)
+			if err != nil {
+				return err
+			}
+			if more || total+pruned == 0 {
+				return nil
+			}
+			lowest, lowestRoot, err := lowestBlockAtOrAfterSlot(ctx, tx, cutoff)
+			if err != nil {
+				return err
+			}
+			if lowest == nil {
+				return nil
+			}
+			return updateBackfillStatusAfterPruning(tx, lowest, lowestRoot)
+		}); err != nil {
+			return total, err
+		}
+		total += pruned
+		if !more {
+			return total, nil
+		}
+	}
+}
+
+// deleteBlocksBeforeSlot deletes the blocks of at most pruneSlotsPerTx slots, from the given slot up to the
+// cutoff, except the ones in keep. It returns the number of deleted blocks, and the slot to continue from if blocks
+// may remain to be deleted.
+func (s *Store) deleteBlocksBeforeSlot(
+	ctx context.Context,
+	tx *bolt.Tx,
+	from, cutoff primitives.Slot,
+	keep [][]byte,
+) (int, primitives.Slot, bool, error) {
+	cutoffKey := bytesutil.SlotToBytesBigEndian(cutoff)
+	slotBkt := tx.Bucket(blockSlotIndicesBucket)
+
+	type slotRoots struct {
+		key   []byte
+		roots []byte
+	}
+	var batch []slotRoots
+	next, more := cutoff, false
+	c := slotBkt.Cursor()
+	for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(from)); k != nil && bytes.Compare(k, cutoffKey) < 0; k, v = c.Next() {
+		if len(batch) == pruneSlotsPerTx {
+			next, more = bytesutil.BytesToSlotBigEndian(k), true
+			break
+		}
+		// The bucket is modified once the iteration is over.
+		batch = append(batch, slotRoots{key: bytes.Clone(k), roots: bytes.Clone(v)})
+	}
+
+	var pruned int
+	for _, sr := range batch {
+		kept := make([]byte, 0, len(sr.roots))
+		for i := 0; i+32 <= len(sr.roots); i += 32 {
+			root := bytesutil.ToBytes32(sr.roots[i : i+32])
+			if containsRoot(keep, root) {
+				kept = append(kept, root[:]...)
+				continue
+			}
+			if err := s.deleteHistoricalBlock(ctx, tx, root); err != nil {
+				return 0, 0, false, errors.Wrapf(err, "could not delete block %#x", root)
+			}
+			pruned++
+		}
+		switch {
+		case len(kept) == len(sr.roots):
+			// Nothing was deleted in this slot.
+		case len(kept) == 0:
+			if err := slotBkt.Delete(sr.key); err != nil {
+				return 0, 0, false, err
+			}
+		default:
+			if err := slotBkt.Put(sr.key, kept); err != nil {
+				return 0, 0, false, err
+			}
+		}
+	}
+	return pruned, next, more, nil
+}
+
+// deleteHistoricalBlock deletes the block of the given root, along with its state, state summary, payload source and
+// indices.
+func (s *Store) deleteHistoricalBlock(ctx context.Context, tx *bolt.Tx, root [32]byte) error {
+	// The state indices are found from the state summary, so the state goes first.
+	if err := s.deleteState(ctx, tx, root); err != nil {
+		return err
+	}
+	if err := tx.Bucket(blocksBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	s.blockCache.Del(string(root[:]))
+	if err := tx.Bucket(blockParentRootIndicesBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	if err := tx.Bucket(finalizedBlockRootsIndexBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	if err := tx.Bucket(stateSummaryBucket).Delete(root[:]); err != nil {
+		return err
+	}
+	s.stateSummaryCache.delete(root)
+	return tx.Bucket(payloadSourcesBucket).Delete(root[:])
+}
+
+// lowestBlockAtOrAfterSlot returns the lowest block whose slot is at least the given one, or nil if there is none.
+func lowestBlockAtOrAfterSlot(ctx context.Context, tx *bolt.Tx, slot primitives.Slot) (interfaces.ReadOnlySignedBeaconBlock, [32]byte, error) {
+	blocks := tx.Bucket(blocksBucket)
+	c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+	for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(slot)); k != nil; k, v = c.Next() {
+		for i := 0; i+32 <= len(v); i += 32 {
+			root := bytesutil.ToBytes32(v[i : i+32])
+			enc := blocks.Get(root[:])
+			if enc == nil {
+				continue
+			}
+			blk, err := unmarshalBlock(ctx, enc)
+			if err != nil {
+				return nil, [32]byte{}, err
+			}
+			return blk, root, nil
+		}
+	}
+	return nil, [32]byte{}, nil
+}
+
+func containsRoot(roots [][]byte, root [32]byte) bool {
+	for _, r := range roots {
+		if bytes.Equal(r, root[:]) {
+			return true
+		}
+	}
+	return false
+}
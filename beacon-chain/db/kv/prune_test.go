@@ -0,0 +1,89 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestStore_DeleteHistoricalDataBeforeSlot(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+	blks := makeBlocks(t, 0, 128, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	roots := make(map[primitives.Slot][32]byte)
+	ss := make([]*ethpb.StateSummary, len(blks))
+	for i, blk := range blks {
+		r, err := blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		roots[blk.Block().Slot()] = r
+		ss[i] = &ethpb.StateSummary{Slot: blk.Block().Slot(), Root: r[:]}
+	}
+	require.NoError(t, db.SaveStateSummaries(ctx, ss))
+	for _, slot := range []primitives.Slot{8, 80} {
+		require.NoError(t, db.SavePayloadFromBuilder(ctx, roots[slot], true))
+	}
+	for _, slot := range []primitives.Slot{8, 90} {
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(slot))
+		require.NoError(t, db.SaveState(ctx, st, roots[slot]))
+	}
+	// The finalized block is not at the start of its epoch.
+	finalizedRoot := roots[90]
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 3, Root: finalizedRoot[:]}))
+	require.Equal(t, true, db.IsFinalizedBlock(ctx, roots[8]))
+
+	// More slots than a single batch are pruned.
+	pruned, err := db.DeleteHistoricalDataBeforeSlot(ctx, 80)
+	require.NoError(t, err)
+	assert.Equal(t, 79, pruned)
+	for slot := primitives.Slot(1); slot <= 128; slot++ {
+		assert.Equal(t, slot >= 80, db.HasBlock(ctx, roots[slot]), "Unexpected block at slot %d", slot)
+		assert.Equal(t, slot >= 80, db.HasStateSummary(ctx, roots[slot]), "Unexpected state summary at slot %d", slot)
+	}
+	assert.Equal(t, false, db.HasState(ctx, roots[8]))
+	assert.Equal(t, false, db.IsFinalizedBlock(ctx, roots[8]))
+	assert.Equal(t, [32]byte{}, db.ArchivedPointRoot(ctx, 8))
+	_, err = db.PayloadFromBuilder(ctx, roots[8])
+	require.ErrorIs(t, err, ErrNotFoundPayloadSource)
+	fromBuilder, err := db.PayloadFromBuilder(ctx, roots[80])
+	require.NoError(t, err)
+	assert.Equal(t, true, fromBuilder)
+	_, blockRoots, err := db.BlockRootsBySlot(ctx, 8)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(blockRoots))
+
+	bf, err := db.BackfillStatus(ctx)
+	require.NoError(t, err)
+	parentRoot := roots[79]
+	lowRoot := roots[80]
+	assert.Equal(t, uint64(80), bf.LowSlot)
+	assert.DeepEqual(t, lowRoot[:], bf.LowRoot)
+	assert.DeepEqual(t, parentRoot[:], bf.LowParentRoot)
+	assert.Equal(t, uint64(80), bf.OriginSlot)
+
+	// The cutoff is capped at the finalized epoch, and the finalized block is kept.
+	pruned, err = db.DeleteHistoricalDataBeforeSlot(ctx, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, 15, pruned)
+	assert.Equal(t, true, db.HasBlock(ctx, roots[90]))
+	assert.Equal(t, true, db.HasState(ctx, roots[90]))
+	assert.Equal(t, true, db.HasBlock(ctx, roots[96]))
+	bf, err = db.BackfillStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(96), bf.LowSlot)
+	assert.Equal(t, uint64(80), bf.OriginSlot)
+
+	// Nothing is left to prune.
+	pruned, err = db.DeleteHistoricalDataBeforeSlot(ctx, 96)
+	require.NoError(t, err)
+	assert.Equal(t, 0, pruned)
+}
@@ -17,6 +17,16 @@ var (
 	feeRecipientBucket    = []byte("fee-recipient")
 	registrationBucket    = []byte("registration")
 
+	// Whether the execution payload of a block proposed by this node came from a builder, keyed by block root.
+	payloadSourcesBucket = []byte("payload-sources")
+
+	// Light client updates, keyed by sync committee period.
+	lightClientUpdatesBucket = []byte("light-client-updates")
+
+	// Hierarchical states of finalized slots, keyed by big endian slot.
+	stateSnapshotsBucket = []byte("state-snapshots")
+	stateDiffsBucket     = []byte("state-diffs")
+
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
@@ -55,6 +65,10 @@ var (
 	originCheckpointBlockRootKey = []byte("origin-checkpoint-block-root")
 	// tracking data about an ongoing backfill
 	backfillStatusKey = []byte("backfill-status")
+	// exponents of the layers of hierarchical states, in decreasing order
+	stateDiffExponentsKey = []byte("state-diff-exponents")
+	// serialized fork choice store, restored on startup instead of replaying the non-finalized blocks
+	forkChoiceSnapshotKey = []byte("forkchoice-snapshot")
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
@@ -0,0 +1,474 @@
+package kv
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	statenative "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+// stateDiffFormatVersion is the version of the encoding of state diffs, stored as their first byte.
+const stateDiffFormatVersion = 1
+
+// validatorSSZSize is the size of the SSZ encoding of a validator.
+const validatorSSZSize = 121
+
+// stateDiff is the difference between a state and a base state, which is either a state of a lower slot, or nothing
+// for a snapshot. The fields that grow with the validator set (validators, balances, inactivity scores and epoch
+// participation) are diffed field by field. Everything else is SSZ encoded with these fields left empty, and XORed
+// with the same encoding of the base state. Unchanged bytes become zeros, which compress well.
+type stateDiff struct {
+	// stateVersion is the fork version of the state.
+	stateVersion int
+	// parentSlot is the slot of the base state. It is ignored for snapshots.
+	parentSlot primitives.Slot
+	// remainder is the XOR of the SSZ encodings of both states without the fields below.
+	remainder []byte
+	// numValidators is the number of validators of the state.
+	numValidators uint64
+	// validators are the validators that differ from the base state, indexed by validator index.
+	validators map[uint64]*ethpb.Validator
+	// balanceDeltas are the differences between the balances of both states.
+	balanceDeltas []int64
+	// inactivityScoreDeltas are the differences between the inactivity scores of both states.
+	inactivityScoreDeltas []int64
+	// previousParticipation is the XOR of the previous epoch participation of both states.
+	previousParticipation []byte
+	// currentParticipation is the XOR of the current epoch participation of both states.
+	currentParticipation []byte
+}
+
+// computeStateDiff returns the diff that turns base into st. A nil base computes a snapshot of st.
+func computeStateDiff(base, st state.BeaconState) (*stateDiff, error) {
+	if base != nil && base.Version() > st.Version() {
+		return nil, errors.Errorf("base state version %s is newer than state version %s", version.String(base.Version()), version.String(st.Version()))
+	}
+
+	d := &stateDiff{
+		stateVersion:  st.Version(),
+		numValidators: uint64(st.NumValidators()),
+		validators:    make(map[uint64]*ethpb.Validator),
+	}
+
+	remainder, err := stateRemainder(st)
+	if err != nil {
+		return nil, err
+	}
+	var baseRemainder []byte
+	if base != nil {
+		d.parentSlot = base.Slot()
+		if baseRemainder, err = stateRemainder(base); err != nil {
+			return nil, err
+		}
+	}
+	d.remainder = xorBytes(remainder, baseRemainder)
+
+	var baseValidators []state.ReadOnlyValidator
+	if base != nil {
+		baseValidators = base.ValidatorsReadOnly()
+	}
+	for i, v := range st.ValidatorsReadOnly() {
+		if i < len(baseValidators) && validatorsEqual(baseValidators[i], v) {
+			continue
+		}
+		val, err := st.ValidatorAtIndex(primitives.ValidatorIndex(i))
+		if err != nil {
+			return nil, err
+		}
+		d.validators[uint64(i)] = val
+	}
+
+	var baseBalances []uint64
+	if base != nil {
+		baseBalances = base.Balances()
+	}
+	d.balanceDeltas = deltas(st.Balances(), baseBalances)
+
+	if st.Version() < version.Altair {
+		return d, nil
+	}
+
+	scores, err := st.InactivityScores()
+	if err != nil {
+		return nil, err
+	}
+	previous, err := st.PreviousEpochParticipation()
+	if err != nil {
+		return nil, err
+	}
+	current, err := st.CurrentEpochParticipation()
+	if err != nil {
+		return nil, err
+	}
+	var baseScores []uint64
+	var basePrevious, baseCurrent []byte
+	if base != nil && base.Version() >= version.Altair {
+		if baseScores, err = base.InactivityScores(); err != nil {
+			return nil, err
+		}
+		if basePrevious, err = base.PreviousEpochParticipation(); err != nil {
+			return nil, err
+		}
+		if baseCurrent, err = base.CurrentEpochParticipation(); err != nil {
+			return nil, err
+		}
+	}
+	d.inactivityScoreDeltas = deltas(scores, baseScores)
+	d.previousParticipation = xorBytes(previous, basePrevious)
+	d.currentParticipation = xorBytes(current, baseCurrent)
+
+	return d, nil
+}
+
+// applyStateDiff applies d to base, which must be nil for snapshots, and returns the resulting state.
+func applyStateDiff(base state.BeaconState, d *stateDiff) (state.BeaconState, error) {
+	var baseRemainder []byte
+	var err error
+	if base != nil {
+		if baseRemainder, err = stateRemainder(base); err != nil {
+			return nil, err
+		}
+	}
+	st, err := stateFromRemainder(d.stateVersion, xorBytes(d.remainder, baseRemainder))
+	if err != nil {
+		return nil, err
+	}
+
+	var baseValidators []*ethpb.Validator
+	var baseBalances []uint64
+	if base != nil {
+		baseValidators = base.Validators()
+		baseBalances = base.Balances()
+	}
+	validators := make([]*ethpb.Validator, d.numValidators)
+	copy(validators, baseValidators)
+	for i, v := range d.validators {
+		if i >= d.numValidators {
+			return nil, errors.Errorf("validator index %d out of range, state has %d validators", i, d.numValidators)
+		}
+		validators[i] = v
+	}
+	for i, v := range validators {
+		if v == nil {
+			return nil, errors.Errorf("missing validator at index %d", i)
+		}
+	}
+	if err := st.SetValidators(validators); err != nil {
+		return nil, err
+	}
+	if err := st.SetBalances(applyDeltas(d.balanceDeltas, baseBalances)); err != nil {
+		return nil, err
+	}
+
+	if d.stateVersion < version.Altair {
+		return st, nil
+	}
+
+	var baseScores []uint64
+	var basePrevious, baseCurrent []byte
+	if base != nil && base.Version() >= version.Altair {
+		if baseScores, err = base.InactivityScores(); err != nil {
+			return nil, err
+		}
+		if basePrevious, err = base.PreviousEpochParticipation(); err != nil {
+			return nil, err
+		}
+		if baseCurrent, err = base.CurrentEpochParticipation(); err != nil {
+			return nil, err
+		}
+	}
+	if err := st.SetInactivityScores(applyDeltas(d.inactivityScoreDeltas, baseScores)); err != nil {
+		return nil, err
+	}
+	if err := st.SetPreviousParticipationBits(xorBytes(d.previousParticipation, basePrevious)); err != nil {
+		return nil, err
+	}
+	if err := st.SetCurrentParticipationBits(xorBytes(d.currentParticipation, baseCurrent)); err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+// stateRemainder returns the SSZ encoding of st without its validators, balances, inactivity scores
+// and epoch participation.
+func stateRemainder(st state.BeaconState) ([]byte, error) {
+	st = st.Copy()
+	if err := st.SetValidators([]*ethpb.Validator{}); err != nil {
+		return nil, err
+	}
+	if err := st.SetBalances([]uint64{}); err != nil {
+		return nil, err
+	}
+	if st.Version() >= version.Altair {
+		if err := st.SetInactivityScores([]uint64{}); err != nil {
+			return nil, err
+		}
+		if err := st.SetPreviousParticipationBits([]byte{}); err != nil {
+			return nil, err
+		}
+		if err := st.SetCurrentParticipationBits([]byte{}); err != nil {
+			return nil, err
+		}
+	}
+	return st.MarshalSSZ()
+}
+
+// stateFromRemainder decodes a state encoded by stateRemainder.
+func stateFromRemainder(v int, enc []byte) (state.BeaconState, error) {
+	switch v {
+	case version.Phase0:
+		protoState := &ethpb.BeaconState{}
+		if err := protoState.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for phase0")
+		}
+		return statenative.InitializeFromProtoUnsafePhase0(protoState)
+	case version.Altair:
+		protoState := &ethpb.BeaconStateAltair{}
+		if err := protoState.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for altair")
+		}
+		return statenative.InitializeFromProtoUnsafeAltair(protoState)
+	case version.Bellatrix:
+		protoState := &ethpb.BeaconStateBellatrix{}
+		if err := protoState.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for bellatrix")
+		}
+		return statenative.InitializeFromProtoUnsafeBellatrix(protoState)
+	case version.Capella:
+		protoState := &ethpb.BeaconStateCapella{}
+		if err := protoState.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for capella")
+		}
+		return statenative.InitializeFromProtoUnsafeCapella(protoState)
+	case version.Deneb:
+		protoState := &ethpb.BeaconStateDeneb{}
+		if err := protoState.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for Deneb")
+		}
+		return statenative.InitializeFromProtoUnsafeDeneb(protoState)
+	case version.Electra:
+		protoState := &ethpb.BeaconStateElectra{}
+		if err := protoState.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for Electra")
+		}
+		return statenative.InitializeFromProtoUnsafeElectra(protoState)
+	default:
+		return nil, errors.Errorf("unsupported state version %s", version.String(v))
+	}
+}
+
+func validatorsEqual(a, b state.ReadOnlyValidator) bool {
+	return a.PublicKey() == b.PublicKey() &&
+		bytes.Equal(a.GetWithdrawalCredentials(), b.GetWithdrawalCredentials()) &&
+		a.EffectiveBalance() == b.EffectiveBalance() &&
+		a.Slashed() == b.Slashed() &&
+		a.ActivationEligibilityEpoch() == b.ActivationEligibilityEpoch() &&
+		a.ActivationEpoch() == b.ActivationEpoch() &&
+		a.ExitEpoch() == b.ExitEpoch() &&
+		a.WithdrawableEpoch() == b.WithdrawableEpoch()
+}
+
+// xorBytes returns a XORed with b, where b is truncated or padded with zeros to the length of a.
+func xorBytes(a, b []byte) []byte {
+	res := make([]byte, len(a))
+	copy(res, a)
+	for i := 0; i < len(res) && i < len(b); i++ {
+		res[i] ^= b[i]
+	}
+	return res
+}
+
+// deltas returns the differences between values and base, where missing base values are zeros.
+func deltas(values, base []uint64) []int64 {
+	res := make([]int64, len(values))
+	for i, v := range values {
+		if i < len(base) {
+			res[i] = int64(v - base[i])
+		} else {
+			res[i] = int64(v)
+		}
+	}
+	return res
+}
+
+// applyDeltas reverses deltas.
+func applyDeltas(deltas []int64, base []uint64) []uint64 {
+	res := make([]uint64, len(deltas))
+	for i, d := range deltas {
+		if i < len(base) {
+			res[i] = base[i] + uint64(d)
+		} else {
+			res[i] = uint64(d)
+		}
+	}
+	return res
+}
+
+// marshal encodes the diff, and compresses it with snappy.
+func (d *stateDiff) marshal() ([]byte, error) {
+	buf := []byte{stateDiffFormatVersion}
+	buf = binary.AppendUvarint(buf, uint64(d.stateVersion))
+	buf = binary.AppendUvarint(buf, uint64(d.parentSlot))
+	buf = appendBytes(buf, d.remainder)
+
+	buf = binary.AppendUvarint(buf, d.numValidators)
+	buf = binary.AppendUvarint(buf, uint64(len(d.validators)))
+	for i := uint64(0); i < d.numValidators; i++ {
+		v, ok := d.validators[i]
+		if !ok {
+			continue
+		}
+		enc, err := v.MarshalSSZ()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not marshal validator %d", i)
+		}
+		buf = binary.AppendUvarint(buf, i)
+		buf = append(buf, enc...)
+	}
+
+	buf = appendDeltas(buf, d.balanceDeltas)
+	buf = appendDeltas(buf, d.inactivityScoreDeltas)
+	buf = appendBytes(buf, d.previousParticipation)
+	buf = appendBytes(buf, d.currentParticipation)
+
+	return snappy.Encode(nil, buf), nil
+}
+
+// unmarshalStateDiff decodes a diff encoded by stateDiff.marshal.
+func unmarshalStateDiff(enc []byte) (*stateDiff, error) {
+	buf, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress state diff")
+	}
+	r := &stateDiffReader{buf: buf}
+	if formatVersion := r.byte(); formatVersion != stateDiffFormatVersion {
+		return nil, errors.Errorf("unsupported state diff format version %d", formatVersion)
+	}
+
+	d := &stateDiff{
+		stateVersion: int(r.uvarint()),
+		parentSlot:   primitives.Slot(r.uvarint()),
+		remainder:    r.bytes(),
+	}
+
+	d.numValidators = r.uvarint()
+	numChanged := r.uvarint()
+	if r.err == nil && numChanged > d.numValidators {
+		return nil, errors.Errorf("state diff has %d changed validators out of %d", numChanged, d.numValidators)
+	}
+	d.validators = make(map[uint64]*ethpb.Validator, numChanged)
+	for i := uint64(0); i < numChanged && r.err == nil; i++ {
+		idx := r.uvarint()
+		v := &ethpb.Validator{}
+		if err := v.UnmarshalSSZ(r.next(validatorSSZSize)); err != nil && r.err == nil {
+			r.err = errors.Wrapf(err, "could not unmarshal validator %d", idx)
+		}
+		d.validators[idx] = v
+	}
+
+	d.balanceDeltas = r.deltas()
+	d.inactivityScoreDeltas = r.deltas()
+	d.previousParticipation = r.bytes()
+	d.currentParticipation = r.bytes()
+
+	if r.err != nil {
+		return nil, errors.Wrap(r.err, "could not decode state diff")
+	}
+	return d, nil
+}
+
+func appendBytes(buf, b []byte) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(b)))
+	return append(buf, b...)
+}
+
+func appendDeltas(buf []byte, deltas []int64) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(deltas)))
+	for _, d := range deltas {
+		buf = binary.AppendVarint(buf, d)
+	}
+	return buf
+}
+
+// stateDiffReader decodes the fields of a state diff, recording the first error it encounters.
+type stateDiffReader struct {
+	buf []byte
+	err error
+}
+
+func (r *stateDiffReader) next(n uint64) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n > uint64(len(r.buf)) {
+		r.err = errors.Errorf("unexpected end of state diff, need %d bytes, have %d", n, len(r.buf))
+		return nil
+	}
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+	return b
+}
+
+func (r *stateDiffReader) byte() byte {
+	b := r.next(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+func (r *stateDiffReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.buf)
+	if n <= 0 {
+		r.err = errors.New("invalid varint in state diff")
+		return 0
+	}
+	r.buf = r.buf[n:]
+	return v
+}
+
+func (r *stateDiffReader) varint() int64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(r.buf)
+	if n <= 0 {
+		r.err = errors.New("invalid varint in state diff")
+		return 0
+	}
+	r.buf = r.buf[n:]
+	return v
+}
+
+func (r *stateDiffReader) bytes() []byte {
+	b := r.next(r.uvarint())
+	if b == nil {
+		return []byte{}
+	}
+	return append([]byte{}, b...)
+}
+
+func (r *stateDiffReader) deltas() []int64 {
+	n := r.uvarint()
+	if r.err == nil && n > uint64(len(r.buf)) {
+		r.err = errors.Errorf("state diff has %d deltas but only %d bytes left", n, len(r.buf))
+	}
+	if r.err != nil {
+		return nil
+	}
+	res := make([]int64, n)
+	for i := range res {
+		res[i] = r.varint()
+	}
+	return res
+}
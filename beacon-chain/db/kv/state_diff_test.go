@@ -0,0 +1,121 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+// mutateState changes the fields of st that are diffed field by field, and a few of the others.
+func mutateState(t *testing.T, st state.BeaconState, slot primitives.Slot) {
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, st.UpdateBalancesAtIndex(1, st.Balances()[1]-1000))
+	require.NoError(t, st.UpdateBalancesAtIndex(2, st.Balances()[2]+1000))
+	require.NoError(t, st.UpdateRandaoMixesAtIndex(uint64(slot)%8, [32]byte{byte(slot)}))
+	require.NoError(t, st.UpdateValidatorAtIndex(3, &ethpb.Validator{
+		PublicKey:             make([]byte, 48),
+		WithdrawalCredentials: make([]byte, 32),
+		EffectiveBalance:      1,
+		Slashed:               true,
+		ExitEpoch:             10,
+		WithdrawableEpoch:     20,
+	}))
+	require.NoError(t, st.AppendValidator(&ethpb.Validator{
+		PublicKey:             []byte{byte(slot), 47: 0},
+		WithdrawalCredentials: make([]byte, 32),
+	}))
+	require.NoError(t, st.AppendBalance(32))
+	if _, err := st.InactivityScores(); err == nil {
+		require.NoError(t, st.AppendInactivityScore(5))
+		require.NoError(t, st.AppendPreviousParticipationBits(1))
+		require.NoError(t, st.AppendCurrentParticipationBits(7))
+	}
+}
+
+func requireSameState(t *testing.T, want, got state.BeaconState) {
+	wantRoot, err := want.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	gotRoot, err := got.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, want.Version(), got.Version())
+	require.Equal(t, wantRoot, gotRoot)
+}
+
+func roundTripStateDiff(t *testing.T, base, st state.BeaconState) state.BeaconState {
+	d, err := computeStateDiff(base, st)
+	require.NoError(t, err)
+	enc, err := d.marshal()
+	require.NoError(t, err)
+	decoded, err := unmarshalStateDiff(enc)
+	require.NoError(t, err)
+	got, err := applyStateDiff(base, decoded)
+	require.NoError(t, err)
+	return got
+}
+
+func TestStateDiff_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		genesis func(testing.TB, uint64) (state.BeaconState, []bls.SecretKey)
+	}{
+		{name: "phase0", genesis: util.DeterministicGenesisState},
+		{name: "altair", genesis: util.DeterministicGenesisStateAltair},
+		{name: "bellatrix", genesis: util.DeterministicGenesisStateBellatrix},
+		{name: "capella", genesis: util.DeterministicGenesisStateCapella},
+		{name: "deneb", genesis: util.DeterministicGenesisStateDeneb},
+		{name: "electra", genesis: util.DeterministicGenesisStateElectra},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base, _ := tt.genesis(t, 16)
+			require.NoError(t, base.SetSlot(64))
+
+			// A snapshot has no base.
+			requireSameState(t, base, roundTripStateDiff(t, nil, base))
+
+			st := base.Copy()
+			mutateState(t, st, 96)
+			requireSameState(t, st, roundTripStateDiff(t, base, st))
+		})
+	}
+}
+
+func TestStateDiff_AcrossForks(t *testing.T) {
+	base, _ := util.DeterministicGenesisState(t, 16)
+	st, _ := util.DeterministicGenesisStateAltair(t, 16)
+	mutateState(t, st, 32)
+	requireSameState(t, st, roundTripStateDiff(t, base, st))
+
+	_, err := computeStateDiff(st, base)
+	require.ErrorContains(t, "is newer than state version", err)
+}
+
+func TestStateDiff_OnlyChangedValidators(t *testing.T) {
+	base, _ := util.DeterministicGenesisStateAltair(t, 16)
+	st := base.Copy()
+	mutateState(t, st, 32)
+
+	d, err := computeStateDiff(base, st)
+	require.NoError(t, err)
+	require.Equal(t, uint64(17), d.numValidators)
+	require.Equal(t, 2, len(d.validators))
+	require.NotNil(t, d.validators[3])
+	require.NotNil(t, d.validators[16])
+}
+
+func TestStateDiff_Corrupted(t *testing.T) {
+	st, _ := util.DeterministicGenesisStateAltair(t, 16)
+	d, err := computeStateDiff(nil, st)
+	require.NoError(t, err)
+	enc, err := d.marshal()
+	require.NoError(t, err)
+
+	_, err = unmarshalStateDiff(enc[:len(enc)/2])
+	require.NotNil(t, err)
+}
@@ -0,0 +1,234 @@
+// Package pruner defines a service deleting the blocks and states that a beacon node no longer needs to serve, once
+// they are older than a retention period.
+package pruner
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// retentionMargin is added to the weak subjectivity period when no retention period is configured, so that peers
+// syncing from a checkpoint at the edge of the period can still be served the blocks they need.
+const retentionMargin = primitives.Epoch(256)
+
+// Database is the set of database methods needed by the pruner.
+type Database interface {
+	FinalizedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error)
+	DeleteHistoricalDataBeforeSlot(ctx context.Context, cutoff primitives.Slot) (int, error)
+}
+
+// StateByRooter retrieves the state of a block root, like stategen.
+type StateByRooter interface {
+	StateByRoot(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
+}
+
+// StatusReloader is notified that the range of available blocks changed, like backfill.Store.
+type StatusReloader interface {
+	Reload(ctx context.Context) error
+}
+
+// ServiceOption is a functional option for the pruner service.
+type ServiceOption func(*Service)
+
+// WithRetentionPeriod sets the number of epochs of blocks and states to keep. When it is 0, which is the default,
+// the weak subjectivity period computed from the finalized state is used, plus a margin. Periods shorter than
+// MIN_EPOCHS_FOR_BLOCK_REQUESTS are raised to it, as peers may request blocks that old.
+func WithRetentionPeriod(epochs primitives.Epoch) ServiceOption {
+	return func(s *Service) {
+		if epochs > 0 && epochs < minRetentionPeriod() {
+			log.WithFields(logrus.Fields{
+				"retentionEpochs":    epochs,
+				"minRetentionEpochs": minRetentionPeriod(),
+			}).Warn("Pruner retention period is shorter than the epochs peers may request blocks for, " +
+				"keeping blocks and states for MIN_EPOCHS_FOR_BLOCK_REQUESTS epochs instead")
+			epochs = minRetentionPeriod()
+		}
+		s.retention = epochs
+	}
+}
+
+// WithStatusReloader sets the StatusReloader notified after each pruning.
+func WithStatusReloader(r StatusReloader) ServiceOption {
+	return func(s *Service) {
+		s.reloader = r
+	}
+}
+
+// WithInitSyncWaiter sets a function which blocks until initial sync is complete. The pruner starts afterwards.
+func WithInitSyncWaiter(w func() error) ServiceOption {
+	return func(s *Service) {
+		s.initSyncWaiter = w
+	}
+}
+
+// WithSlotTicker sets the ticker of the slots at which to prune, instead of the start of each epoch.
+func WithSlotTicker(t slots.Ticker) ServiceOption {
+	return func(s *Service) {
+		s.ticker = t
+	}
+}
+
+// Service deletes the blocks and states below a retention period, every epoch.
+type Service struct {
+	ctx            context.Context
+	cancel         context.CancelFunc
+	db             Database
+	stateGen       StateByRooter
+	cw             startup.ClockWaiter
+	retention      primitives.Epoch
+	reloader       StatusReloader
+	initSyncWaiter func() error
+	ticker         slots.Ticker
+	prunedBefore   primitives.Slot
+	done           chan struct{}
+}
+
+// New creates a pruner service, which starts pruning when Start is called.
+func New(ctx context.Context, db Database, stateGen StateByRooter, cw startup.ClockWaiter, opts ...ServiceOption) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		ctx:      ctx,
+		cancel:   cancel,
+		db:       db,
+		stateGen: stateGen,
+		cw:       cw,
+		done:     make(chan struct{}),
+	}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
+}
+
+// Start the pruner in the background.
+func (s *Service) Start() {
+	go s.run()
+}
+
+// Stop the pruner, waiting for an ongoing pruning to end.
+func (s *Service) Stop() error {
+	s.cancel()
+	<-s.done
+	return nil
+}
+
+// Status of the pruner service.
+func (*Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	defer close(s.done)
+	clock, err := s.cw.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Pruner failed to start while waiting for genesis data")
+		return
+	}
+	if s.initSyncWaiter != nil {
+		if err := s.initSyncWaiter(); err != nil {
+			log.WithError(err).Error("Error waiting for initial sync to complete")
+			return
+		}
+	}
+	if s.ticker == nil {
+		s.ticker = slots.NewSlotTicker(clock.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	}
+	defer s.ticker.Done()
+
+	s.pruneAndLog(clock.CurrentSlot())
+	for {
+		select {
+		case slot := <-s.ticker.C():
+			if slots.IsEpochStart(slot) {
+				s.pruneAndLog(slot)
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) pruneAndLog(slot primitives.Slot) {
+	start := time.Now()
+	cutoff, pruned, err := s.prune(s.ctx, slot)
+	if err != nil {
+		log.WithError(err).Error("Failed to prune blocks and states")
+		return
+	}
+	if pruned == 0 {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"prunedBefore": cutoff,
+		"blocks":       pruned,
+		"duration":     time.Since(start),
+	}).Info("Pruned blocks and states")
+}
+
+// prune deletes the blocks and states below the retention period as seen at the given slot. It returns the slot
+// below which data was pruned, and the number of deleted blocks.
+func (s *Service) prune(ctx context.Context, slot primitives.Slot) (primitives.Slot, int, error) {
+	retention, err := s.retentionPeriod(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	epoch := slots.ToEpoch(slot)
+	if epoch <= retention {
+		return 0, 0, nil
+	}
+	cutoff, err := slots.EpochStart(epoch - retention)
+	if err != nil {
+		return 0, 0, err
+	}
+	if cutoff <= s.prunedBefore {
+		return cutoff, 0, nil
+	}
+	pruned, err := s.db.DeleteHistoricalDataBeforeSlot(ctx, cutoff)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "could not delete data before slot %d", cutoff)
+	}
+	s.prunedBefore = cutoff
+	if pruned > 0 && s.reloader != nil {
+		if err := s.reloader.Reload(ctx); err != nil {
+			return 0, 0, errors.Wrap(err, "could not reload the range of available blocks")
+		}
+	}
+	return cutoff, pruned, nil
+}
+
+// retentionPeriod returns the configured retention period, or the weak subjectivity period of the finalized state
+// plus a margin, and at least MIN_EPOCHS_FOR_BLOCK_REQUESTS.
+func (s *Service) retentionPeriod(ctx context.Context) (primitives.Epoch, error) {
+	if s.retention > 0 {
+		return max(s.retention, minRetentionPeriod()), nil
+	}
+	cp, err := s.db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	st, err := s.stateGen.StateByRoot(ctx, bytesutil.ToBytes32(cp.Root))
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get finalized state")
+	}
+	wsp, err := helpers.ComputeWeakSubjectivityPeriod(ctx, st, params.BeaconConfig())
+	if err != nil {
+		return 0, errors.Wrap(err, "could not compute weak subjectivity period")
+	}
+	return max(wsp+retentionMargin, minRetentionPeriod()), nil
+}
+
+// minRetentionPeriod is the number of epochs for which peers may request blocks, MIN_EPOCHS_FOR_BLOCK_REQUESTS.
+func minRetentionPeriod() primitives.Epoch {
+	return primitives.Epoch(params.BeaconConfig().MinEpochsForBlockRequests)
+}
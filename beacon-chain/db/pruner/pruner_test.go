@@ -0,0 +1,172 @@
+package pruner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	slottest "github.com/prysmaticlabs/prysm/v5/time/slots/testing"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+type mockDatabase struct {
+	cutoffs []primitives.Slot
+	pruned  int
+	err     error
+	deleted chan primitives.Slot
+}
+
+func (*mockDatabase) FinalizedCheckpoint(context.Context) (*ethpb.Checkpoint, error) {
+	return &ethpb.Checkpoint{Root: make([]byte, 32)}, nil
+}
+
+func (m *mockDatabase) DeleteHistoricalDataBeforeSlot(_ context.Context, cutoff primitives.Slot) (int, error) {
+	if m.err != nil {
+		return 0, m.err
+	}
+	m.cutoffs = append(m.cutoffs, cutoff)
+	if m.deleted != nil {
+		m.deleted <- cutoff
+	}
+	return m.pruned, nil
+}
+
+type mockStateGen struct {
+	st state.BeaconState
+}
+
+func (m *mockStateGen) StateByRoot(context.Context, [32]byte) (state.BeaconState, error) {
+	return m.st, nil
+}
+
+type mockReloader struct {
+	reloads int
+}
+
+func (m *mockReloader) Reload(context.Context) error {
+	m.reloads++
+	return nil
+}
+
+// setMinRetentionPeriod overrides MIN_EPOCHS_FOR_BLOCK_REQUESTS, so that tests can use short retention periods.
+func setMinRetentionPeriod(t *testing.T, epochs uint64) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.MinEpochsForBlockRequests = epochs
+	params.OverrideBeaconConfig(cfg)
+}
+
+func TestService_Prune(t *testing.T) {
+	setMinRetentionPeriod(t, 10)
+	ctx := context.Background()
+	db := &mockDatabase{pruned: 10}
+	reloader := &mockReloader{}
+	s := New(ctx, db, nil, nil, WithRetentionPeriod(100), WithStatusReloader(reloader))
+
+	// Nothing is old enough.
+	_, pruned, err := s.prune(ctx, params.BeaconConfig().SlotsPerEpoch*100)
+	require.NoError(t, err)
+	assert.Equal(t, 0, pruned)
+	assert.Equal(t, 0, len(db.cutoffs))
+
+	slot := params.BeaconConfig().SlotsPerEpoch * 150
+	cutoff, pruned, err := s.prune(ctx, slot)
+	require.NoError(t, err)
+	assert.Equal(t, 10, pruned)
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch*50, cutoff)
+	assert.DeepEqual(t, []primitives.Slot{cutoff}, db.cutoffs)
+	assert.Equal(t, 1, reloader.reloads)
+
+	// The same epoch is not pruned twice.
+	_, _, err = s.prune(ctx, slot+1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(db.cutoffs))
+
+	// Nothing to reload when nothing was pruned.
+	db.pruned = 0
+	_, _, err = s.prune(ctx, slot+params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(db.cutoffs))
+	assert.Equal(t, 1, reloader.reloads)
+
+	db.err = errors.New("bolt")
+	_, _, err = s.prune(ctx, slot+2*params.BeaconConfig().SlotsPerEpoch)
+	require.ErrorContains(t, "could not delete data before slot", err)
+}
+
+func TestService_RetentionPeriod(t *testing.T) {
+	setMinRetentionPeriod(t, 10)
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisState(t, 64)
+	wsp, err := helpers.ComputeWeakSubjectivityPeriod(ctx, st, params.BeaconConfig())
+	require.NoError(t, err)
+
+	s := New(ctx, &mockDatabase{}, &mockStateGen{st: st}, nil)
+	retention, err := s.retentionPeriod(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, wsp+retentionMargin, retention)
+
+	s = New(ctx, &mockDatabase{}, &mockStateGen{st: st}, nil, WithRetentionPeriod(10))
+	retention, err = s.retentionPeriod(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Epoch(10), retention)
+}
+
+func TestService_RetentionPeriodIsAtLeastMinEpochsForBlockRequests(t *testing.T) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisState(t, 64)
+	wsp, err := helpers.ComputeWeakSubjectivityPeriod(ctx, st, params.BeaconConfig())
+	require.NoError(t, err)
+	minRetention := wsp + retentionMargin + 1
+	setMinRetentionPeriod(t, uint64(minRetention))
+
+	s := New(ctx, &mockDatabase{}, &mockStateGen{st: st}, nil)
+	retention, err := s.retentionPeriod(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, minRetention, retention)
+
+	hook := logTest.NewGlobal()
+	s = New(ctx, &mockDatabase{}, &mockStateGen{st: st}, nil, WithRetentionPeriod(minRetention-1))
+	require.LogsContain(t, hook, "Pruner retention period is shorter than the epochs peers may request blocks for")
+	retention, err = s.retentionPeriod(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, minRetention, retention)
+
+	hook.Reset()
+	s = New(ctx, &mockDatabase{}, &mockStateGen{st: st}, nil, WithRetentionPeriod(minRetention+1))
+	require.LogsDoNotContain(t, hook, "Pruner retention period is shorter")
+	retention, err = s.retentionPeriod(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, minRetention+1, retention)
+}
+
+func TestService_PrunesEveryEpoch(t *testing.T) {
+	setMinRetentionPeriod(t, 10)
+	spe := params.BeaconConfig().SlotsPerEpoch
+	db := &mockDatabase{pruned: 1, deleted: make(chan primitives.Slot, 2)}
+	ticker := &slottest.MockTicker{Channel: make(chan primitives.Slot)}
+	cs := startup.NewClockSynchronizer()
+	genesis := time.Now().Add(-time.Duration(uint64(spe)*20*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	require.NoError(t, cs.SetClock(startup.NewClock(genesis, [32]byte{})))
+
+	s := New(context.Background(), db, nil, cs, WithRetentionPeriod(10), WithSlotTicker(ticker))
+	s.Start()
+
+	// The pruner runs once started, then at the start of each epoch.
+	assert.Equal(t, spe*10, <-db.deleted)
+	ticker.Channel <- spe*21 + 1
+	ticker.Channel <- spe * 22
+	assert.Equal(t, spe*12, <-db.deleted)
+	require.NoError(t, s.Stop())
+	assert.Equal(t, 2, len(db.cutoffs))
+}
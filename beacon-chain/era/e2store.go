@@ -0,0 +1,86 @@
+package era
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+)
+
+// e2store records start with a header of a 2 byte type, a 4 byte little-endian length and 2 reserved zero bytes.
+const headerLength = 8
+
+// maxRecordLength bounds the length of the records read, which is far above the size of a compressed state.
+const maxRecordLength = 1 << 30
+
+var (
+	typeVersion                     = [2]byte{0x65, 0x32}
+	typeCompressedSignedBeaconBlock = [2]byte{0x01, 0x00}
+	typeCompressedBeaconState       = [2]byte{0x02, 0x00}
+	typeSlotIndex                   = [2]byte{0x69, 0x32}
+)
+
+var (
+	errInvalidHeader  = errors.New("invalid e2store record header")
+	errRecordTooLong  = errors.New("e2store record is too long")
+	errUnexpectedType = errors.New("unexpected e2store record type")
+)
+
+// writeRecord writes a record of the given type and returns the number of bytes written.
+func writeRecord(w io.Writer, typ [2]byte, data []byte) (int64, error) {
+	if len(data) > maxRecordLength {
+		return 0, errRecordTooLong
+	}
+	var header [headerLength]byte
+	copy(header[:2], typ[:])
+	binary.LittleEndian.PutUint32(header[2:6], uint32(len(data)))
+	if _, err := w.Write(header[:]); err != nil {
+		return 0, err
+	}
+	if _, err := w.Write(data); err != nil {
+		return 0, err
+	}
+	return int64(headerLength + len(data)), nil
+}
+
+// readRecord reads the record starting at the given offset, which must be of the given type.
+func readRecord(r io.ReaderAt, offset int64, typ [2]byte) ([]byte, error) {
+	var header [headerLength]byte
+	if _, err := r.ReadAt(header[:], offset); err != nil {
+		return nil, errors.Wrapf(err, "could not read record header at offset %d", offset)
+	}
+	if header[6] != 0 || header[7] != 0 {
+		return nil, errors.Wrapf(errInvalidHeader, "reserved bytes are not zero at offset %d", offset)
+	}
+	if !bytes.Equal(header[:2], typ[:]) {
+		return nil, errors.Wrapf(errUnexpectedType, "type %#x at offset %d, expected %#x", header[:2], offset, typ)
+	}
+	length := binary.LittleEndian.Uint32(header[2:6])
+	if length > maxRecordLength {
+		return nil, errors.Wrapf(errRecordTooLong, "length %d at offset %d", length, offset)
+	}
+	data := make([]byte, length)
+	if _, err := r.ReadAt(data, offset+headerLength); err != nil {
+		return nil, errors.Wrapf(err, "could not read record of length %d at offset %d", length, offset)
+	}
+	return data, nil
+}
+
+// compress encodes the data with the framed snappy format used by era files.
+func compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := snappy.NewBufferedWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decompress(data []byte) ([]byte, error) {
+	return io.ReadAll(snappy.NewReader(bytes.NewReader(data)))
+}
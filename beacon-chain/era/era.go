@@ -0,0 +1,123 @@
+// Package era reads and writes era files, which archive finalized history in e2store containers.
+//
+// The era file of era N holds the snappy-framed SSZ blocks of the slots from (N-1)*SLOTS_PER_HISTORICAL_ROOT
+// to N*SLOTS_PER_HISTORICAL_ROOT excluded, the state at slot N*SLOTS_PER_HISTORICAL_ROOT, before the block of that
+// slot is applied, and the indices locating them by slot:
+//
+//	era := Version | block* | era-state | slot-index(block)? | slot-index(state)
+//
+// Era 0 only holds the genesis state.
+package era
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+const fileExtension = ".era"
+
+// File is an era file in a directory.
+type File struct {
+	Era  uint64
+	Path string
+}
+
+// StateSlot returns the slot of the state of the given era.
+func StateSlot(era uint64) primitives.Slot {
+	return primitives.Slot(era) * params.BeaconConfig().SlotsPerHistoricalRoot
+}
+
+// BlockSlots returns the range of the slots of the blocks of the given era, with the end excluded.
+func BlockSlots(era uint64) (primitives.Slot, primitives.Slot) {
+	if era == 0 {
+		return 0, 0
+	}
+	return StateSlot(era - 1), StateSlot(era)
+}
+
+// ForSlot returns the era holding the block of the given slot.
+func ForSlot(slot primitives.Slot) uint64 {
+	return uint64(slot/params.BeaconConfig().SlotsPerHistoricalRoot) + 1
+}
+
+// FileName returns the standard name of the era file of the given era, whose state is st:
+// <config-name>-<era-number>-<short-historical-root>.era.
+func FileName(era uint64, st state.ReadOnlyBeaconState) (string, error) {
+	root, err := shortHistoricalRoot(era, st)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%05d-%s%s", params.BeaconConfig().ConfigName, era, root, fileExtension), nil
+}
+
+// shortHistoricalRoot returns the first 4 bytes of the root of the last historical summary of the state, or of its
+// last historical root before Capella. The genesis era has no historical root, and uses the genesis validators root.
+func shortHistoricalRoot(era uint64, st state.ReadOnlyBeaconState) (string, error) {
+	if era == 0 {
+		return fmt.Sprintf("%x", st.GenesisValidatorsRoot()[:4]), nil
+	}
+	summaries, err := st.HistoricalSummaries()
+	if err == nil && len(summaries) > 0 {
+		root, err := summaries[len(summaries)-1].HashTreeRoot()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%x", root[:4]), nil
+	}
+	roots, err := st.HistoricalRoots()
+	if err != nil {
+		return "", err
+	}
+	if len(roots) == 0 {
+		return "", errors.Errorf("state at slot %d has no historical root", st.Slot())
+	}
+	return fmt.Sprintf("%x", roots[len(roots)-1][:4]), nil
+}
+
+// ParseFileName returns the era of a file named by FileName.
+func ParseFileName(name string) (uint64, error) {
+	parts := strings.Split(strings.TrimSuffix(filepath.Base(name), fileExtension), "-")
+	if !strings.HasSuffix(name, fileExtension) || len(parts) < 3 {
+		return 0, errors.Errorf("%s is not an era file name", name)
+	}
+	era, err := strconv.ParseUint(parts[len(parts)-2], 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not parse the era of file %s", name)
+	}
+	return era, nil
+}
+
+// ListFiles returns the era files of the directory, sorted by era. There may only be one file per era.
+func ListFiles(dir string) ([]File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read era directory %s", dir)
+	}
+	var files []File
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), fileExtension) {
+			continue
+		}
+		era, err := ParseFileName(e.Name())
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, File{Era: era, Path: filepath.Join(dir, e.Name())})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Era < files[j].Era })
+	for i := 1; i < len(files); i++ {
+		if files[i].Era == files[i-1].Era {
+			return nil, errors.Errorf("there are several files for era %d: %s and %s", files[i].Era, files[i-1].Path, files[i].Path)
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,89 @@
+package era
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func setupEraConfig(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	// Smaller eras keep the files of the tests small, the state stays sized for mainnet.
+	cfg := params.MainnetConfig().Copy()
+	cfg.SlotsPerHistoricalRoot = 64
+	params.OverrideBeaconConfig(cfg)
+}
+
+func TestSlots(t *testing.T) {
+	setupEraConfig(t)
+	perEra := params.BeaconConfig().SlotsPerHistoricalRoot
+	require.Equal(t, primitives.Slot(0), StateSlot(0))
+	require.Equal(t, 2*perEra, StateSlot(2))
+
+	start, end := BlockSlots(0)
+	require.Equal(t, primitives.Slot(0), start)
+	require.Equal(t, primitives.Slot(0), end)
+	start, end = BlockSlots(2)
+	require.Equal(t, perEra, start)
+	require.Equal(t, 2*perEra, end)
+
+	require.Equal(t, uint64(1), ForSlot(0))
+	require.Equal(t, uint64(1), ForSlot(perEra-1))
+	require.Equal(t, uint64(2), ForSlot(perEra))
+}
+
+func TestFileName(t *testing.T) {
+	setupEraConfig(t)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetGenesisValidatorsRoot(bytesutil.PadTo([]byte{0x4b, 0x36, 0x3d, 0xb9, 0x42}, 32)))
+
+	name, err := FileName(0, st)
+	require.NoError(t, err)
+	require.Equal(t, "mainnet-00000-4b363db9.era", name)
+
+	_, err = FileName(1, st)
+	require.ErrorContains(t, "has no historical root", err)
+
+	require.NoError(t, st.AppendHistoricalRoots([32]byte{0xab, 0xcd, 0xef, 0x01, 0x23}))
+	name, err = FileName(1, st)
+	require.NoError(t, err)
+	require.Equal(t, "mainnet-00001-abcdef01.era", name)
+
+	era, err := ParseFileName(name)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), era)
+	era, err = ParseFileName("mainnet-01234-00000000.era")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1234), era)
+	_, err = ParseFileName("mainnet-01234-00000000.txt")
+	require.ErrorContains(t, "is not an era file name", err)
+	_, err = ParseFileName("mainnet-abc-00000000.era")
+	require.ErrorContains(t, "could not parse the era", err)
+}
+
+func TestListFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"minimal-00002-01020304.era", "minimal-00000-00000000.era", "minimal-00001-05060708.era", "notes.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0600))
+	}
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub.era"), 0700))
+
+	files, err := ListFiles(dir)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(files))
+	for i, f := range files {
+		require.Equal(t, uint64(i), f.Era)
+	}
+	require.Equal(t, filepath.Join(dir, "minimal-00001-05060708.era"), files[1].Path)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "minimal-00001-0a0b0c0d.era"), nil, 0600))
+	_, err = ListFiles(dir)
+	require.ErrorContains(t, "there are several files for era 1", err)
+}
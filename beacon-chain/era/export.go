@@ -0,0 +1,171 @@
+package era
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// exportChunkSlots is the number of slots of blocks read at once from the database.
+const exportChunkSlots = 256
+
+// Exporter writes the era files of the finalized history of a beacon node database.
+type Exporter struct {
+	db            iface.ReadOnlyDatabase
+	history       *stategen.CanonicalHistory
+	finalizedSlot primitives.Slot
+}
+
+// finalizedChecker considers the blocks of the finalized index as canonical.
+type finalizedChecker struct {
+	db iface.ReadOnlyDatabase
+}
+
+func (c *finalizedChecker) IsCanonical(ctx context.Context, blockRoot [32]byte) (bool, error) {
+	return c.db.IsFinalizedBlock(ctx, blockRoot), nil
+}
+
+type finalizedSlotter struct {
+	slot primitives.Slot
+}
+
+func (s *finalizedSlotter) CurrentSlot() primitives.Slot {
+	return s.slot
+}
+
+// NewExporter creates an Exporter of the history finalized in the database.
+func NewExporter(ctx context.Context, d iface.ReadOnlyDatabase) (*Exporter, error) {
+	cp, err := d.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	finalizedSlot, err := slots.EpochStart(cp.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	return &Exporter{
+		db:            d,
+		history:       stategen.NewCanonicalHistory(d, &finalizedChecker{db: d}, &finalizedSlotter{slot: finalizedSlot}),
+		finalizedSlot: finalizedSlot,
+	}, nil
+}
+
+// LastEra returns the last era whose state is finalized.
+func (e *Exporter) LastEra() uint64 {
+	return uint64(e.finalizedSlot / StateSlot(1))
+}
+
+// Export writes the era file of the given era in the directory, and returns its path.
+func (e *Exporter) Export(ctx context.Context, era uint64, dir string) (string, error) {
+	if era > e.LastEra() {
+		return "", errors.Errorf("era %d is not finalized, the last finalized era is %d", era, e.LastEra())
+	}
+	start, end := BlockSlots(era)
+	if start < end {
+		if err := e.ensureBlocksAvailable(ctx, start); err != nil {
+			return "", err
+		}
+	}
+
+	st, err := e.state(ctx, era)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not get the state of era %d", era)
+	}
+	name, err := FileName(era, st)
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, name)
+
+	// The file only gets its final name once complete.
+	f, err := os.CreateTemp(dir, name+".*.tmp")
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+	w, err := NewWriter(f, era)
+	if err != nil {
+		return "", err
+	}
+	// Blocks are read in chunks to bound memory usage.
+	for chunk := start; chunk < end; chunk += exportChunkSlots {
+		blks, roots, err := e.db.Blocks(ctx, filters.NewFilter().SetStartSlot(chunk).SetEndSlot(chunk+exportChunkSlots-1))
+		if err != nil {
+			return "", errors.Wrapf(err, "could not get the blocks of era %d", era)
+		}
+		finalized := make([]interfaces.ReadOnlySignedBeaconBlock, 0, len(blks))
+		for i, blk := range blks {
+			if e.db.IsFinalizedBlock(ctx, roots[i]) {
+				finalized = append(finalized, blk)
+			}
+		}
+		sort.Slice(finalized, func(i, j int) bool { return finalized[i].Block().Slot() < finalized[j].Block().Slot() })
+		for _, blk := range finalized {
+			if err := w.WriteBlock(blk); err != nil {
+				return "", err
+			}
+		}
+	}
+	if err := w.Finish(st); err != nil {
+		return "", err
+	}
+	if err := f.Sync(); err != nil {
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// ensureBlocksAvailable returns an error if the blocks from the given slot were not all backfilled.
+func (e *Exporter) ensureBlocksAvailable(ctx context.Context, start primitives.Slot) error {
+	bf, err := e.db.BackfillStatus(ctx)
+	if errors.Is(err, db.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not get backfill status")
+	}
+	// The genesis block is always available.
+	if start == 0 {
+		start = 1
+	}
+	if primitives.Slot(bf.LowSlot) > start {
+		return errors.Errorf("blocks below slot %d are missing from the database", bf.LowSlot)
+	}
+	return nil
+}
+
+// state returns the state at the end of the era, before the block of that slot is applied.
+func (e *Exporter) state(ctx context.Context, era uint64) (state.BeaconState, error) {
+	if era == 0 {
+		st, err := e.db.GenesisState(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if st == nil || st.IsNil() {
+			return nil, errors.New("genesis state not found")
+		}
+		return st, nil
+	}
+	slot := StateSlot(era)
+	return e.history.ReplayerForSlot(slot-1).ReplayToSlot(ctx, slot)
+}
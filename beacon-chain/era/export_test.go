@@ -0,0 +1,85 @@
+package era
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	testDB "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/proto/dbval"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestExporter_Genesis(t *testing.T) {
+	setupEraConfig(t)
+	ctx := context.Background()
+	d := testDB.SetupDB(t)
+	st := testState(t, 0)
+	require.NoError(t, st.SetGenesisValidatorsRoot(bytesutil.PadTo([]byte{0x4b, 0x36, 0x3d, 0xb9}, 32)))
+	require.NoError(t, d.SaveGenesisData(ctx, st))
+
+	e, err := NewExporter(ctx, d)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), e.LastEra())
+	dir := t.TempDir()
+	path, err := e.Export(ctx, 0, dir)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "mainnet-00000-4b363db9.era"), path)
+	_, err = e.Export(ctx, 1, dir)
+	require.ErrorContains(t, "era 1 is not finalized", err)
+
+	// Only the complete era file remains in the directory.
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+
+	r, err := Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, r.Close())
+	}()
+	got, err := r.State()
+	require.NoError(t, err)
+	want, err := d.GenesisState(ctx)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, want.ToProtoUnsafe(), got.ToProtoUnsafe())
+}
+
+func TestExporter_MissingBlocks(t *testing.T) {
+	setupEraConfig(t)
+	ctx := context.Background()
+	d := testDB.SetupDB(t)
+	require.NoError(t, d.SaveGenesisData(ctx, testState(t, 0)))
+
+	genesisRoot, err := d.GenesisBlockRoot(ctx)
+	require.NoError(t, err)
+	b := util.NewBeaconBlock()
+	b.Block.Slot = StateSlot(4)
+	b.Block.ParentRoot = genesisRoot[:]
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	root, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveBlock(ctx, blk))
+	st := testState(t, StateSlot(4))
+	require.NoError(t, d.SaveState(ctx, st, root))
+	require.NoError(t, d.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 8, Root: root[:]}))
+	require.NoError(t, d.SaveBackfillStatus(ctx, &dbval.BackfillStatus{
+		LowSlot:       100,
+		LowRoot:       bytesutil.PadTo([]byte{1}, 32),
+		LowParentRoot: bytesutil.PadTo([]byte{2}, 32),
+		OriginSlot:    uint64(StateSlot(4)),
+		OriginRoot:    root[:],
+	}))
+
+	e, err := NewExporter(ctx, d)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), e.LastEra())
+	_, err = e.Export(ctx, 1, t.TempDir())
+	require.ErrorContains(t, "blocks below slot 100 are missing", err)
+}
@@ -0,0 +1,209 @@
+package era
+
+import (
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
+)
+
+var errInvalidIndex = errors.New("invalid era file slot index")
+
+// Reader reads the blocks and the state of an era file.
+type Reader struct {
+	r            io.ReaderAt
+	closer       io.Closer
+	era          uint64
+	blockStart   primitives.Slot
+	blockOffsets []int64
+	stateOffset  int64
+}
+
+// Open opens the era file at the given path.
+func Open(path string) (*Reader, error) {
+	f, err := os.Open(path) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open era file %s", path)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not stat era file %s", path)
+	}
+	r, err := NewReader(f, info.Size())
+	if err != nil {
+		_ = f.Close()
+		return nil, errors.Wrapf(err, "could not read era file %s", path)
+	}
+	r.closer = f
+	return r, nil
+}
+
+// NewReader reads an era file of the given size from r, starting with its slot indices.
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	// The state index, with a single offset, ends the file.
+	stateIndexOffset := size - headerLength - 3*8
+	if stateIndexOffset < headerLength {
+		return nil, errors.Wrap(errInvalidIndex, "file is too small")
+	}
+	start, stateOffsets, err := readSlotIndex(r, stateIndexOffset)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read state index")
+	}
+	if len(stateOffsets) != 1 || stateOffsets[0] == 0 {
+		return nil, errors.Wrap(errInvalidIndex, "state index must hold a single state")
+	}
+	slotsPerEra := params.BeaconConfig().SlotsPerHistoricalRoot
+	if primitives.Slot(start)%slotsPerEra != 0 {
+		return nil, errors.Wrapf(errInvalidIndex, "state slot %d is not at an era boundary", start)
+	}
+	reader := &Reader{
+		r:           r,
+		era:         uint64(primitives.Slot(start) / slotsPerEra),
+		stateOffset: stateOffsets[0],
+	}
+	if reader.era == 0 {
+		return reader, nil
+	}
+
+	// The block index precedes the state index.
+	blockIndexOffset := stateIndexOffset - headerLength - int64(slotsPerEra+2)*8
+	if blockIndexOffset < headerLength {
+		return nil, errors.Wrap(errInvalidIndex, "file is too small for a block index")
+	}
+	blockStart, blockOffsets, err := readSlotIndex(r, blockIndexOffset)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read block index")
+	}
+	if wantStart, _ := BlockSlots(reader.era); primitives.Slot(blockStart) != wantStart || len(blockOffsets) != int(slotsPerEra) {
+		return nil, errors.Wrapf(errInvalidIndex, "block index of era %d starts at slot %d with %d slots", reader.era, blockStart, len(blockOffsets))
+	}
+	reader.blockStart = primitives.Slot(blockStart)
+	reader.blockOffsets = blockOffsets
+	return reader, nil
+}
+
+// readSlotIndex reads the slot index at the given offset, and returns its starting slot and the absolute offsets of
+// its records, or 0 for the empty slots.
+func readSlotIndex(r io.ReaderAt, offset int64) (uint64, []int64, error) {
+	data, err := readRecord(r, offset, typeSlotIndex)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(data) < 16 || len(data)%8 != 0 {
+		return 0, nil, errors.Wrapf(errInvalidIndex, "length %d", len(data))
+	}
+	count := binary.LittleEndian.Uint64(data[len(data)-8:])
+	if count != uint64(len(data)/8-2) {
+		return 0, nil, errors.Wrapf(errInvalidIndex, "count %d for length %d", count, len(data))
+	}
+	offsets := make([]int64, count)
+	for i := range offsets {
+		relative := int64(binary.LittleEndian.Uint64(data[8*(i+1):]))
+		if relative == 0 {
+			continue
+		}
+		offsets[i] = offset + relative
+		if offsets[i] < headerLength || offsets[i] >= offset {
+			return 0, nil, errors.Wrapf(errInvalidIndex, "offset %d of slot %d is out of the file", relative, i)
+		}
+	}
+	return binary.LittleEndian.Uint64(data), offsets, nil
+}
+
+// Era returns the era of the file.
+func (r *Reader) Era() uint64 {
+	return r.era
+}
+
+// StateSSZ returns the SSZ encoding of the state of the era.
+func (r *Reader) StateSSZ() ([]byte, error) {
+	compressed, err := readRecord(r.r, r.stateOffset, typeCompressedBeaconState)
+	if err != nil {
+		return nil, err
+	}
+	return decompress(compressed)
+}
+
+// State returns the state of the era.
+func (r *Reader) State() (state.BeaconState, error) {
+	enc, err := r.StateSSZ()
+	if err != nil {
+		return nil, err
+	}
+	cf, err := detect.FromState(enc)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not detect the fork of the era state")
+	}
+	return cf.UnmarshalBeaconState(enc)
+}
+
+// BlockSSZ returns the SSZ encoding of the block of the given slot, or nil if the slot is empty.
+func (r *Reader) BlockSSZ(slot primitives.Slot) ([]byte, error) {
+	if slot < r.blockStart || slot >= r.blockStart+primitives.Slot(len(r.blockOffsets)) {
+		return nil, errors.Errorf("slot %d is not in era %d", slot, r.era)
+	}
+	offset := r.blockOffsets[slot-r.blockStart]
+	if offset == 0 {
+		return nil, nil
+	}
+	compressed, err := readRecord(r.r, offset, typeCompressedSignedBeaconBlock)
+	if err != nil {
+		return nil, err
+	}
+	return decompress(compressed)
+}
+
+// Block returns the block of the given slot, or nil if the slot is empty.
+func (r *Reader) Block(slot primitives.Slot) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	enc, err := r.BlockSSZ(slot)
+	if err != nil || enc == nil {
+		return nil, err
+	}
+	cf, err := detect.FromBlock(enc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not detect the fork of the block at slot %d", slot)
+	}
+	blk, err := cf.UnmarshalBeaconBlock(enc)
+	if err != nil {
+		return nil, err
+	}
+	if blk.Block().Slot() != slot {
+		return nil, errors.Errorf("block indexed at slot %d is at slot %d", slot, blk.Block().Slot())
+	}
+	return blk, nil
+}
+
+// Blocks returns the blocks of the era between the given slots, with the end excluded, in increasing slot order.
+func (r *Reader) Blocks(start, end primitives.Slot) ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+	if start < r.blockStart {
+		start = r.blockStart
+	}
+	if last := r.blockStart + primitives.Slot(len(r.blockOffsets)); end > last {
+		end = last
+	}
+	var blks []interfaces.ReadOnlySignedBeaconBlock
+	for slot := start; slot < end; slot++ {
+		blk, err := r.Block(slot)
+		if err != nil {
+			return nil, err
+		}
+		if blk != nil {
+			blks = append(blks, blk)
+		}
+	}
+	return blks, nil
+}
+
+// Close closes the file opened by Open.
+func (r *Reader) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+	return r.closer.Close()
+}
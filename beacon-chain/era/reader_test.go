@@ -0,0 +1,150 @@
+package era
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func testBlock(t *testing.T, slot primitives.Slot) interfaces.ReadOnlySignedBeaconBlock {
+	b := util.NewBeaconBlock()
+	b.Block.Slot = slot
+	b.Block.ProposerIndex = primitives.ValidatorIndex(slot)
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return blk
+}
+
+func testState(t *testing.T, slot primitives.Slot) state.BeaconState {
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	return st
+}
+
+func writeEra(t *testing.T, era uint64, slots []primitives.Slot) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, era)
+	require.NoError(t, err)
+	for _, s := range slots {
+		require.NoError(t, w.WriteBlock(testBlock(t, s)))
+	}
+	require.NoError(t, w.Finish(testState(t, StateSlot(era))))
+	return buf.Bytes()
+}
+
+func TestWriterReader_RoundTrip(t *testing.T) {
+	setupEraConfig(t)
+	start, end := BlockSlots(2)
+	written := []primitives.Slot{start, start + 1, start + 5, end - 1}
+	enc := writeEra(t, 2, written)
+
+	r, err := NewReader(bytes.NewReader(enc), int64(len(enc)))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), r.Era())
+
+	st, err := r.State()
+	require.NoError(t, err)
+	require.Equal(t, StateSlot(2), st.Slot())
+
+	blk, err := r.Block(start + 5)
+	require.NoError(t, err)
+	require.Equal(t, start+5, blk.Block().Slot())
+	want, err := testBlock(t, start+5).Block().HashTreeRoot()
+	require.NoError(t, err)
+	got, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	blk, err = r.Block(start + 2)
+	require.NoError(t, err)
+	require.Equal(t, nil, blk)
+	_, err = r.Block(end)
+	require.ErrorContains(t, "is not in era 2", err)
+
+	blks, err := r.Blocks(0, end+10)
+	require.NoError(t, err)
+	require.Equal(t, len(written), len(blks))
+	for i := range blks {
+		require.Equal(t, written[i], blks[i].Block().Slot())
+	}
+	blks, err = r.Blocks(start+1, start+5)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blks))
+}
+
+func TestWriterReader_Genesis(t *testing.T) {
+	setupEraConfig(t)
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, 0)
+	require.NoError(t, err)
+	require.ErrorContains(t, "is not in era 0", w.WriteBlock(testBlock(t, 0)))
+	require.NoError(t, w.Finish(testState(t, 0)))
+
+	path := filepath.Join(t.TempDir(), "minimal-00000-00000000.era")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0600))
+	r, err := Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, r.Close())
+	}()
+	require.Equal(t, uint64(0), r.Era())
+	st, err := r.State()
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(0), st.Slot())
+	blks, err := r.Blocks(0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(blks))
+}
+
+func TestWriter_Errors(t *testing.T) {
+	setupEraConfig(t)
+	start, end := BlockSlots(1)
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, 1)
+	require.NoError(t, err)
+	require.ErrorContains(t, "is not in era 1", w.WriteBlock(testBlock(t, end)))
+	require.NoError(t, w.WriteBlock(testBlock(t, start+2)))
+	require.ErrorContains(t, "is not above the previous block", w.WriteBlock(testBlock(t, start+2)))
+	require.ErrorContains(t, "is not above the previous block", w.WriteBlock(testBlock(t, start+1)))
+	require.ErrorContains(t, "is not the state of era 1", w.Finish(testState(t, end+1)))
+	require.NoError(t, w.Finish(testState(t, end)))
+	require.ErrorContains(t, "era file is finished", w.WriteBlock(testBlock(t, start+3)))
+}
+
+func TestReader_Invalid(t *testing.T) {
+	setupEraConfig(t)
+	start, _ := BlockSlots(1)
+	enc := writeEra(t, 1, []primitives.Slot{start + 1})
+
+	_, err := NewReader(bytes.NewReader(enc[:20]), 20)
+	require.ErrorIs(t, err, errInvalidIndex)
+
+	// Truncating the file loses the state index.
+	truncated := enc[:len(enc)-1]
+	_, err = NewReader(bytes.NewReader(truncated), int64(len(truncated)))
+	require.ErrorContains(t, "could not read state index", err)
+
+	// Corrupt the reserved bytes of the state index header.
+	corrupted := bytes.Clone(enc)
+	corrupted[len(corrupted)-3*8-1] = 1
+	_, err = NewReader(bytes.NewReader(corrupted), int64(len(corrupted)))
+	require.ErrorIs(t, err, errInvalidHeader)
+
+	// Point the block of the era to the state record.
+	r, err := NewReader(bytes.NewReader(enc), int64(len(enc)))
+	require.NoError(t, err)
+	r.blockOffsets[1] = r.stateOffset
+	_, err = r.Block(start + 1)
+	require.ErrorIs(t, err, errUnexpectedType)
+	require.Equal(t, params.BeaconConfig().SlotsPerHistoricalRoot, primitives.Slot(len(r.blockOffsets)))
+}
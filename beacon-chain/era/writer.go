@@ -0,0 +1,119 @@
+package era
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+)
+
+// Writer writes the era file of an era. Blocks are written in increasing slot order, then Finish writes the state.
+type Writer struct {
+	w            io.Writer
+	era          uint64
+	offset       int64
+	blockOffsets []int64
+	lastBlock    int
+	finished     bool
+}
+
+// NewWriter starts writing the era file of the given era to w.
+func NewWriter(w io.Writer, era uint64) (*Writer, error) {
+	start, end := BlockSlots(era)
+	n, err := writeRecord(w, typeVersion, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not write version record")
+	}
+	return &Writer{w: w, era: era, offset: n, blockOffsets: make([]int64, end-start), lastBlock: -1}, nil
+}
+
+// WriteBlock writes a block of the era, which must be above the ones already written.
+func (w *Writer) WriteBlock(blk interfaces.ReadOnlySignedBeaconBlock) error {
+	if w.finished {
+		return errors.New("era file is finished")
+	}
+	if err := blocks.BeaconBlockIsNil(blk); err != nil {
+		return err
+	}
+	start, end := BlockSlots(w.era)
+	slot := blk.Block().Slot()
+	if slot < start || slot >= end {
+		return errors.Errorf("block at slot %d is not in era %d", slot, w.era)
+	}
+	i := int(slot - start)
+	if i <= w.lastBlock {
+		return errors.Errorf("block at slot %d is not above the previous block", slot)
+	}
+	enc, err := blk.MarshalSSZ()
+	if err != nil {
+		return errors.Wrapf(err, "could not marshal block at slot %d", slot)
+	}
+	compressed, err := compress(enc)
+	if err != nil {
+		return err
+	}
+	n, err := writeRecord(w.w, typeCompressedSignedBeaconBlock, compressed)
+	if err != nil {
+		return errors.Wrapf(err, "could not write block at slot %d", slot)
+	}
+	w.blockOffsets[i] = w.offset
+	w.lastBlock = i
+	w.offset += n
+	return nil
+}
+
+// Finish writes the state of the era and the slot indices.
+func (w *Writer) Finish(st state.ReadOnlyBeaconState) error {
+	if w.finished {
+		return errors.New("era file is finished")
+	}
+	if st.Slot() != StateSlot(w.era) {
+		return errors.Errorf("state at slot %d is not the state of era %d, at slot %d", st.Slot(), w.era, StateSlot(w.era))
+	}
+	enc, err := st.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal state")
+	}
+	compressed, err := compress(enc)
+	if err != nil {
+		return err
+	}
+	stateOffset := w.offset
+	n, err := writeRecord(w.w, typeCompressedBeaconState, compressed)
+	if err != nil {
+		return errors.Wrap(err, "could not write state")
+	}
+	w.offset += n
+
+	if len(w.blockOffsets) > 0 {
+		start, _ := BlockSlots(w.era)
+		n, err := w.writeSlotIndex(uint64(start), w.blockOffsets)
+		if err != nil {
+			return errors.Wrap(err, "could not write block index")
+		}
+		w.offset += n
+	}
+	if _, err := w.writeSlotIndex(uint64(st.Slot()), []int64{stateOffset}); err != nil {
+		return errors.Wrap(err, "could not write state index")
+	}
+	w.finished = true
+	return nil
+}
+
+// writeSlotIndex writes a slot index: the starting slot, then the offsets of the records relative to the start of
+// the index, or 0 for the empty slots, then their count, all as little-endian 64 bit integers.
+func (w *Writer) writeSlotIndex(start uint64, offsets []int64) (int64, error) {
+	data := make([]byte, 8*(len(offsets)+2))
+	binary.LittleEndian.PutUint64(data, start)
+	for i, o := range offsets {
+		if o == 0 {
+			continue
+		}
+		binary.LittleEndian.PutUint64(data[8*(i+1):], uint64(o-w.offset))
+	}
+	binary.LittleEndian.PutUint64(data[len(data)-8:], uint64(len(offsets)))
+	return writeRecord(w.w, typeSlotIndex, data)
+}
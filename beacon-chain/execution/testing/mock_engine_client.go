@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/holiman/uint256"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -36,6 +37,7 @@ type EngineClient struct {
 	ErrGetPayload               error
 	ExecutionPayloadByBlockHash map[[32]byte]*pb.ExecutionPayload
 	BlockByHashMap              map[[32]byte]*pb.ExecutionBlock
+	ReceiptsByHashMap           map[[32]byte][]*gethtypes.Receipt
 	NumReconstructedPayloads    uint64
 	TerminalBlockHash           []byte
 	TerminalBlockHashExists     bool
@@ -104,6 +106,15 @@ func (e *EngineClient) ExecutionBlockByHash(_ context.Context, h common.Hash, _
 	return b, e.ErrExecBlockByHash
 }
 
+// ExecutionBlockReceipts --
+func (e *EngineClient) ExecutionBlockReceipts(_ context.Context, h common.Hash) ([]*gethtypes.Receipt, error) {
+	r, ok := e.ReceiptsByHashMap[h]
+	if !ok {
+		return nil, errors.New("block not found")
+	}
+	return r, nil
+}
+
 // ReconstructFullBlock --
 func (e *EngineClient) ReconstructFullBlock(
 	_ context.Context, blindedBlock interfaces.ReadOnlySignedBeaconBlock,
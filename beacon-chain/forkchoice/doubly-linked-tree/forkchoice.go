@@ -574,7 +574,8 @@ func (f *ForkChoice) ForkChoiceDump(ctx context.Context) (*forkchoice2.Dump, err
 	nodes := make([]*forkchoice2.Node, 0, f.NodeCount())
 	var err error
 	if f.store.treeRootNode != nil {
-		nodes, err = f.store.treeRootNode.nodeTreeDump(ctx, nodes)
+		currentEpoch := slots.EpochsSinceGenesis(time.Unix(int64(f.store.genesisTime), 0))
+		nodes, err = f.store.treeRootNode.nodeTreeDump(ctx, nodes, f.store.justifiedCheckpoint.Epoch, currentEpoch)
 		if err != nil {
 			return nil, err
 		}
@@ -148,7 +148,7 @@ func (n *Node) arrivedAfterOrphanCheck(genesisTime uint64) (bool, error) {
 }
 
 // nodeTreeDump appends to the given list all the nodes descending from this one
-func (n *Node) nodeTreeDump(ctx context.Context, nodes []*forkchoice2.Node) ([]*forkchoice2.Node, error) {
+func (n *Node) nodeTreeDump(ctx context.Context, nodes []*forkchoice2.Node, justifiedEpoch, currentEpoch primitives.Epoch) ([]*forkchoice2.Node, error) {
 	if ctx.Err() != nil {
 		return nil, ctx.Err()
 	}
@@ -169,6 +169,7 @@ func (n *Node) nodeTreeDump(ctx context.Context, nodes []*forkchoice2.Node) ([]*
 		ExecutionOptimistic:      n.optimistic,
 		ExecutionBlockHash:       n.payloadHash[:],
 		Timestamp:                n.timestamp,
+		ViableForHead:            n.viableForHead(justifiedEpoch, currentEpoch),
 	}
 	if n.optimistic {
 		thisNode.Validity = forkchoice2.Optimistic
@@ -179,7 +180,7 @@ func (n *Node) nodeTreeDump(ctx context.Context, nodes []*forkchoice2.Node) ([]*
 	nodes = append(nodes, thisNode)
 	var err error
 	for _, child := range n.children {
-		nodes, err = child.nodeTreeDump(ctx, nodes)
+		nodes, err = child.nodeTreeDump(ctx, nodes, justifiedEpoch, currentEpoch)
 		if err != nil {
 			return nil, err
 		}
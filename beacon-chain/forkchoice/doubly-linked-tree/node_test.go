@@ -240,7 +240,7 @@ func TestNode_SetFullyValidated(t *testing.T) {
 	require.Equal(t, false, opt)
 
 	respNodes := make([]*forkchoice.Node, 0)
-	respNodes, err = f.store.treeRootNode.nodeTreeDump(ctx, respNodes)
+	respNodes, err = f.store.treeRootNode.nodeTreeDump(ctx, respNodes, f.store.justifiedCheckpoint.Epoch, 0)
 	require.NoError(t, err)
 	require.Equal(t, len(respNodes), f.NodeCount())
 
@@ -255,6 +255,7 @@ func TestNode_SetFullyValidated(t *testing.T) {
 		require.Equal(t, storeNodes[i].finalizedEpoch, respNode.FinalizedEpoch)
 		require.Equal(t, storeNodes[i].unrealizedFinalizedEpoch, respNode.UnrealizedFinalizedEpoch)
 		require.Equal(t, storeNodes[i].timestamp, respNode.Timestamp)
+		require.Equal(t, true, respNode.ViableForHead)
 	}
 }
 
@@ -0,0 +1,389 @@
+package doublylinkedtree
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// snapshotVersion is the version of the encoding of fork choice snapshots. Snapshots of other versions are rejected,
+// so that a node falls back to rebuilding its fork choice store from the database.
+const snapshotVersion = 1
+
+var (
+	errInvalidSnapshot           = errors.New("invalid fork choice snapshot")
+	errSnapshotFinalizedMismatch = errors.New("fork choice snapshot does not match the finalized checkpoint")
+	errSnapshotUnknownBlock      = errors.New("fork choice snapshot has a block which is not in the database")
+)
+
+// Snapshot serializes the fork choice store: its nodes with their weights and optimistic status, the checkpoints,
+// the proposer boost, and the validators' votes and balances. The caller must hold the fork choice read lock.
+func (f *ForkChoice) Snapshot() ([]byte, error) {
+	s := f.store
+	if s.treeRootNode == nil {
+		return nil, errors.Wrap(ErrNilNode, "could not snapshot fork choice without a tree root")
+	}
+	buf := []byte{snapshotVersion}
+	for _, cp := range []*forkchoicetypes.Checkpoint{
+		s.justifiedCheckpoint,
+		s.unrealizedJustifiedCheckpoint,
+		s.unrealizedFinalizedCheckpoint,
+		s.prevJustifiedCheckpoint,
+		s.finalizedCheckpoint,
+	} {
+		if cp == nil {
+			return nil, errInvalidNilCheckpoint
+		}
+		buf = binary.AppendUvarint(buf, uint64(cp.Epoch))
+		buf = append(buf, cp.Root[:]...)
+	}
+	buf = append(buf, s.proposerBoostRoot[:]...)
+	buf = append(buf, s.previousProposerBoostRoot[:]...)
+	buf = binary.AppendUvarint(buf, s.previousProposerBoostScore)
+	buf = binary.AppendUvarint(buf, s.committeeWeight)
+	buf = appendBool(buf, s.allTipsAreInvalid)
+	for _, slot := range s.receivedBlocksLastEpoch {
+		buf = binary.AppendUvarint(buf, uint64(slot))
+	}
+
+	// Nodes are listed parents first, so that every node refers to its parent and target by their earlier positions.
+	nodes := []*Node{s.treeRootNode}
+	index := map[*Node]uint64{s.treeRootNode: 1}
+	for i := 0; i < len(nodes); i++ {
+		for _, child := range nodes[i].children {
+			nodes = append(nodes, child)
+			index[child] = uint64(len(nodes))
+		}
+	}
+	if len(nodes) != len(s.nodeByRoot) {
+		return nil, fmt.Errorf("fork choice tree has %d nodes but %d are indexed by root", len(nodes), len(s.nodeByRoot))
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(nodes)))
+	for _, n := range nodes {
+		// Positions are shifted by one, so that zero stands for a missing node.
+		buf = binary.AppendUvarint(buf, index[n.parent])
+		buf = binary.AppendUvarint(buf, index[n.target])
+		buf = binary.AppendUvarint(buf, uint64(n.slot))
+		buf = append(buf, n.root[:]...)
+		buf = append(buf, n.payloadHash[:]...)
+		buf = binary.AppendUvarint(buf, uint64(n.justifiedEpoch))
+		buf = binary.AppendUvarint(buf, uint64(n.unrealizedJustifiedEpoch))
+		buf = binary.AppendUvarint(buf, uint64(n.finalizedEpoch))
+		buf = binary.AppendUvarint(buf, uint64(n.unrealizedFinalizedEpoch))
+		buf = binary.AppendUvarint(buf, n.balance)
+		buf = binary.AppendUvarint(buf, n.weight)
+		buf = appendBool(buf, n.optimistic)
+		buf = binary.AppendUvarint(buf, n.timestamp)
+	}
+	buf = binary.AppendUvarint(buf, index[s.headNode])
+	buf = binary.AppendUvarint(buf, index[s.highestReceivedNode])
+
+	slashed := make([]primitives.ValidatorIndex, 0, len(s.slashedIndices))
+	for i, ok := range s.slashedIndices {
+		if ok {
+			slashed = append(slashed, i)
+		}
+	}
+	sort.Slice(slashed, func(i, j int) bool { return slashed[i] < slashed[j] })
+	buf = binary.AppendUvarint(buf, uint64(len(slashed)))
+	for _, i := range slashed {
+		buf = binary.AppendUvarint(buf, uint64(i))
+	}
+
+	// Most validators vote for the same few blocks, so votes refer to their roots by position in a table.
+	var roots [][fieldparams.RootLength]byte
+	rootIndex := make(map[[fieldparams.RootLength]byte]uint64)
+	voteRoots := make([]uint64, 0, 2*len(f.votes))
+	for _, v := range f.votes {
+		for _, r := range [][fieldparams.RootLength]byte{v.currentRoot, v.nextRoot} {
+			i, ok := rootIndex[r]
+			if !ok {
+				i = uint64(len(roots))
+				rootIndex[r] = i
+				roots = append(roots, r)
+			}
+			voteRoots = append(voteRoots, i)
+		}
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(roots)))
+	for _, r := range roots {
+		buf = append(buf, r[:]...)
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(f.votes)))
+	for i, v := range f.votes {
+		buf = binary.AppendUvarint(buf, voteRoots[2*i])
+		buf = binary.AppendUvarint(buf, voteRoots[2*i+1])
+		buf = binary.AppendUvarint(buf, uint64(v.nextEpoch))
+	}
+	buf = appendUint64s(buf, f.balances)
+	buf = appendUint64s(buf, f.justifiedBalances)
+	buf = binary.AppendUvarint(buf, f.numActiveValidators)
+
+	checksum := hash.Hash(buf)
+	return append(buf, checksum[:]...), nil
+}
+
+// RestoreSnapshot replaces the fork choice store with the one serialized in a snapshot, keeping the genesis time,
+// origin root and balances handler. The snapshot must have the given finalized checkpoint, and hasBlock must know the
+// block of each of its nodes. The store is left untouched if the snapshot is corrupt or does not match. The caller
+// must hold the fork choice lock.
+func (f *ForkChoice) RestoreSnapshot(
+	ctx context.Context,
+	snapshot []byte,
+	finalized *forkchoicetypes.Checkpoint,
+	hasBlock func(context.Context, [32]byte) bool,
+) error {
+	if len(snapshot) < fieldparams.RootLength+1 {
+		return errors.Wrap(errInvalidSnapshot, "snapshot is too short")
+	}
+	body := snapshot[:len(snapshot)-fieldparams.RootLength]
+	checksum := hash.Hash(body)
+	if !bytes.Equal(checksum[:], snapshot[len(body):]) {
+		return errors.Wrap(errInvalidSnapshot, "checksum mismatch")
+	}
+	r := &snapshotReader{buf: body}
+	if v := r.byte(); v != snapshotVersion {
+		return errors.Wrapf(errInvalidSnapshot, "unsupported version %d", v)
+	}
+
+	s := &Store{
+		nodeByRoot:     make(map[[fieldparams.RootLength]byte]*Node),
+		nodeByPayload:  make(map[[fieldparams.RootLength]byte]*Node),
+		slashedIndices: make(map[primitives.ValidatorIndex]bool),
+		originRoot:     f.store.originRoot,
+		genesisTime:    f.store.genesisTime,
+	}
+	for _, cp := range []**forkchoicetypes.Checkpoint{
+		&s.justifiedCheckpoint,
+		&s.unrealizedJustifiedCheckpoint,
+		&s.unrealizedFinalizedCheckpoint,
+		&s.prevJustifiedCheckpoint,
+		&s.finalizedCheckpoint,
+	} {
+		*cp = &forkchoicetypes.Checkpoint{Epoch: primitives.Epoch(r.uvarint()), Root: r.root()}
+	}
+	s.proposerBoostRoot = r.root()
+	s.previousProposerBoostRoot = r.root()
+	s.previousProposerBoostScore = r.uvarint()
+	s.committeeWeight = r.uvarint()
+	s.allTipsAreInvalid = r.bool()
+	for i := range s.receivedBlocksLastEpoch {
+		s.receivedBlocksLastEpoch[i] = primitives.Slot(r.uvarint())
+	}
+
+	nodes := make([]*Node, r.count())
+	nodeAt := func(i uint64) (*Node, bool) {
+		if i == 0 {
+			return nil, true
+		}
+		if i > uint64(len(nodes)) || nodes[i-1] == nil {
+			return nil, false
+		}
+		return nodes[i-1], true
+	}
+	for i := range nodes {
+		n := &Node{}
+		nodes[i] = n
+		parent, ok := nodeAt(r.uvarint())
+		if !ok || parent == n || (i > 0) != (parent != nil) {
+			return errors.Wrapf(errInvalidSnapshot, "invalid parent of node %d", i)
+		}
+		target, ok := nodeAt(r.uvarint())
+		if !ok {
+			return errors.Wrapf(errInvalidSnapshot, "invalid target of node %d", i)
+		}
+		n.parent = parent
+		n.target = target
+		n.slot = primitives.Slot(r.uvarint())
+		n.root = r.root()
+		n.payloadHash = r.root()
+		n.justifiedEpoch = primitives.Epoch(r.uvarint())
+		n.unrealizedJustifiedEpoch = primitives.Epoch(r.uvarint())
+		n.finalizedEpoch = primitives.Epoch(r.uvarint())
+		n.unrealizedFinalizedEpoch = primitives.Epoch(r.uvarint())
+		n.balance = r.uvarint()
+		n.weight = r.uvarint()
+		n.optimistic = r.bool()
+		n.timestamp = r.uvarint()
+		if r.err != nil {
+			return errors.Wrap(errInvalidSnapshot, r.err.Error())
+		}
+		if _, ok := s.nodeByRoot[n.root]; ok {
+			return errors.Wrapf(errInvalidSnapshot, "duplicate node %#x", n.root)
+		}
+		if parent != nil {
+			parent.children = append(parent.children, n)
+		}
+		s.nodeByRoot[n.root] = n
+		s.nodeByPayload[n.payloadHash] = n
+	}
+	if len(nodes) == 0 {
+		return errors.Wrap(errInvalidSnapshot, "no nodes")
+	}
+	s.treeRootNode = nodes[0]
+	var ok bool
+	if s.headNode, ok = nodeAt(r.uvarint()); !ok || s.headNode == nil {
+		return errors.Wrap(errInvalidSnapshot, "invalid head node")
+	}
+	if s.highestReceivedNode, ok = nodeAt(r.uvarint()); !ok || s.highestReceivedNode == nil {
+		return errors.Wrap(errInvalidSnapshot, "invalid highest received node")
+	}
+	for i := r.count(); i > 0; i-- {
+		s.slashedIndices[primitives.ValidatorIndex(r.uvarint())] = true
+	}
+
+	roots := make([][fieldparams.RootLength]byte, r.count())
+	for i := range roots {
+		roots[i] = r.root()
+	}
+	voteRoot := func() [fieldparams.RootLength]byte {
+		i := r.uvarint()
+		if i >= uint64(len(roots)) {
+			r.fail(fmt.Errorf("vote root %d is not in the table of %d roots", i, len(roots)))
+			return [fieldparams.RootLength]byte{}
+		}
+		return roots[i]
+	}
+	votes := make([]Vote, r.count())
+	for i := range votes {
+		votes[i].currentRoot = voteRoot()
+		votes[i].nextRoot = voteRoot()
+		votes[i].nextEpoch = primitives.Epoch(r.uvarint())
+	}
+	balances := r.uint64s()
+	justifiedBalances := r.uint64s()
+	numActiveValidators := r.uvarint()
+	if r.err != nil {
+		return errors.Wrap(errInvalidSnapshot, r.err.Error())
+	}
+	if len(r.buf) != 0 {
+		return errors.Wrapf(errInvalidSnapshot, "%d trailing bytes", len(r.buf))
+	}
+
+	// The snapshot must continue from the finalized checkpoint of the database, and only have blocks it knows.
+	if s.finalizedCheckpoint.Epoch != finalized.Epoch || s.finalizedCheckpoint.Root != finalized.Root {
+		return errors.Wrapf(errSnapshotFinalizedMismatch, "snapshot has epoch %d root %#x, wanted epoch %d root %#x",
+			s.finalizedCheckpoint.Epoch, s.finalizedCheckpoint.Root, finalized.Epoch, finalized.Root)
+	}
+	for root := range s.nodeByRoot {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !hasBlock(ctx, root) {
+			return errors.Wrapf(errSnapshotUnknownBlock, "%#x", root)
+		}
+	}
+
+	currentEpoch := slots.ToEpoch(slots.CurrentSlot(s.genesisTime))
+	if err := s.treeRootNode.updateBestDescendant(ctx, s.justifiedCheckpoint.Epoch, s.finalizedCheckpoint.Epoch, currentEpoch); err != nil {
+		return errors.Wrap(err, "could not update best descendants")
+	}
+	f.store = s
+	f.votes = votes
+	f.balances = balances
+	f.justifiedBalances = justifiedBalances
+	f.numActiveValidators = numActiveValidators
+	nodeCount.Set(float64(len(s.nodeByRoot)))
+	return nil
+}
+
+func appendBool(buf []byte, b bool) []byte {
+	if b {
+		return append(buf, 1)
+	}
+	return append(buf, 0)
+}
+
+func appendUint64s(buf []byte, values []uint64) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(values)))
+	for _, v := range values {
+		buf = binary.AppendUvarint(buf, v)
+	}
+	return buf
+}
+
+// snapshotReader decodes the fields of a snapshot, remembering the first error so that it is checked once after
+// decoding a group of fields.
+type snapshotReader struct {
+	buf []byte
+	err error
+}
+
+func (r *snapshotReader) fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+	r.buf = nil
+}
+
+func (r *snapshotReader) next(n int) []byte {
+	if len(r.buf) < n {
+		r.fail(errors.New("unexpected end of snapshot"))
+		return make([]byte, n)
+	}
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+	return b
+}
+
+func (r *snapshotReader) byte() byte {
+	return r.next(1)[0]
+}
+
+func (r *snapshotReader) bool() bool {
+	switch b := r.byte(); b {
+	case 0:
+		return false
+	case 1:
+		return true
+	default:
+		r.fail(fmt.Errorf("invalid boolean %d", b))
+		return false
+	}
+}
+
+func (r *snapshotReader) root() [fieldparams.RootLength]byte {
+	var root [fieldparams.RootLength]byte
+	copy(root[:], r.next(fieldparams.RootLength))
+	return root
+}
+
+func (r *snapshotReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.buf)
+	if n <= 0 {
+		r.fail(errors.New("invalid varint"))
+		return 0
+	}
+	r.buf = r.buf[n:]
+	return v
+}
+
+// count reads the length of a list, which cannot exceed the remaining bytes since every element takes at least one.
+func (r *snapshotReader) count() int {
+	n := r.uvarint()
+	if n > uint64(len(r.buf)) {
+		r.fail(fmt.Errorf("list of %d elements is longer than the remaining %d bytes", n, len(r.buf)))
+		return 0
+	}
+	return int(n)
+}
+
+func (r *snapshotReader) uint64s() []uint64 {
+	values := make([]uint64, r.count())
+	for i := range values {
+		values[i] = r.uvarint()
+	}
+	return values
+}
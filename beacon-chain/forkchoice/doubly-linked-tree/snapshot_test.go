@@ -0,0 +1,110 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"testing"
+
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func hasAllBlocks(context.Context, [32]byte) bool {
+	return true
+}
+
+// snapshotTestForkChoice builds a fork choice store with a fork at block 1, votes on both branches, a validated
+// branch and a slashed validator.
+func snapshotTestForkChoice(t *testing.T) *ForkChoice {
+	ctx := context.Background()
+	f := setup(0, 0)
+	for _, b := range []struct {
+		slot   uint64
+		root   uint64
+		parent [32]byte
+	}{
+		{1, 1, params.BeaconConfig().ZeroHash},
+		{2, 2, indexToHash(1)},
+		{3, 3, indexToHash(2)},
+		{2, 4, indexToHash(1)},
+	} {
+		st, root, err := prepareForkchoiceState(ctx, primitives.Slot(b.slot), indexToHash(b.root), b.parent, indexToHash(100+b.root), 0, 0)
+		require.NoError(t, err)
+		require.NoError(t, f.InsertNode(ctx, st, root))
+	}
+	require.NoError(t, f.SetOptimisticToValid(ctx, indexToHash(2)))
+	f.justifiedBalances = []uint64{10, 20, 30, 40}
+	f.numActiveValidators = 4
+	f.ProcessAttestation(ctx, []uint64{0, 1}, indexToHash(3), 1)
+	f.ProcessAttestation(ctx, []uint64{2}, indexToHash(4), 1)
+	f.InsertSlashedIndex(ctx, 3)
+	_, err := f.Head(ctx)
+	require.NoError(t, err)
+	f.store.proposerBoostRoot = indexToHash(3)
+	return f
+}
+
+func TestForkChoice_RestoreSnapshot(t *testing.T) {
+	ctx := context.Background()
+	f := snapshotTestForkChoice(t)
+	snapshot, err := f.Snapshot()
+	require.NoError(t, err)
+
+	restored := New()
+	restored.SetGenesisTime(f.store.genesisTime)
+	require.NoError(t, restored.RestoreSnapshot(ctx, snapshot, f.FinalizedCheckpoint(), hasAllBlocks))
+	require.Equal(t, f.NodeCount(), restored.NodeCount())
+	require.Equal(t, f.CachedHeadRoot(), restored.CachedHeadRoot())
+	require.Equal(t, f.ProposerBoost(), restored.ProposerBoost())
+	require.DeepEqual(t, f.JustifiedCheckpoint(), restored.JustifiedCheckpoint())
+	for i := uint64(1); i <= 4; i++ {
+		want, err := f.Weight(indexToHash(i))
+		require.NoError(t, err)
+		got, err := restored.Weight(indexToHash(i))
+		require.NoError(t, err)
+		require.Equal(t, want, got, "weight of block %d", i)
+		wantOptimistic, err := f.IsOptimistic(indexToHash(i))
+		require.NoError(t, err)
+		gotOptimistic, err := restored.IsOptimistic(indexToHash(i))
+		require.NoError(t, err)
+		require.Equal(t, wantOptimistic, gotOptimistic, "optimistic status of block %d", i)
+	}
+	require.DeepEqual(t, f.votes, restored.votes)
+	require.Equal(t, true, restored.store.slashedIndices[3])
+	require.Equal(t, restored.store.treeRootNode, restored.store.nodeByRoot[indexToHash(3)].target)
+
+	// A restored store serializes to the same snapshot, and keeps working.
+	again, err := restored.Snapshot()
+	require.NoError(t, err)
+	require.DeepEqual(t, snapshot, again)
+	restored.ProcessAttestation(ctx, []uint64{0, 1, 2}, indexToHash(4), 2)
+	head, err := restored.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, indexToHash(4), head)
+}
+
+func TestForkChoice_RestoreSnapshot_Invalid(t *testing.T) {
+	ctx := context.Background()
+	f := snapshotTestForkChoice(t)
+	snapshot, err := f.Snapshot()
+	require.NoError(t, err)
+
+	restored := setup(0, 0)
+	corrupt := make([]byte, len(snapshot))
+	copy(corrupt, snapshot)
+	corrupt[len(corrupt)/2] ^= 1
+	require.ErrorIs(t, restored.RestoreSnapshot(ctx, corrupt, f.FinalizedCheckpoint(), hasAllBlocks), errInvalidSnapshot)
+	require.ErrorIs(t, restored.RestoreSnapshot(ctx, snapshot[:10], f.FinalizedCheckpoint(), hasAllBlocks), errInvalidSnapshot)
+
+	finalized := &forkchoicetypes.Checkpoint{Epoch: 1, Root: indexToHash(1)}
+	require.ErrorIs(t, restored.RestoreSnapshot(ctx, snapshot, finalized, hasAllBlocks), errSnapshotFinalizedMismatch)
+
+	missing := func(_ context.Context, root [32]byte) bool {
+		return root != indexToHash(4)
+	}
+	require.ErrorIs(t, restored.RestoreSnapshot(ctx, snapshot, f.FinalizedCheckpoint(), missing), errSnapshotUnknownBlock)
+
+	// The store is untouched by failed restores.
+	require.Equal(t, 1, restored.NodeCount())
+}
@@ -56,6 +56,7 @@ type Getter interface {
 	AncestorRoot(ctx context.Context, root [32]byte, slot primitives.Slot) ([32]byte, error)
 	CommonAncestor(ctx context.Context, root1 [32]byte, root2 [32]byte) ([32]byte, primitives.Slot, error)
 	ForkChoiceDump(context.Context) (*forkchoice2.Dump, error)
+	Snapshot() ([]byte, error)
 	Tips() ([][32]byte, []primitives.Slot)
 }
 
@@ -93,4 +94,5 @@ type Setter interface {
 	NewSlot(context.Context, primitives.Slot) error
 	SetBalancesByRooter(BalancesByRooter)
 	InsertSlashedIndex(context.Context, primitives.ValidatorIndex)
+	RestoreSnapshot(ctx context.Context, snapshot []byte, finalized *forkchoicetypes.Checkpoint, hasBlock func(context.Context, [32]byte) bool) error
 }
@@ -18,6 +18,7 @@ func configureTracing(cliCtx *cli.Context) error {
 		"beacon-chain", // service name
 		cliCtx.String(cmd.TracingProcessNameFlag.Name),
 		cliCtx.String(cmd.TracingEndpointFlag.Name),
+		cliCtx.String(cmd.TracingProtocolFlag.Name),
 		cliCtx.Float64(cmd.TraceSampleFractionFlag.Name),
 		cliCtx.Bool(cmd.EnableTracingFlag.Name),
 	)
@@ -79,6 +80,10 @@ func configureBuilderCircuitBreaker(cliCtx *cli.Context) error {
 
 func configureSlotsPerArchivedPoint(cliCtx *cli.Context) error {
 	if cliCtx.IsSet(flags.SlotsPerArchivedPoint.Name) {
+		// Hierarchical states replace the archived points.
+		if cliCtx.IsSet(flags.StateDiffExponents.Name) {
+			return fmt.Errorf("--%s cannot be used with --%s", flags.SlotsPerArchivedPoint.Name, flags.StateDiffExponents.Name)
+		}
 		c := params.BeaconConfig().Copy()
 		c.SlotsPerArchivedPoint = primitives.Slot(cliCtx.Int(flags.SlotsPerArchivedPoint.Name))
 		if err := params.SetActive(c); err != nil {
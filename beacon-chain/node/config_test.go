@@ -51,6 +51,10 @@ func TestConfigureSlotsPerArchivedPoint(t *testing.T) {
 	require.NoError(t, configureSlotsPerArchivedPoint(cliCtx))
 
 	assert.Equal(t, primitives.Slot(100), params.BeaconConfig().SlotsPerArchivedPoint)
+
+	set.Var(cli.NewIntSlice(), flags.StateDiffExponents.Name, "")
+	require.NoError(t, set.Set(flags.StateDiffExponents.Name, "21"))
+	require.ErrorContains(t, "cannot be used with", configureSlotsPerArchivedPoint(cliCtx))
 }
 
 func TestConfigureProofOfWork(t *testing.T) {
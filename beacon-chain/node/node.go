@@ -29,6 +29,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/slasherkv"
 	interopcoldstart "github.com/prysmaticlabs/prysm/v5/beacon-chain/deterministic-genesis"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
@@ -58,12 +59,14 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	bflags "github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/sync/backfill/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/container/slice"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/prometheus"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
 	"github.com/prysmaticlabs/prysm/v5/runtime"
 	"github.com/prysmaticlabs/prysm/v5/runtime/debug"
 	"github.com/prysmaticlabs/prysm/v5/runtime/prereqs"
@@ -354,6 +357,11 @@ func registerServices(cliCtx *cli.Context, beacon *BeaconNode, synchronizer *sta
 		return errors.Wrap(err, "could not register sync service")
 	}
 
+	log.Debugln("Registering Pruner Service")
+	if err := beacon.registerPrunerService(cliCtx, bfs); err != nil {
+		return errors.Wrap(err, "could not register pruner service")
+	}
+
 	log.Debugln("Registering Slasher Service")
 	if err := beacon.registerSlasherService(); err != nil {
 		return errors.Wrap(err, "could not register slasher service")
@@ -409,6 +417,7 @@ func newRouter(cliCtx *cli.Context) *mux.Router {
 		allowedOrigins = strings.Split(flags.GPRCGatewayCorsDomain.Value, ",")
 	}
 	r := mux.NewRouter()
+	r.Use(tracing.HttpMiddleware)
 	r.Use(server.NormalizeQueryValuesHandler)
 	r.Use(server.CorsHandler(allowedOrigins))
 	return r
@@ -473,12 +482,15 @@ func (b *BeaconNode) Close() {
 	if err := b.db.Close(); err != nil {
 		log.WithError(err).Error("Failed to close database")
 	}
+	if err := tracing.Shutdown(); err != nil {
+		log.WithError(err).Error("Could not shut down tracing")
+	}
 	b.collector.unregister()
 	b.cancel()
 	close(b.stop)
 }
 
-func (b *BeaconNode) clearDB(clearDB, forceClearDB bool, d *kv.Store, dbPath string) (*kv.Store, error) {
+func (b *BeaconNode) clearDB(clearDB, forceClearDB bool, d *kv.Store, dbPath string, opts ...kv.KVStoreOption) (*kv.Store, error) {
 	var err error
 	clearDBConfirmed := false
 
@@ -506,7 +518,7 @@ func (b *BeaconNode) clearDB(clearDB, forceClearDB bool, d *kv.Store, dbPath str
 			return nil, errors.Wrap(err, "could not clear blob storage")
 		}
 
-		d, err = kv.NewKVStore(b.ctx, dbPath)
+		d, err = kv.NewKVStore(b.ctx, dbPath, opts...)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not create new database")
 		}
@@ -546,15 +558,20 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	clearDBRequired := cliCtx.Bool(cmd.ClearDB.Name)
 	forceClearDBRequired := cliCtx.Bool(cmd.ForceClearDB.Name)
 
+	var opts []kv.KVStoreOption
+	if cliCtx.IsSet(flags.StateDiffExponents.Name) {
+		opts = append(opts, kv.WithStateDiffExponents(cliCtx.IntSlice(flags.StateDiffExponents.Name)))
+	}
+
 	log.WithField("databasePath", dbPath).Info("Checking DB")
 
-	d, err := kv.NewKVStore(b.ctx, dbPath)
+	d, err := kv.NewKVStore(b.ctx, dbPath, opts...)
 	if err != nil {
 		return errors.Wrapf(err, "could not create database at %s", dbPath)
 	}
 
 	if clearDBRequired || forceClearDBRequired {
-		d, err = b.clearDB(clearDBRequired, forceClearDBRequired, d, dbPath)
+		d, err = b.clearDB(clearDBRequired, forceClearDBRequired, d, dbPath, opts...)
 		if err != nil {
 			return errors.Wrap(err, "could not clear database")
 		}
@@ -563,6 +580,12 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	if err := d.RunMigrations(b.ctx); err != nil {
 		return err
 	}
+	if d.HierarchicalStateInterval() > 0 && cliCtx.IsSet(flags.SlotsPerArchivedPoint.Name) {
+		log.Warnf("Ignoring --%s, as the database stores hierarchical states", flags.SlotsPerArchivedPoint.Name)
+	}
+	if err := d.MigrateToHierarchicalStates(b.ctx); err != nil {
+		return errors.Wrap(err, "could not migrate archived states to hierarchical states")
+	}
 
 	b.db = d
 
@@ -707,6 +730,9 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 		QueueSize:            cliCtx.Uint(cmd.PubsubQueueSize.Name),
 		AllowListCIDR:        cliCtx.String(cmd.P2PAllowList.Name),
 		DenyListCIDR:         slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
+		GossipTraceFile:      cliCtx.String(cmd.GossipTraceFile.Name),
+		GossipTraceFormat:    cliCtx.String(cmd.GossipTraceFormat.Name),
+		GossipTraceMaxSize:   int64(cliCtx.Int(cmd.GossipTraceMaxSize.Name)) * 1024 * 1024,
 		EnableUPnP:           cliCtx.Bool(cmd.EnableUPnPFlag.Name),
 		StateNotifier:        b,
 		DB:                   b.db,
@@ -889,6 +915,29 @@ func (b *BeaconNode) registerInitialSyncService(complete chan struct{}) error {
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerPrunerService(cliCtx *cli.Context, bfs *backfill.Store) error {
+	if !cliCtx.Bool(flags.BeaconDBPruning.Name) {
+		return nil
+	}
+	// Backfill would download the pruned blocks again, and hierarchical states are meant for archive nodes.
+	if cliCtx.Bool(bflags.EnableExperimentalBackfill.Name) {
+		return errors.Errorf("--%s cannot be used with --%s", flags.BeaconDBPruning.Name, bflags.EnableExperimentalBackfill.Name)
+	}
+	if b.db.HierarchicalStateInterval() > 0 {
+		return errors.Errorf("--%s cannot be used with hierarchical states", flags.BeaconDBPruning.Name)
+	}
+	svc := pruner.New(
+		b.ctx,
+		b.db,
+		b.stateGen,
+		b.clockWaiter,
+		pruner.WithRetentionPeriod(primitives.Epoch(cliCtx.Uint64(flags.PrunerRetentionEpochs.Name))),
+		pruner.WithStatusReloader(bfs),
+		pruner.WithInitSyncWaiter(initSyncWaiter(b.ctx, b.initialSyncComplete)),
+	)
+	return b.services.RegisterService(svc)
+}
+
 func (b *BeaconNode) registerSlasherService() error {
 	if !features.Get().EnableSlasher {
 		return nil
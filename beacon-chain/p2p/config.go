@@ -31,6 +31,9 @@ type Config struct {
 	QueueSize            uint
 	AllowListCIDR        string
 	DenyListCIDR         []string
+	GossipTraceFile      string
+	GossipTraceFormat    string
+	GossipTraceMaxSize   int64
 	StateNotifier        statefeed.Notifier
 	DB                   db.ReadOnlyDatabase
 	ClockWaiter          startup.ClockWaiter
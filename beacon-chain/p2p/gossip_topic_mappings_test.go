@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestMappingHasNoDuplicates(t *testing.T) {
@@ -73,3 +74,34 @@ func TestGossipTopicMappings_CorrectBlockType(t *testing.T) {
 	_, ok = pMessage.(*ethpb.SignedBeaconBlockElectra)
 	assert.Equal(t, true, ok)
 }
+
+func TestGossipTopicMappings_CorrectLightClientType(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	bCfg := params.BeaconConfig().Copy()
+	bCfg.AltairForkEpoch = 100
+	bCfg.BellatrixForkEpoch = 200
+	bCfg.CapellaForkEpoch = 300
+	bCfg.DenebForkEpoch = 400
+	bCfg.ElectraForkEpoch = 500
+	params.OverrideBeaconConfig(bCfg)
+
+	tests := []struct {
+		epoch      primitives.Epoch
+		finality   proto.Message
+		optimistic proto.Message
+	}{
+		{epoch: 100, finality: &ethpb.LightClientFinalityUpdateAltair{}, optimistic: &ethpb.LightClientOptimisticUpdateAltair{}},
+		{epoch: 200, finality: &ethpb.LightClientFinalityUpdateAltair{}, optimistic: &ethpb.LightClientOptimisticUpdateAltair{}},
+		{epoch: 300, finality: &ethpb.LightClientFinalityUpdateCapella{}, optimistic: &ethpb.LightClientOptimisticUpdateCapella{}},
+		{epoch: 400, finality: &ethpb.LightClientFinalityUpdateDeneb{}, optimistic: &ethpb.LightClientOptimisticUpdateDeneb{}},
+		{epoch: 500, finality: &ethpb.LightClientFinalityUpdateElectra{}, optimistic: &ethpb.LightClientOptimisticUpdateElectra{}},
+	}
+	for _, tt := range tests {
+		finality := GossipTopicMappings(LightClientFinalityUpdateTopicFormat, tt.epoch)
+		assert.Equal(t, reflect.TypeOf(tt.finality), reflect.TypeOf(finality))
+		assert.Equal(t, LightClientFinalityUpdateTopicFormat, GossipTypeMapping[reflect.TypeOf(finality)])
+		optimistic := GossipTopicMappings(LightClientOptimisticUpdateTopicFormat, tt.epoch)
+		assert.Equal(t, reflect.TypeOf(tt.optimistic), reflect.TypeOf(optimistic))
+		assert.Equal(t, LightClientOptimisticUpdateTopicFormat, GossipTypeMapping[reflect.TypeOf(optimistic)])
+	}
+}
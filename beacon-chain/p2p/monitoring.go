@@ -161,6 +161,10 @@ var (
 		Help: "The number of publish messages sent via rpc for a particular topic",
 	},
 		[]string{"topic"})
+	gossipTraceDroppedEvents = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_gossip_trace_dropped_events_total",
+		Help: "The number of gossipsub trace events which could not be written to the gossip trace file",
+	})
 )
 
 func (s *Service) updateMetrics() {
@@ -52,6 +52,7 @@ type PeerData struct {
 	ConnState     PeerConnectionState
 	Enr           *enr.Record
 	NextValidTime time.Time
+	LastSeen      time.Time
 	// Chain related data.
 	MetaData                  metadata.Metadata
 	ChainState                *ethpb.Status
@@ -65,6 +66,10 @@ type PeerData struct {
 	TopicScores      map[string]*ethpb.TopicScoreSnapshot
 	GossipScore      float64
 	BehaviourPenalty float64
+	// Manual ban data, the ban does not expire if the expiry is the zero time.
+	ManuallyBanned  bool
+	ManualBanReason string
+	ManualBanExpiry time.Time
 }
 
 // NewStore creates new peer data store.
@@ -0,0 +1,121 @@
+package peers
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/metadata"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+)
+
+// PeerRecord holds what is known about a peer which is worth keeping across restarts of the node.
+type PeerRecord struct {
+	ID        peer.ID
+	Enr       *enr.Record
+	Address   ma.Multiaddr
+	Direction network.Direction
+	// LastSeen is the last time the peer was connected.
+	LastSeen time.Time
+	// Score is the total score of the peer when the record was taken. It is not restored, as it is derived from the
+	// scorers data below, but allows to dial the best peers first.
+	Score            float64
+	BadResponses     int
+	ProcessedBlocks  uint64
+	GossipScore      float64
+	BehaviourPenalty float64
+	// MetaData holds the subnets the peer was subscribed to.
+	MetaData        metadata.Metadata
+	ManuallyBanned  bool
+	ManualBanReason string
+	ManualBanExpiry time.Time
+}
+
+// PeerRecords returns the records of the peers which have been connected within the given maximum age, sorted from
+// the highest to the lowest score. Expired manual bans are not recorded.
+func (p *Status) PeerRecords(maxAge time.Duration) []*PeerRecord {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	now := prysmTime.Now()
+	records := make([]*PeerRecord, 0)
+	for pid, peerData := range p.store.Peers() {
+		lastSeen := peerData.LastSeen
+		if peerData.ConnState == PeerConnected {
+			lastSeen = now
+		}
+		if lastSeen.IsZero() || now.Sub(lastSeen) > maxAge {
+			continue
+		}
+		record := &PeerRecord{
+			ID:               pid,
+			Enr:              peerData.Enr,
+			Address:          peerData.Address,
+			Direction:        peerData.Direction,
+			LastSeen:         lastSeen,
+			Score:            p.scorers.ScoreNoLock(pid),
+			BadResponses:     peerData.BadResponses,
+			ProcessedBlocks:  peerData.ProcessedBlocks,
+			GossipScore:      peerData.GossipScore,
+			BehaviourPenalty: peerData.BehaviourPenalty,
+		}
+		if peerData.MetaData != nil && !peerData.MetaData.IsNil() {
+			record.MetaData = peerData.MetaData.Copy()
+		}
+		if p.isManuallyBanned(pid) {
+			record.ManuallyBanned = true
+			record.ManualBanReason = peerData.ManualBanReason
+			record.ManualBanExpiry = peerData.ManualBanExpiry
+		}
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Score > records[j].Score
+	})
+	return records
+}
+
+// RestorePeerRecords adds the recorded peers as disconnected peers, seeding the scorers with their recorded data.
+// Records older than the given maximum age and peers which are already known are skipped. It returns the number of
+// restored peers.
+func (p *Status) RestorePeerRecords(records []*PeerRecord, maxAge time.Duration) int {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	now := prysmTime.Now()
+	restored := 0
+	for _, record := range records {
+		if record == nil || now.Sub(record.LastSeen) > maxAge {
+			continue
+		}
+		if _, ok := p.store.PeerData(record.ID); ok {
+			continue
+		}
+		peerData := &peerdata.PeerData{
+			Address:              record.Address,
+			Direction:            record.Direction,
+			ConnState:            PeerDisconnected,
+			Enr:                  record.Enr,
+			LastSeen:             record.LastSeen,
+			MetaData:             record.MetaData,
+			BadResponses:         record.BadResponses,
+			ProcessedBlocks:      record.ProcessedBlocks,
+			BlockProviderUpdated: now,
+			GossipScore:          record.GossipScore,
+			BehaviourPenalty:     record.BehaviourPenalty,
+		}
+		if record.ManuallyBanned && (record.ManualBanExpiry.IsZero() || now.Before(record.ManualBanExpiry)) {
+			peerData.ManuallyBanned = true
+			peerData.ManualBanReason = record.ManualBanReason
+			peerData.ManualBanExpiry = record.ManualBanExpiry
+		}
+		p.store.SetPeerData(record.ID, peerData)
+		p.addIpToTracker(record.ID)
+		restored++
+	}
+	return restored
+}
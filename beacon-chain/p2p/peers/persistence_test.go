@@ -0,0 +1,80 @@
+package peers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/wrapper"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStatus_PeerRecords(t *testing.T) {
+	config := &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 5,
+			},
+		},
+	}
+	p := peers.NewStatus(context.Background(), config)
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+
+	connected := peer.ID("connected")
+	p.Add(new(enr.Record), connected, address, network.DirOutbound)
+	p.SetConnectionState(connected, peers.PeerConnected)
+	p.SetMetadata(connected, wrapper.WrappedMetadataV1(&pb.MetaDataV1{
+		SeqNumber: 3,
+		Attnets:   bitfield.Bitvector64{0x05, 0, 0, 0, 0, 0, 0, 0},
+		Syncnets:  bitfield.Bitvector4{0x01},
+	}))
+	p.Scorers().BlockProviderScorer().IncrementProcessedBlocks(connected, 128)
+
+	disconnected := peer.ID("disconnected")
+	p.Add(nil, disconnected, address, network.DirInbound)
+	p.SetConnectionState(disconnected, peers.PeerConnected)
+	p.SetConnectionState(disconnected, peers.PeerDisconnected)
+	p.Scorers().BadResponsesScorer().Increment(disconnected)
+	p.Ban(disconnected, time.Hour, "spam")
+
+	// Peers which were never connected are not recorded.
+	p.Add(nil, "discovered", address, network.DirUnknown)
+
+	records := p.PeerRecords(time.Hour)
+	require.Equal(t, 2, len(records))
+	assert.Equal(t, connected, records[0].ID, "Peers are not sorted by score")
+	assert.DeepEqual(t, []int{0, 2}, records[0].MetaData.AttnetsBitfield().BitIndices())
+	assert.Equal(t, uint64(128), records[0].ProcessedBlocks)
+	assert.Equal(t, disconnected, records[1].ID)
+	assert.Equal(t, 1, records[1].BadResponses)
+	assert.Equal(t, true, records[1].ManuallyBanned)
+	assert.Equal(t, 0, len(p.PeerRecords(-time.Second)), "Expired peers are recorded")
+
+	restoredStatus := peers.NewStatus(context.Background(), config)
+	restoredStatus.Add(nil, connected, address, network.DirInbound)
+	assert.Equal(t, 1, restoredStatus.RestorePeerRecords(records, time.Hour), "Known peers are overwritten")
+	assert.Equal(t, 0, restoredStatus.RestorePeerRecords(records, time.Hour), "Peers are restored twice")
+
+	state, err := restoredStatus.ConnectionState(disconnected)
+	require.NoError(t, err)
+	assert.Equal(t, peers.PeerDisconnected, state)
+	count, err := restoredStatus.Scorers().BadResponsesScorer().Count(disconnected)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, true, restoredStatus.IsBad(disconnected), "Manual ban is not restored")
+
+	// Records older than the maximum age are not restored.
+	records[1].LastSeen = time.Now().Add(-2 * time.Hour)
+	assert.Equal(t, 0, peers.NewStatus(context.Background(), config).RestorePeerRecords(records[1:], time.Hour))
+}
@@ -22,8 +22,9 @@ const (
 
 // BadResponsesScorer represents bad responses scoring service.
 type BadResponsesScorer struct {
-	config *BadResponsesScorerConfig
-	store  *peerdata.Store
+	config    *BadResponsesScorerConfig
+	store     *peerdata.Store
+	lastDecay time.Time
 }
 
 // BadResponsesScorerConfig holds configuration parameters for bad response scoring service.
@@ -40,8 +41,9 @@ func newBadResponsesScorer(store *peerdata.Store, config *BadResponsesScorerConf
 		config = &BadResponsesScorerConfig{}
 	}
 	scorer := &BadResponsesScorer{
-		config: config,
-		store:  store,
+		config:    config,
+		store:     store,
+		lastDecay: time.Now(),
 	}
 	if scorer.config.Threshold == 0 {
 		scorer.config.Threshold = DefaultBadResponsesThreshold
@@ -156,4 +158,23 @@ func (s *BadResponsesScorer) Decay() {
 			peerData.BadResponses--
 		}
 	}
+	s.lastDecay = time.Now()
+}
+
+// BannedUntil returns the time at which enough bad responses of the peer will have decayed for it not to be considered
+// bad anymore, or the zero time if the peer is not considered bad.
+func (s *BadResponsesScorer) BannedUntil(pid peer.ID) time.Time {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.bannedUntilNoLock(pid)
+}
+
+// bannedUntilNoLock is a lock-free version of BannedUntil.
+func (s *BadResponsesScorer) bannedUntilNoLock(pid peer.ID) time.Time {
+	peerData, ok := s.store.PeerData(pid)
+	if !ok || peerData.BadResponses < s.config.Threshold {
+		return time.Time{}
+	}
+	decays := peerData.BadResponses - s.config.Threshold + 1
+	return s.lastDecay.Add(time.Duration(decays) * s.config.DecayInterval)
 }
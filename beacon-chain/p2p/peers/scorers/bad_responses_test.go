@@ -4,6 +4,7 @@ import (
 	"context"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -197,3 +198,37 @@ func TestScorers_BadResponses_BadPeers(t *testing.T) {
 	})
 	assert.DeepEqual(t, want, badPeers, "Unexpected list of bad peers")
 }
+
+func TestScorers_BadResponses_BannedUntil(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peerStatuses := peers.NewStatus(ctx, &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold:     2,
+				DecayInterval: time.Hour,
+			},
+		},
+	})
+	scorer := peerStatuses.Scorers().BadResponsesScorer()
+	pid := peer.ID("peer1")
+	assert.Equal(t, true, scorer.BannedUntil(pid).IsZero(), "Unknown peer is not banned")
+
+	scorer.Increment(pid)
+	assert.Equal(t, true, scorer.BannedUntil(pid).IsZero(), "Peer below threshold is not banned")
+
+	// One decay lifts the ban at the threshold, and each extra bad response needs another decay.
+	start := time.Now()
+	scorer.Increment(pid)
+	until := scorer.BannedUntil(pid)
+	assert.Equal(t, true, until.After(start) && !until.After(start.Add(time.Hour)), "Unexpected ban expiry %v", until)
+	scorer.Increment(pid)
+	assert.Equal(t, time.Hour, scorer.BannedUntil(pid).Sub(until))
+
+	scorer.Decay()
+	scorer.Decay()
+	assert.Equal(t, false, scorer.IsBadPeer(pid))
+	assert.Equal(t, true, scorer.BannedUntil(pid).IsZero())
+}
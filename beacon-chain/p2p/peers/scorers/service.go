@@ -2,6 +2,7 @@ package scorers
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"time"
 
@@ -123,6 +124,34 @@ func (s *Service) ScoreNoLock(pid peer.ID) float64 {
 	return math.Round(score*ScoreRoundingFactor) / ScoreRoundingFactor
 }
 
+// PeerScores holds the score of a peer as calculated by each of the scorers, before weighting, along with the weighted
+// total score.
+type PeerScores struct {
+	BadResponses  float64
+	BlockProvider float64
+	PeerStatus    float64
+	Gossip        float64
+	Total         float64
+}
+
+// Scores returns the score of a peer as calculated by each of the scorers.
+func (s *Service) Scores(pid peer.ID) *PeerScores {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.ScoresNoLock(pid)
+}
+
+// ScoresNoLock is a lock-free version of Scores.
+func (s *Service) ScoresNoLock(pid peer.ID) *PeerScores {
+	return &PeerScores{
+		BadResponses:  s.scorers.badResponsesScorer.scoreNoLock(pid),
+		BlockProvider: s.scorers.blockProviderScorer.scoreNoLock(pid),
+		PeerStatus:    s.scorers.peerStatusScorer.scoreNoLock(pid),
+		Gossip:        s.scorers.gossipScorer.scoreNoLock(pid),
+		Total:         s.ScoreNoLock(pid),
+	}
+}
+
 // IsBadPeer traverses all the scorers to see if any of them classifies peer as bad.
 func (s *Service) IsBadPeer(pid peer.ID) bool {
 	s.store.RLock()
@@ -146,6 +175,42 @@ func (s *Service) IsBadPeerNoLock(pid peer.ID) bool {
 	return false
 }
 
+// BadPeerReasons returns why each of the scorers which classify the peer as bad does so, and the time at which the
+// peer is expected to stop being classified as bad. The time is zero if the peer is bad until its behaviour changes.
+func (s *Service) BadPeerReasons(pid peer.ID) ([]string, time.Time) {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.BadPeerReasonsNoLock(pid)
+}
+
+// BadPeerReasonsNoLock is a lock-free version of BadPeerReasons.
+func (s *Service) BadPeerReasonsNoLock(pid peer.ID) ([]string, time.Time) {
+	peerData, ok := s.store.PeerData(pid)
+	if !ok {
+		return nil, time.Time{}
+	}
+	var reasons []string
+	until := time.Time{}
+	indefinite := false
+	if s.scorers.badResponsesScorer.isBadPeerNoLock(pid) {
+		reasons = append(reasons, fmt.Sprintf("%d bad responses, threshold is %d",
+			peerData.BadResponses, s.scorers.badResponsesScorer.Params().Threshold))
+		until = s.scorers.badResponsesScorer.bannedUntilNoLock(pid)
+	}
+	if s.scorers.peerStatusScorer.isBadPeerNoLock(pid) {
+		reasons = append(reasons, fmt.Sprintf("invalid chain status: %v", peerData.ChainStateValidationError))
+		indefinite = true
+	}
+	if features.Get().EnablePeerScorer && s.scorers.gossipScorer.isBadPeerNoLock(pid) {
+		reasons = append(reasons, fmt.Sprintf("gossip score %.2f is below threshold %.2f", peerData.GossipScore, gossipThreshold))
+		indefinite = true
+	}
+	if indefinite {
+		return reasons, time.Time{}
+	}
+	return reasons, until
+}
+
 // BadPeers returns the peers that are considered bad by any of registered scorers.
 func (s *Service) BadPeers() []peer.ID {
 	s.store.RLock()
@@ -9,7 +9,9 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 )
 
@@ -308,3 +310,54 @@ func TestScorers_Service_BadPeers(t *testing.T) {
 	assert.Equal(t, true, peerStatuses.Scorers().IsBadPeer("peer3"))
 	assert.Equal(t, 2, len(peerStatuses.Scorers().BadPeers()))
 }
+
+func TestScorers_Service_Scores(t *testing.T) {
+	peerStatuses := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold:     5,
+				DecayInterval: 50 * time.Second,
+			},
+		},
+	})
+	s := peerStatuses.Scorers()
+	s.BadResponsesScorer().Increment("peer1")
+	s.GossipScorer().SetGossipData("peer1", 10, 0, nil)
+
+	scores := s.Scores("peer1")
+	assert.Equal(t, s.BadResponsesScorer().Score("peer1"), scores.BadResponses)
+	assert.Equal(t, s.BlockProviderScorer().Score("peer1"), scores.BlockProvider)
+	assert.Equal(t, s.PeerStatusScorer().Score("peer1"), scores.PeerStatus)
+	assert.Equal(t, 10.0, scores.Gossip)
+	assert.Equal(t, s.Score("peer1"), scores.Total)
+}
+
+func TestScorers_Service_BadPeerReasons(t *testing.T) {
+	peerStatuses := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold:     2,
+				DecayInterval: 50 * time.Second,
+			},
+		},
+	})
+	s := peerStatuses.Scorers()
+	reasons, until := s.BadPeerReasons("peer1")
+	assert.Equal(t, 0, len(reasons))
+	assert.Equal(t, true, until.IsZero())
+
+	s.BadResponsesScorer().Increment("peer1")
+	s.BadResponsesScorer().Increment("peer1")
+	reasons, until = s.BadPeerReasons("peer1")
+	assert.DeepEqual(t, []string{"2 bad responses, threshold is 2"}, reasons)
+	assert.Equal(t, s.BadResponsesScorer().BannedUntil("peer1"), until)
+
+	// An invalid chain status has no expiry.
+	s.PeerStatusScorer().SetPeerStatus("peer1", &pb.Status{}, p2ptypes.ErrWrongForkDigestVersion)
+	reasons, until = s.BadPeerReasons("peer1")
+	assert.Equal(t, 2, len(reasons))
+	assert.StringContains(t, "invalid chain status", reasons[1])
+	assert.Equal(t, true, until.IsZero())
+}
@@ -24,6 +24,7 @@ package peers
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"sort"
 	"strings"
@@ -306,6 +307,10 @@ func (p *Status) SetConnectionState(pid peer.ID, state peerdata.PeerConnectionSt
 	defer p.store.Unlock()
 
 	peerData := p.store.PeerDataGetOrCreate(pid)
+	// The peer was last seen when we connected to it or when the connection ended.
+	if state == PeerConnected || peerData.ConnState == PeerConnected {
+		peerData.LastSeen = prysmTime.Now()
+	}
 	peerData.ConnState = state
 }
 
@@ -343,6 +348,10 @@ func (p *Status) IsBad(pid peer.ID) bool {
 
 // isBad is the lock-free version of IsBad.
 func (p *Status) isBad(pid peer.ID) bool {
+	// Manual bans override the trusted peer set.
+	if p.isManuallyBanned(pid) {
+		return true
+	}
 	// Do not disconnect from trusted peers.
 	if p.store.IsTrustedPeer(pid) {
 		return false
@@ -350,6 +359,101 @@ func (p *Status) isBad(pid peer.ID) bool {
 	return p.isfromBadIP(pid) || p.scorers.IsBadPeerNoLock(pid)
 }
 
+// BanInfo describes why a peer is considered bad.
+type BanInfo struct {
+	Reasons []string
+	// Until is the time at which the peer is expected to stop being considered bad. It is the zero time if the peer
+	// is bad until its behaviour changes or the ban is lifted.
+	Until time.Time
+}
+
+// BanInfo returns why the peer is considered bad, or nil if it is not.
+func (p *Status) BanInfo(pid peer.ID) *BanInfo {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	if !p.isBad(pid) {
+		return nil
+	}
+	peerData, ok := p.store.PeerData(pid)
+	if !ok {
+		return nil
+	}
+	// The peer stays bad until every cause is resolved, so the latest expiry wins and a cause without an expiry
+	// makes the ban indefinite.
+	info := &BanInfo{}
+	indefinite := false
+	addCause := func(reason string, until time.Time) {
+		info.Reasons = append(info.Reasons, reason)
+		if until.IsZero() {
+			indefinite = true
+		} else if until.After(info.Until) {
+			info.Until = until
+		}
+	}
+	if p.isManuallyBanned(pid) {
+		reason := "manually banned"
+		if peerData.ManualBanReason != "" {
+			reason += ": " + peerData.ManualBanReason
+		}
+		addCause(reason, peerData.ManualBanExpiry)
+	}
+	// Trusted peers are only bad when manually banned.
+	if !p.store.IsTrustedPeer(pid) {
+		if p.isfromBadIP(pid) {
+			addCause(fmt.Sprintf("more than %d peers seen from the same ip", CollocationLimit), time.Time{})
+		}
+		reasons, until := p.scorers.BadPeerReasonsNoLock(pid)
+		for _, reason := range reasons {
+			addCause(reason, until)
+		}
+	}
+	if indefinite {
+		info.Until = time.Time{}
+	}
+	return info
+}
+
+// Ban marks the peer as bad, regardless of its scores and of it being trusted, until the given duration elapses or
+// the ban is lifted with Unban. A zero duration bans the peer until Unban is called.
+func (p *Status) Ban(pid peer.ID, duration time.Duration, reason string) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData := p.store.PeerDataGetOrCreate(pid)
+	peerData.ManuallyBanned = true
+	peerData.ManualBanReason = reason
+	peerData.ManualBanExpiry = time.Time{}
+	if duration > 0 {
+		peerData.ManualBanExpiry = prysmTime.Now().Add(duration)
+	}
+}
+
+// Unban lifts a manual ban of the peer. Its bad responses, gossip score and chain status are left as they are, so a
+// peer which is bad for another reason stays bad. This will error if the peer does not exist.
+func (p *Status) Unban(pid peer.ID) error {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData, ok := p.store.PeerData(pid)
+	if !ok {
+		return peerdata.ErrPeerUnknown
+	}
+	peerData.ManuallyBanned = false
+	peerData.ManualBanReason = ""
+	peerData.ManualBanExpiry = time.Time{}
+	return nil
+}
+
+// isManuallyBanned is the lock-free check of whether the peer has been banned with Ban, and the ban has not expired.
+func (p *Status) isManuallyBanned(pid peer.ID) bool {
+	peerData, ok := p.store.PeerData(pid)
+	if !ok || !peerData.ManuallyBanned {
+		return false
+	}
+	return peerData.ManualBanExpiry.IsZero() || prysmTime.Now().Before(peerData.ManualBanExpiry)
+}
+
 // NextValidTime gets the earliest possible time it is to contact/dial
 // a peer again. This is used to back-off from peers in the event
 // they are 'full' or have banned us.
@@ -591,6 +695,7 @@ func (p *Status) Prune() {
 	if len(p.store.Peers()) <= p.store.Config().MaxPeers {
 		return
 	}
+	// Bad peers, which include the manually banned ones, are kept so that they are not given a fresh start.
 	notBadPeer := func(pid peer.ID) bool {
 		return !p.isBad(pid)
 	}
@@ -643,8 +748,9 @@ func (p *Status) deprecatedPrune() {
 		return
 	}
 
-	notBadPeer := func(peerData *peerdata.PeerData) bool {
-		return peerData.BadResponses < p.scorers.BadResponsesScorer().Params().Threshold
+	notBadPeer := func(pid peer.ID, peerData *peerdata.PeerData) bool {
+		// Manually banned peers are kept, so that the ban is not lifted by forgetting the peer.
+		return peerData.BadResponses < p.scorers.BadResponsesScorer().Params().Threshold && !p.isManuallyBanned(pid)
 	}
 	notTrustedPeer := func(pid peer.ID) bool {
 		return !p.isTrustedPeers(pid)
@@ -657,7 +763,7 @@ func (p *Status) deprecatedPrune() {
 	// Select disconnected peers with a smaller bad response count.
 	for pid, peerData := range p.store.Peers() {
 		// Should not prune trusted peer or prune the peer dara and unset trusted peer.
-		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) && notTrustedPeer(pid) {
+		if peerData.ConnState == PeerDisconnected && notBadPeer(pid, peerData) && notTrustedPeer(pid) {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
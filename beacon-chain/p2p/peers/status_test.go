@@ -379,6 +379,62 @@ func TestPeerBadResponses(t *testing.T) {
 	assert.Equal(t, true, p.IsBad(id), "Peer not marked as bad when it should be")
 }
 
+func TestPeerBan(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 2,
+			},
+		},
+	})
+	id, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	p.Add(new(enr.Record), id, address, network.DirInbound)
+	assert.Equal(t, true, p.BanInfo(id) == nil, "Good peer has ban info")
+	require.ErrorIs(t, p.Unban("unknown"), peerdata.ErrPeerUnknown)
+
+	// Manual bans apply to trusted peers too.
+	p.SetTrustedPeers([]peer.ID{id})
+	p.Ban(id, time.Hour, "spam")
+	assert.Equal(t, true, p.IsBad(id), "Banned peer is not bad")
+	info := p.BanInfo(id)
+	require.NotNil(t, info)
+	assert.DeepEqual(t, []string{"manually banned: spam"}, info.Reasons)
+	assert.Equal(t, true, info.Until.After(time.Now().Add(59*time.Minute)), "Unexpected ban expiry %v", info.Until)
+
+	require.NoError(t, p.Unban(id))
+	assert.Equal(t, false, p.IsBad(id), "Unbanned peer is bad")
+	p.DeleteTrustedPeers([]peer.ID{id})
+
+	// A ban without duration lasts until the peer is unbanned, and outlasts the bad responses.
+	p.Ban(id, 0, "")
+	p.Scorers().BadResponsesScorer().Increment(id)
+	p.Scorers().BadResponsesScorer().Increment(id)
+	info = p.BanInfo(id)
+	require.NotNil(t, info)
+	assert.DeepEqual(t, []string{"manually banned", "2 bad responses, threshold is 2"}, info.Reasons)
+	assert.Equal(t, true, info.Until.IsZero(), "Ban without duration expires")
+
+	// Unbanning only lifts the manual ban, the bad responses still make the peer bad.
+	require.NoError(t, p.Unban(id))
+	assert.Equal(t, true, p.IsBad(id), "Peer with bad responses is not bad")
+	info = p.BanInfo(id)
+	require.NotNil(t, info)
+	assert.DeepEqual(t, []string{"2 bad responses, threshold is 2"}, info.Reasons)
+	count, err := p.Scorers().BadResponsesScorer().Count(id)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	// Expired bans are lifted.
+	other := addPeer(t, p, peers.PeerConnected)
+	p.Ban(other, time.Nanosecond, "")
+	time.Sleep(time.Millisecond)
+	assert.Equal(t, false, p.IsBad(other), "Peer with expired ban is bad")
+}
+
 func TestAddMetaData(t *testing.T) {
 	maxBadResponses := 2
 	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
@@ -548,6 +604,38 @@ func TestPrune(t *testing.T) {
 	assert.ErrorContains(t, "peer unknown", err)
 }
 
+func TestPrune_KeepsBannedPeers(t *testing.T) {
+	for name, enablePeerScorer := range map[string]bool{"deprecated prune": false, "peer scorer": true} {
+		t.Run(name, func(t *testing.T) {
+			resetCfg := features.InitWithReset(&features.Flags{
+				EnablePeerScorer: enablePeerScorer,
+			})
+			defer resetCfg()
+			p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+				PeerLimit: 30,
+				ScorerParams: &scorers.Config{
+					BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+						Threshold: 2,
+					},
+				},
+			})
+			var disconnected []peer.ID
+			for i := 0; i < p.MaxPeerLimit()+10; i++ {
+				disconnected = append(disconnected, addPeer(t, p, peers.PeerDisconnected))
+			}
+			banned := disconnected[0]
+			p.Ban(banned, 0, "spam")
+
+			p.Prune()
+
+			_, err := p.ConnectionState(banned)
+			require.NoError(t, err, "Banned peer was pruned")
+			assert.Equal(t, true, p.IsBad(banned), "Banned peer is not bad")
+			assert.Equal(t, p.MaxPeerLimit(), len(p.All()))
+		})
+	}
+}
+
 func TestPeerIPTracker(t *testing.T) {
 	resetCfg := features.InitWithReset(&features.Flags{
 		EnablePeerScorer: false,
@@ -0,0 +1,252 @@
+package p2p
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/wrapper"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+const peerStorePath = "peerstore.json"
+
+// peerStoreVersion is the version of the peer store file format. Files of other versions are ignored.
+const peerStoreVersion = 1
+
+var (
+	// peerStoreSaveInterval is how often the known peers are saved to the peer store, so that a node which did not
+	// shut down cleanly restarts with a recent view of its peers.
+	peerStoreSaveInterval = 5 * time.Minute
+	// peerStoreMaxAge is how long a peer is kept in the peer store after it was last connected.
+	peerStoreMaxAge = 7 * 24 * time.Hour
+)
+
+type peerStore struct {
+	Version int               `json:"version"`
+	Peers   []*peerStoreEntry `json:"peers"`
+}
+
+type peerStoreEntry struct {
+	ID               string            `json:"id"`
+	Enr              string            `json:"enr,omitempty"`
+	Address          string            `json:"address,omitempty"`
+	Direction        network.Direction `json:"direction"`
+	LastSeen         time.Time         `json:"last_seen"`
+	Score            float64           `json:"score"`
+	BadResponses     int               `json:"bad_responses,omitempty"`
+	ProcessedBlocks  uint64            `json:"processed_blocks,omitempty"`
+	GossipScore      float64           `json:"gossip_score,omitempty"`
+	BehaviourPenalty float64           `json:"behaviour_penalty,omitempty"`
+	MetaDataSeq      uint64            `json:"metadata_seq,omitempty"`
+	Attnets          []byte            `json:"attnets,omitempty"`
+	Syncnets         []byte            `json:"syncnets,omitempty"`
+	Banned           bool              `json:"banned,omitempty"`
+	BanReason        string            `json:"ban_reason,omitempty"`
+	BanExpiry        time.Time         `json:"ban_expiry,omitempty"`
+}
+
+// savePeerStore writes the peers which have been connected recently, along with their scoring data and subnets, to
+// the peer store in the data directory.
+func (s *Service) savePeerStore() error {
+	if s.cfg.DataDir == "" {
+		return nil
+	}
+	records := s.peers.PeerRecords(peerStoreMaxAge)
+	store := &peerStore{
+		Version: peerStoreVersion,
+		Peers:   make([]*peerStoreEntry, 0, len(records)),
+	}
+	for _, record := range records {
+		entry := &peerStoreEntry{
+			ID:               record.ID.String(),
+			Direction:        record.Direction,
+			LastSeen:         record.LastSeen,
+			Score:            record.Score,
+			BadResponses:     record.BadResponses,
+			ProcessedBlocks:  record.ProcessedBlocks,
+			GossipScore:      record.GossipScore,
+			BehaviourPenalty: record.BehaviourPenalty,
+			Banned:           record.ManuallyBanned,
+			BanReason:        record.ManualBanReason,
+			BanExpiry:        record.ManualBanExpiry,
+		}
+		if record.Enr != nil {
+			serialized, err := SerializeENR(record.Enr)
+			if err != nil {
+				log.WithError(err).WithField("peer", record.ID).Debug("Could not serialize peer ENR")
+			} else {
+				entry.Enr = serialized
+			}
+		}
+		if record.Address != nil {
+			entry.Address = record.Address.String()
+		}
+		if record.MetaData != nil {
+			entry.MetaDataSeq = record.MetaData.SequenceNumber()
+			entry.Attnets = record.MetaData.AttnetsBitfield()
+			if md := record.MetaData.MetadataObjV1(); md != nil {
+				entry.Syncnets = md.Syncnets
+			}
+		}
+		store.Peers = append(store.Peers, entry)
+	}
+	enc, err := json.Marshal(store)
+	if err != nil {
+		return errors.Wrap(err, "could not encode peer store")
+	}
+	if err := file.WriteFile(path.Join(s.cfg.DataDir, peerStorePath), enc); err != nil {
+		return errors.Wrap(err, "could not write peer store")
+	}
+	log.WithField("peers", len(store.Peers)).Debug("Saved peer store")
+	return nil
+}
+
+// loadPeerStore reads the peer records from the peer store in the data directory, skipping the records which are
+// invalid or older than the maximum age. It returns no records if there is no peer store.
+func (s *Service) loadPeerStore() ([]*peers.PeerRecord, error) {
+	if s.cfg.DataDir == "" {
+		return nil, nil
+	}
+	enc, err := os.ReadFile(path.Join(s.cfg.DataDir, peerStorePath)) // #nosec G304
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "could not read peer store")
+	}
+	store := &peerStore{}
+	if err := json.Unmarshal(enc, store); err != nil {
+		return nil, errors.Wrap(err, "could not decode peer store")
+	}
+	if store.Version != peerStoreVersion {
+		return nil, errors.Errorf("unsupported peer store version %d, expected %d", store.Version, peerStoreVersion)
+	}
+	records := make([]*peers.PeerRecord, 0, len(store.Peers))
+	for _, entry := range store.Peers {
+		if time.Since(entry.LastSeen) > peerStoreMaxAge {
+			continue
+		}
+		record, err := peerRecordFromEntry(entry)
+		if err != nil {
+			log.WithError(err).WithField("peer", entry.ID).Debug("Skipping invalid peer store entry")
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func peerRecordFromEntry(entry *peerStoreEntry) (*peers.PeerRecord, error) {
+	pid, err := peer.Decode(entry.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode peer id")
+	}
+	record := &peers.PeerRecord{
+		ID:               pid,
+		Direction:        entry.Direction,
+		LastSeen:         entry.LastSeen,
+		Score:            entry.Score,
+		BadResponses:     entry.BadResponses,
+		ProcessedBlocks:  entry.ProcessedBlocks,
+		GossipScore:      entry.GossipScore,
+		BehaviourPenalty: entry.BehaviourPenalty,
+		ManuallyBanned:   entry.Banned,
+		ManualBanReason:  entry.BanReason,
+		ManualBanExpiry:  entry.BanExpiry,
+	}
+	if entry.Enr != "" {
+		node, err := enode.Parse(enode.ValidSchemes, "enr:"+entry.Enr)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode ENR")
+		}
+		record.Enr = node.Record()
+	}
+	if entry.Address != "" {
+		record.Address, err = multiAddrFromString(entry.Address)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode address")
+		}
+	}
+	if entry.Attnets != nil {
+		syncnets := bitfield.Bitvector4(entry.Syncnets)
+		if syncnets == nil {
+			syncnets = bitfield.NewBitvector4()
+		}
+		record.MetaData = wrapper.WrappedMetadataV1(&pb.MetaDataV1{
+			SeqNumber: entry.MetaDataSeq,
+			Attnets:   entry.Attnets,
+			Syncnets:  syncnets,
+		})
+	}
+	return record, nil
+}
+
+// restorePeerStore adds the peers from the peer store to the peer status, and dials the best of them, up to the peer
+// limit. Only peers whose ENR is known or which we dialed ourselves are dialed, as the address of an inbound peer
+// is not one it listens on.
+func (s *Service) restorePeerStore() {
+	records, err := s.loadPeerStore()
+	if err != nil {
+		log.WithError(err).Error("Could not load peer store")
+		return
+	}
+	if len(records) == 0 {
+		return
+	}
+	restored := s.peers.RestorePeerRecords(records, peerStoreMaxAge)
+
+	// Records are sorted from the highest to the lowest score.
+	dialed := 0
+	for _, record := range records {
+		if dialed >= int(s.cfg.MaxPeers) {
+			break
+		}
+		if s.peers.IsBad(record.ID) {
+			continue
+		}
+		info, err := persistedPeerAddrInfo(record)
+		if err != nil {
+			log.WithError(err).WithField("peer", record.ID).Debug("Could not get address of persisted peer")
+			continue
+		}
+		if info == nil {
+			continue
+		}
+		dialed++
+		go func(info *peer.AddrInfo) {
+			if err := s.connectWithPeer(s.ctx, *info); err != nil {
+				log.WithError(err).Tracef("Could not connect with peer %s", info.String())
+			}
+		}(info)
+	}
+	log.WithFields(logrus.Fields{
+		"restored": restored,
+		"dialed":   dialed,
+	}).Info("Restored peers from peer store")
+}
+
+func persistedPeerAddrInfo(record *peers.PeerRecord) (*peer.AddrInfo, error) {
+	if record.Enr != nil {
+		node, err := enode.New(enode.ValidSchemes, record.Enr)
+		if err != nil {
+			return nil, err
+		}
+		info, _, err := convertToAddrInfo(node)
+		return info, err
+	}
+	if record.Address != nil && record.Direction == network.DirOutbound {
+		return &peer.AddrInfo{ID: record.ID, Addrs: []ma.Multiaddr{record.Address}}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,102 @@
+package p2p
+
+import (
+	"context"
+	"crypto/rand"
+	"net"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/wrapper"
+	ecdsaprysm "github.com/prysmaticlabs/prysm/v5/crypto/ecdsa"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestPeerStore_SaveAndLoad(t *testing.T) {
+	newService := func(dataDir string) *Service {
+		return &Service{
+			cfg: &Config{DataDir: dataDir, MaxPeers: 30},
+			peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+				PeerLimit:    30,
+				ScorerParams: &scorers.Config{},
+			}),
+		}
+	}
+	dataDir := t.TempDir()
+	s := newService(dataDir)
+
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	key, err := ecdsaprysm.ConvertFromInterfacePrivKey(priv)
+	require.NoError(t, err)
+	pid, err := peer.IDFromPrivateKey(priv)
+	require.NoError(t, err)
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	localNode := enode.NewLocalNode(db, key)
+	localNode.SetStaticIP(net.ParseIP("213.202.254.180"))
+	localNode.Set(enr.TCP(13000))
+	record := localNode.Node().Record()
+
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	s.peers.Add(record, pid, address, network.DirInbound)
+	s.peers.SetConnectionState(pid, peers.PeerConnected)
+	s.peers.SetMetadata(pid, wrapper.WrappedMetadataV1(&pb.MetaDataV1{
+		SeqNumber: 7,
+		Attnets:   bitfield.Bitvector64{0x03, 0, 0, 0, 0, 0, 0, 0},
+		Syncnets:  bitfield.Bitvector4{0x02},
+	}))
+	s.peers.Scorers().BadResponsesScorer().Increment(pid)
+	require.NoError(t, s.savePeerStore())
+
+	records, err := newService(dataDir).loadPeerStore()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, pid, records[0].ID)
+	assert.Equal(t, address.String(), records[0].Address.String())
+	assert.Equal(t, 1, records[0].BadResponses)
+	assert.Equal(t, uint64(7), records[0].MetaData.SequenceNumber())
+	assert.DeepEqual(t, []int{0, 1}, records[0].MetaData.AttnetsBitfield().BitIndices())
+	assert.DeepEqual(t, bitfield.Bitvector4{0x02}, records[0].MetaData.MetadataObjV1().Syncnets)
+
+	// Inbound peers are dialed through the address in their ENR.
+	info, err := persistedPeerAddrInfo(records[0])
+	require.NoError(t, err)
+	require.NotNil(t, info)
+	assert.Equal(t, pid, info.ID)
+	assert.Equal(t, "/ip4/213.202.254.180/tcp/13000", info.Addrs[0].String())
+
+	// Peers last seen too long ago are aged out.
+	records[0].LastSeen = time.Now().Add(-2 * peerStoreMaxAge)
+	restored := newService(dataDir)
+	restored.peers.RestorePeerRecords(records, 3*peerStoreMaxAge)
+	require.NoError(t, restored.savePeerStore())
+	records, err = restored.loadPeerStore()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(records))
+}
+
+func TestPeerStore_LoadMissingOrUnsupported(t *testing.T) {
+	s := &Service{cfg: &Config{DataDir: t.TempDir()}}
+	records, err := s.loadPeerStore()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(records))
+
+	require.NoError(t, os.WriteFile(path.Join(s.cfg.DataDir, peerStorePath), []byte(`{"version":0}`), 0600))
+	_, err = s.loadPeerStore()
+	assert.ErrorContains(t, "unsupported peer store version", err)
+}
@@ -148,6 +148,9 @@ func (s *Service) pubsubOptions() []pubsub.Option {
 		pubsub.WithGossipSubParams(pubsubGossipParam()),
 		pubsub.WithRawTracer(gossipTracer{host: s.host}),
 	}
+	if s.gossipEventTracer != nil {
+		psOpts = append(psOpts, pubsub.WithEventTracer(s.gossipEventTracer))
+	}
 
 	if len(s.cfg.StaticPeers) > 0 {
 		directPeersAddrInfos, err := parsePeersEnr(s.cfg.StaticPeers)
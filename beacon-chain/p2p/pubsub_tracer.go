@@ -1,14 +1,44 @@
 package p2p
 
 import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+)
+
+var (
+	_ = pubsub.RawTracer(gossipTracer{})
+	_ = pubsub.EventTracer(&gossipEventTracer{})
 )
 
-var _ = pubsub.RawTracer(gossipTracer{})
+// Formats of the gossip trace file, which are those of the JSON and protobuf tracers of libp2p.
+const (
+	// GossipTraceFormatJSON writes one JSON encoded trace event per line.
+	GossipTraceFormatJSON = "json"
+	// GossipTraceFormatPB writes trace events as protobuf, each prefixed with its length as a varint.
+	GossipTraceFormatPB = "pb"
+)
+
+const (
+	// gossipTraceQueueSize is the number of trace events which can wait to be written before new events are dropped.
+	gossipTraceQueueSize = 1 << 16
+	// gossipTraceBackups is the number of rotated gossip trace files which are kept.
+	gossipTraceBackups = 5
+)
 
 // Initializes the values for the pubsub rpc action.
 type action int
@@ -118,3 +148,263 @@ func (g gossipTracer) setMetricFromRPC(act action, subCtr prometheus.Counter, pu
 		pubCtr.WithLabelValues(*msg.Topic).Inc()
 	}
 }
+
+// gossipEventTracer writes the gossipsub trace events of the message lifecycle, and of the peers and mesh changes, to a
+// file which is rotated once it grows past a maximum size. RPC events, which are already counted in metrics, are left
+// out as they would make up most of the trace. Events are written in the background, so that tracing never blocks
+// gossip, and dropped if they cannot be written fast enough.
+type gossipEventTracer struct {
+	events chan *pubsubpb.TraceEvent
+	quit   chan struct{}
+	done   chan struct{}
+	file   *rotatingFile
+	encode func(w io.Writer, evt *pubsubpb.TraceEvent) error
+}
+
+// newGossipEventTracer starts a tracer writing events in the given format to the file at the given path, rotating it
+// once it grows past maxSize bytes.
+func newGossipEventTracer(path, format string, maxSize int64) (*gossipEventTracer, error) {
+	var encode func(w io.Writer, evt *pubsubpb.TraceEvent) error
+	switch format {
+	case GossipTraceFormatJSON:
+		encode = encodeTraceEventJSON
+	case GossipTraceFormatPB:
+		encode = encodeTraceEventPB
+	default:
+		return nil, fmt.Errorf("unknown gossip trace format %q, expected %q or %q", format, GossipTraceFormatJSON, GossipTraceFormatPB)
+	}
+	f, err := openRotatingFile(path, maxSize)
+	if err != nil {
+		return nil, err
+	}
+	t := &gossipEventTracer{
+		events: make(chan *pubsubpb.TraceEvent, gossipTraceQueueSize),
+		quit:   make(chan struct{}),
+		done:   make(chan struct{}),
+		file:   f,
+		encode: encode,
+	}
+	go t.run()
+	return t, nil
+}
+
+// Trace queues the event to be written.
+func (t *gossipEventTracer) Trace(evt *pubsubpb.TraceEvent) {
+	switch evt.GetType() {
+	case pubsubpb.TraceEvent_RECV_RPC, pubsubpb.TraceEvent_SEND_RPC, pubsubpb.TraceEvent_DROP_RPC:
+		return
+	}
+	select {
+	case <-t.quit:
+	case t.events <- evt:
+	default:
+		gossipTraceDroppedEvents.Inc()
+	}
+}
+
+// Close writes the queued events and closes the trace file.
+func (t *gossipEventTracer) Close() error {
+	close(t.quit)
+	<-t.done
+	return t.file.Close()
+}
+
+func (t *gossipEventTracer) run() {
+	defer close(t.done)
+	for {
+		select {
+		case evt := <-t.events:
+			t.write(evt)
+		case <-t.quit:
+			for {
+				select {
+				case evt := <-t.events:
+					t.write(evt)
+				default:
+					return
+				}
+			}
+		}
+		// Events are buffered while they keep coming, and flushed once the queue is empty.
+		if len(t.events) == 0 {
+			if err := t.file.Flush(); err != nil {
+				log.WithError(err).Debug("Could not flush gossip trace")
+			}
+		}
+	}
+}
+
+func (t *gossipEventTracer) write(evt *pubsubpb.TraceEvent) {
+	if err := t.encode(t.file, evt); err != nil {
+		gossipTraceDroppedEvents.Inc()
+		log.WithError(err).Debug("Could not write gossip trace event")
+	}
+}
+
+func encodeTraceEventJSON(w io.Writer, evt *pubsubpb.TraceEvent) error {
+	enc, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(enc, '\n'))
+	return err
+}
+
+func encodeTraceEventPB(w io.Writer, evt *pubsubpb.TraceEvent) error {
+	enc, err := evt.Marshal()
+	if err != nil {
+		return err
+	}
+	// The length and the event are written at once, so that the file is not rotated in between.
+	_, err = w.Write(append(binary.AppendUvarint(nil, uint64(len(enc))), enc...))
+	return err
+}
+
+// ReadGossipTrace calls fn with each event of a gossip trace written in the given format, in order.
+func ReadGossipTrace(r io.Reader, format string, fn func(evt *pubsubpb.TraceEvent) error) error {
+	switch format {
+	case GossipTraceFormatJSON:
+		dec := json.NewDecoder(r)
+		for {
+			evt := &pubsubpb.TraceEvent{}
+			if err := dec.Decode(evt); err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return errors.Wrap(err, "could not decode trace event")
+			}
+			if err := fn(evt); err != nil {
+				return err
+			}
+		}
+	case GossipTraceFormatPB:
+		br := bufio.NewReader(r)
+		for {
+			size, err := binary.ReadUvarint(br)
+			if err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return errors.Wrap(err, "could not read trace event length")
+			}
+			if size > uint64(params.BeaconConfig().GossipMaxSize) {
+				return fmt.Errorf("trace event of %d bytes is too large", size)
+			}
+			enc := make([]byte, size)
+			if _, err := io.ReadFull(br, enc); err != nil {
+				return errors.Wrap(err, "could not read trace event")
+			}
+			evt := &pubsubpb.TraceEvent{}
+			if err := evt.Unmarshal(enc); err != nil {
+				return errors.Wrap(err, "could not decode trace event")
+			}
+			if err := fn(evt); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unknown gossip trace format %q, expected %q or %q", format, GossipTraceFormatJSON, GossipTraceFormatPB)
+	}
+}
+
+// rotatingFile is a buffered file which is moved to path.1 once it grows past its maximum size, after moving the
+// previously rotated files up by one, and dropping the oldest.
+type rotatingFile struct {
+	path    string
+	maxSize int64
+	size    int64
+	f       *os.File
+	w       *bufio.Writer
+}
+
+func openRotatingFile(path string, maxSize int64) (*rotatingFile, error) {
+	if err := file.MkdirAll(filepath.Dir(path)); err != nil {
+		return nil, errors.Wrap(err, "could not create gossip trace directory")
+	}
+	r := &rotatingFile{path: path, maxSize: maxSize}
+	if err := r.open(os.O_APPEND); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *rotatingFile) open(flag int) error {
+	f, size, err := openTraceFile(r.path, flag)
+	if err != nil {
+		return err
+	}
+	r.f = f
+	r.w = bufio.NewWriter(f)
+	r.size = size
+	return nil
+}
+
+func openTraceFile(path string, flag int) (*os.File, int64, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|flag, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not open gossip trace file")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			log.WithError(closeErr).Debug("Could not close gossip trace file")
+		}
+		return nil, 0, errors.Wrap(err, "could not stat gossip trace file")
+	}
+	return f, info.Size(), nil
+}
+
+// Write writes p to the file, rotating it first if p would take it past its maximum size.
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	if r.maxSize > 0 && r.size > 0 && r.size+int64(len(p)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			// Events keep being written to the current file, which is rotated again once it has grown by another
+			// maxSize bytes.
+			log.WithError(err).Warn("Could not rotate gossip trace file")
+			r.size = 0
+		}
+	}
+	n, err := r.w.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// Flush writes the buffered data to the file.
+func (r *rotatingFile) Flush() error {
+	return r.w.Flush()
+}
+
+// Close flushes and closes the file.
+func (r *rotatingFile) Close() error {
+	if err := r.w.Flush(); err != nil {
+		return err
+	}
+	return r.f.Close()
+}
+
+// rotate moves the file to path.1 and continues in a new file at path. The current file is only closed once the new one
+// is open, so that it is kept if the rotation fails.
+func (r *rotatingFile) rotate() error {
+	if err := r.w.Flush(); err != nil {
+		return errors.Wrap(err, "could not flush gossip trace file")
+	}
+	for i := gossipTraceBackups - 1; i > 0; i-- {
+		if err := os.Rename(fmt.Sprintf("%s.%d", r.path, i), fmt.Sprintf("%s.%d", r.path, i+1)); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "could not rotate gossip trace file")
+		}
+	}
+	if err := os.Rename(r.path, r.path+".1"); err != nil {
+		return errors.Wrap(err, "could not rotate gossip trace file")
+	}
+	f, size, err := openTraceFile(r.path, os.O_TRUNC)
+	if err != nil {
+		return err
+	}
+	if err := r.f.Close(); err != nil {
+		log.WithError(err).Debug("Could not close rotated gossip trace file")
+	}
+	r.f = f
+	r.w.Reset(f)
+	r.size = size
+	return nil
+}
@@ -0,0 +1,123 @@
+package p2p
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestGossipEventTracer_WritesAndRotates(t *testing.T) {
+	for _, format := range []string{GossipTraceFormatJSON, GossipTraceFormatPB} {
+		t.Run(format, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "trace")
+			tracer, err := newGossipEventTracer(path, format, 4096)
+			require.NoError(t, err)
+
+			topic := "/eth2/00000000/beacon_block/ssz_snappy"
+			reason := "validation failed"
+			const n = 1000
+			for i := 0; i < n; i++ {
+				ts := int64(i)
+				tracer.Trace(&pubsubpb.TraceEvent{
+					Type:      pubsubpb.TraceEvent_REJECT_MESSAGE.Enum(),
+					PeerID:    []byte("node"),
+					Timestamp: &ts,
+					RejectMessage: &pubsubpb.TraceEvent_RejectMessage{
+						MessageID:    []byte(fmt.Sprintf("message-%d", i)),
+						ReceivedFrom: []byte("peer"),
+						Reason:       &reason,
+						Topic:        &topic,
+					},
+				})
+				// RPC events are not traced.
+				tracer.Trace(&pubsubpb.TraceEvent{Type: pubsubpb.TraceEvent_SEND_RPC.Enum(), Timestamp: &ts})
+			}
+			require.NoError(t, tracer.Close())
+
+			// The rotated files are read from the oldest to the current one.
+			var files []string
+			for i := gossipTraceBackups; i > 0; i-- {
+				files = append(files, fmt.Sprintf("%s.%d", path, i))
+			}
+			files = append(files, path)
+			var timestamps []int64
+			for _, name := range files {
+				f, err := os.Open(name)
+				require.NoError(t, err, "Trace file was not rotated")
+				info, err := f.Stat()
+				require.NoError(t, err)
+				assert.Equal(t, true, info.Size() <= 4096, "Trace file grew past its maximum size")
+				require.NoError(t, ReadGossipTrace(f, format, func(evt *pubsubpb.TraceEvent) error {
+					require.Equal(t, pubsubpb.TraceEvent_REJECT_MESSAGE, evt.GetType())
+					assert.Equal(t, topic, evt.GetRejectMessage().GetTopic())
+					assert.Equal(t, reason, evt.GetRejectMessage().GetReason())
+					timestamps = append(timestamps, evt.GetTimestamp())
+					return nil
+				}))
+				require.NoError(t, f.Close())
+			}
+			_, err = os.Stat(fmt.Sprintf("%s.%d", path, gossipTraceBackups+1))
+			assert.Equal(t, true, os.IsNotExist(err), "Too many rotated files were kept")
+
+			// Only the most recent events are left, in order.
+			require.Equal(t, true, len(timestamps) > 0 && len(timestamps) < n)
+			for i, ts := range timestamps {
+				assert.Equal(t, int64(n-len(timestamps)+i), ts)
+			}
+		})
+	}
+}
+
+func TestGossipEventTracer_UnknownFormat(t *testing.T) {
+	_, err := newGossipEventTracer(filepath.Join(t.TempDir(), "trace"), "csv", 0)
+	assert.ErrorContains(t, "unknown gossip trace format", err)
+}
+
+func TestGossipEventTracer_KeepsWritingWhenRotationFails(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("Read-only directories are writable by root")
+	}
+	dir := t.TempDir()
+	path := filepath.Join(dir, "trace")
+	tracer, err := newGossipEventTracer(path, GossipTraceFormatJSON, 1024)
+	require.NoError(t, err)
+	require.NoError(t, os.Chmod(dir, 0500))
+	defer func() {
+		require.NoError(t, os.Chmod(dir, 0700))
+	}()
+
+	topic := "topic"
+	const n = 100
+	for i := 0; i < n; i++ {
+		ts := int64(i)
+		tracer.Trace(&pubsubpb.TraceEvent{
+			Type:      pubsubpb.TraceEvent_JOIN.Enum(),
+			Timestamp: &ts,
+			Join:      &pubsubpb.TraceEvent_Join{Topic: &topic},
+		})
+	}
+	require.NoError(t, tracer.Close())
+
+	_, err = os.Stat(path + ".1")
+	assert.Equal(t, true, os.IsNotExist(err), "Trace file was rotated in a read-only directory")
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	var timestamps []int64
+	require.NoError(t, ReadGossipTrace(f, GossipTraceFormatJSON, func(evt *pubsubpb.TraceEvent) error {
+		timestamps = append(timestamps, evt.GetTimestamp())
+		return nil
+	}))
+	// All events are kept in the file which could not be rotated.
+	require.Equal(t, n, len(timestamps))
+	for i, ts := range timestamps {
+		assert.Equal(t, int64(i), ts)
+	}
+}
@@ -23,6 +23,19 @@ func TestVerifyRPCMappings(t *testing.T) {
 	assert.NotNil(t, VerifyTopicMapping(RPCStatusTopicV1, new([]byte)), "Incorrect message type verified for metadata rpc topic")
 
 	assert.NoError(t, VerifyTopicMapping(RPCBlocksByRootTopicV1, new(types.BeaconBlockByRootsReq)), "Failed to verify blocks by root rpc topic")
+
+	assert.NoError(t, VerifyTopicMapping(RPCLightClientBootstrapTopicV1, new(types.LightClientBootstrapReq)), "Failed to verify light client bootstrap rpc topic")
+	assert.NoError(t, VerifyTopicMapping(RPCLightClientUpdatesByRangeTopicV1, new(pb.LightClientUpdatesByRangeRequest)), "Failed to verify light client updates by range rpc topic")
+	assert.NoError(t, VerifyTopicMapping(RPCLightClientFinalityUpdateTopicV1, new(interface{})), "Failed to verify light client finality update rpc topic")
+}
+
+func TestOmitRequestPayload(t *testing.T) {
+	assert.Equal(t, true, OmitRequestPayload(RPCMetaDataTopicV1))
+	assert.Equal(t, true, OmitRequestPayload(RPCMetaDataTopicV2))
+	assert.Equal(t, true, OmitRequestPayload(RPCLightClientFinalityUpdateTopicV1))
+	assert.Equal(t, true, OmitRequestPayload(RPCLightClientOptimisticUpdateTopicV1))
+	assert.Equal(t, false, OmitRequestPayload(RPCLightClientBootstrapTopicV1))
+	assert.Equal(t, false, OmitRequestPayload(RPCStatusTopicV1))
 }
 
 func TestTopicDeconstructor(t *testing.T) {
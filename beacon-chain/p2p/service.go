@@ -66,6 +66,7 @@ type Service struct {
 	privKey               *ecdsa.PrivateKey
 	metaData              metadata.Metadata
 	pubsub                *pubsub.PubSub
+	gossipEventTracer     *gossipEventTracer
 	joinedTopics          map[string]*pubsub.Topic
 	joinedTopicsLock      sync.RWMutex
 	subnetsLock           map[uint64]*sync.RWMutex
@@ -135,6 +136,14 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 
 	s.host = h
 
+	if cfg.GossipTraceFile != "" {
+		s.gossipEventTracer, err = newGossipEventTracer(cfg.GossipTraceFile, cfg.GossipTraceFormat, cfg.GossipTraceMaxSize)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create gossip tracer")
+		}
+		log.WithField("file", cfg.GossipTraceFile).Info("Writing gossip trace")
+	}
+
 	// Gossipsub registration is done before we add in any new peers
 	// due to libp2p's gossipsub implementation not taking into
 	// account previously added peers when creating the gossipsub
@@ -191,6 +200,9 @@ func (s *Service) Start() {
 		}
 	}
 
+	// Dial the peers known from previous runs first, as they are more likely to be useful than the bootnodes.
+	s.restorePeerStore()
+
 	if !s.cfg.NoDiscovery {
 		ipAddr := prysmnetwork.IPAddr()
 		listener, err := s.startDiscoveryV5(
@@ -235,6 +247,11 @@ func (s *Service) Start() {
 	async.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
 	async.RunEvery(s.ctx, time.Duration(params.BeaconConfig().RespTimeout)*time.Second, s.updateMetrics)
 	async.RunEvery(s.ctx, refreshRate, s.RefreshENR)
+	async.RunEvery(s.ctx, peerStoreSaveInterval, func() {
+		if err := s.savePeerStore(); err != nil {
+			log.WithError(err).Error("Could not save peer store")
+		}
+	})
 	async.RunEvery(s.ctx, 1*time.Minute, func() {
 		inboundQUICCount := len(s.peers.InboundConnectedWithProtocol(peers.QUIC))
 		inboundTCPCount := len(s.peers.InboundConnectedWithProtocol(peers.TCP))
@@ -278,10 +295,20 @@ func (s *Service) Start() {
 // Stop the p2p service and terminate all peer connections.
 func (s *Service) Stop() error {
 	defer s.cancel()
+	if s.started {
+		if err := s.savePeerStore(); err != nil {
+			log.WithError(err).Error("Could not save peer store")
+		}
+	}
 	s.started = false
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
 	}
+	if s.gossipEventTracer != nil {
+		if err := s.gossipEventTracer.Close(); err != nil {
+			log.WithError(err).Error("Could not close gossip trace")
+		}
+	}
 	return nil
 }
 
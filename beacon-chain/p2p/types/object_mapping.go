@@ -9,6 +9,7 @@ import (
 	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/metadata"
+	"google.golang.org/protobuf/proto"
 )
 
 func init() {
@@ -29,6 +30,12 @@ var (
 	MetaDataMap             map[[4]byte]func() metadata.Metadata
 	AttestationMap          map[[4]byte]func() (ethpb.Att, error)
 	AggregateAttestationMap map[[4]byte]func() (ethpb.SignedAggregateAttAndProof, error)
+	// LightClientFinalityUpdateMap maps the fork-version to the light client finality update
+	// type of that fork, from Altair on.
+	LightClientFinalityUpdateMap map[[4]byte]func() proto.Message
+	// LightClientOptimisticUpdateMap maps the fork-version to the light client optimistic update
+	// type of that fork, from Altair on.
+	LightClientOptimisticUpdateMap map[[4]byte]func() proto.Message
 )
 
 // InitializeDataMaps initializes all the relevant object maps. This function is called to
@@ -131,4 +138,40 @@ func InitializeDataMaps() {
 			return &ethpb.SignedAggregateAttestationAndProofElectra{}, nil
 		},
 	}
+
+	LightClientFinalityUpdateMap = map[[4]byte]func() proto.Message{
+		bytesutil.ToBytes4(params.BeaconConfig().AltairForkVersion): func() proto.Message {
+			return &ethpb.LightClientFinalityUpdateAltair{}
+		},
+		bytesutil.ToBytes4(params.BeaconConfig().BellatrixForkVersion): func() proto.Message {
+			return &ethpb.LightClientFinalityUpdateAltair{}
+		},
+		bytesutil.ToBytes4(params.BeaconConfig().CapellaForkVersion): func() proto.Message {
+			return &ethpb.LightClientFinalityUpdateCapella{}
+		},
+		bytesutil.ToBytes4(params.BeaconConfig().DenebForkVersion): func() proto.Message {
+			return &ethpb.LightClientFinalityUpdateDeneb{}
+		},
+		bytesutil.ToBytes4(params.BeaconConfig().ElectraForkVersion): func() proto.Message {
+			return &ethpb.LightClientFinalityUpdateElectra{}
+		},
+	}
+
+	LightClientOptimisticUpdateMap = map[[4]byte]func() proto.Message{
+		bytesutil.ToBytes4(params.BeaconConfig().AltairForkVersion): func() proto.Message {
+			return &ethpb.LightClientOptimisticUpdateAltair{}
+		},
+		bytesutil.ToBytes4(params.BeaconConfig().BellatrixForkVersion): func() proto.Message {
+			return &ethpb.LightClientOptimisticUpdateAltair{}
+		},
+		bytesutil.ToBytes4(params.BeaconConfig().CapellaForkVersion): func() proto.Message {
+			return &ethpb.LightClientOptimisticUpdateCapella{}
+		},
+		bytesutil.ToBytes4(params.BeaconConfig().DenebForkVersion): func() proto.Message {
+			return &ethpb.LightClientOptimisticUpdateDeneb{}
+		},
+		bytesutil.ToBytes4(params.BeaconConfig().ElectraForkVersion): func() proto.Message {
+			return &ethpb.LightClientOptimisticUpdateElectra{}
+		},
+	}
 }
@@ -84,6 +84,36 @@ func (r *BeaconBlockByRootsReq) UnmarshalSSZ(buf []byte) error {
 	return nil
 }
 
+// LightClientBootstrapReq specifies the block root of the light client bootstrap request.
+type LightClientBootstrapReq [rootLength]byte
+
+// MarshalSSZTo marshals the light client bootstrap request with the provided byte slice.
+func (r *LightClientBootstrapReq) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, r[:]...), nil
+}
+
+// MarshalSSZ Marshals the light client bootstrap request type into the serialized object.
+func (r *LightClientBootstrapReq) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, rootLength)
+	copy(buf, r[:])
+	return buf, nil
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (r *LightClientBootstrapReq) SizeSSZ() int {
+	return rootLength
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the
+// light client bootstrap request object.
+func (r *LightClientBootstrapReq) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != rootLength {
+		return errors.Wrapf(ssz.ErrIncorrectByteSize, "expected buffer with length of %d but received length %d", rootLength, len(buf))
+	}
+	copy(r[:], buf)
+	return nil
+}
+
 // ErrorMessage describes the error message type.
 type ErrorMessage []byte
 
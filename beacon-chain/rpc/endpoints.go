@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/beacon"
@@ -20,6 +21,8 @@ import (
 	validatorv1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/validator"
 	validatorprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/validator"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	leakybucket "github.com/prysmaticlabs/prysm/v5/container/leaky-bucket"
 )
 
 type endpoint struct {
@@ -50,7 +53,7 @@ func (s *Service) endpoints(
 	endpoints = append(endpoints, s.eventsEndpoints()...)
 	endpoints = append(endpoints, s.prysmBeaconEndpoints(ch, stater)...)
 	endpoints = append(endpoints, s.prysmNodeEndpoints()...)
-	endpoints = append(endpoints, s.prysmValidatorEndpoints(coreService, stater)...)
+	endpoints = append(endpoints, s.prysmValidatorEndpoints(validatorServer, coreService, stater, rewardFetcher)...)
 	if enableDebug {
 		endpoints = append(endpoints, s.debugEndpoints(stater)...)
 	}
@@ -66,6 +69,8 @@ func (s *Service) rewardsEndpoints(blocker lookup.Blocker, stater lookup.Stater,
 		Stater:                stater,
 		HeadFetcher:           s.cfg.HeadFetcher,
 		BlockRewardFetcher:    rewardFetcher,
+		BeaconDB:              s.cfg.BeaconDB,
+		ExecutionEngineCaller: s.cfg.ExecutionEngineCaller,
 	}
 
 	const namespace = "rewards"
@@ -88,6 +93,12 @@ func (s *Service) rewardsEndpoints(blocker lookup.Blocker, stater lookup.Stater,
 			handler:  server.SyncCommitteeRewards,
 			methods:  []string{http.MethodPost},
 		},
+		{
+			template: "/prysm/v1/beacon/rewards/blocks/{block_id}",
+			name:     namespace + ".ProposerRewards",
+			handler:  server.ProposerRewards,
+			methods:  []string{http.MethodGet},
+		},
 	}
 }
 
@@ -580,6 +591,7 @@ func (s *Service) lightClientEndpoints(blocker lookup.Blocker, stater lookup.Sta
 		Blocker:     blocker,
 		Stater:      stater,
 		HeadFetcher: s.cfg.HeadFetcher,
+		BeaconDB:    s.cfg.BeaconDB,
 	}
 
 	const namespace = "lightclient"
@@ -643,6 +655,12 @@ func (s *Service) debugEndpoints(stater lookup.Stater) []endpoint {
 			handler:  server.GetForkChoice,
 			methods:  []string{http.MethodGet},
 		},
+		{
+			template: "/prysm/v1/debug/fork_choice/dot",
+			name:     namespace + ".GetForkChoiceDOT",
+			handler:  server.GetForkChoiceDOT,
+			methods:  []string{http.MethodGet},
+		},
 	}
 }
 
@@ -754,12 +772,44 @@ func (s *Service) prysmNodeEndpoints() []endpoint {
 			handler:  server.RemoveTrustedPeer,
 			methods:  []string{http.MethodDelete},
 		},
+		{
+			template: "/prysm/v1/node/peers/scores",
+			name:     namespace + ".ListPeerScores",
+			handler:  server.ListPeerScores,
+			methods:  []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/peers/{peer_id}/ban",
+			name:     namespace + ".BanPeer",
+			handler:  server.BanPeer,
+			methods:  []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/node/peers/{peer_id}/ban",
+			name:     namespace + ".UnbanPeer",
+			handler:  server.UnbanPeer,
+			methods:  []string{http.MethodDelete},
+		},
 	}
 }
 
-func (s *Service) prysmValidatorEndpoints(coreService *core.Service, stater lookup.Stater) []endpoint {
+func (s *Service) prysmValidatorEndpoints(
+	validatorServer *validatorv1alpha1.Server,
+	coreService *core.Service,
+	stater lookup.Stater,
+	rewardFetcher rewards.BlockRewardsFetcher,
+) []endpoint {
+	// A block can be simulated once per slot, as each simulation starts a payload build on the execution client.
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
 	server := &validatorprysm.Server{
-		CoreService: coreService,
+		CoreService:           coreService,
+		HeadFetcher:           s.cfg.HeadFetcher,
+		TimeFetcher:           s.cfg.GenesisTimeFetcher,
+		SyncChecker:           s.cfg.SyncService,
+		OptimisticModeFetcher: s.cfg.OptimisticModeFetcher,
+		BlockSimulator:        validatorServer,
+		BlockRewardFetcher:    rewardFetcher,
+		SimulationLimiter:     leakybucket.NewCollector(1, 1, slotDuration, false /* deleteEmptyBuckets */),
 	}
 
 	const namespace = "prysm.validator"
@@ -776,5 +826,11 @@ func (s *Service) prysmValidatorEndpoints(coreService *core.Service, stater look
 			handler:  server.GetValidatorPerformance,
 			methods:  []string{http.MethodPost},
 		},
+		{
+			template: "/prysm/v1/validator/blocks/{slot}/simulate",
+			name:     namespace + ".SimulateBlock",
+			handler:  server.SimulateBlock,
+			methods:  []string{http.MethodGet},
+		},
 	}
 }
@@ -12,6 +12,7 @@ func Test_endpoints(t *testing.T) {
 		"/eth/v1/beacon/rewards/blocks/{block_id}":         {http.MethodGet},
 		"/eth/v1/beacon/rewards/attestations/{epoch}":      {http.MethodPost},
 		"/eth/v1/beacon/rewards/sync_committee/{block_id}": {http.MethodPost},
+		"/prysm/v1/beacon/rewards/blocks/{block_id}":       {http.MethodGet},
 	}
 
 	beaconRoutes := map[string][]string{
@@ -72,6 +73,7 @@ func Test_endpoints(t *testing.T) {
 		"/eth/v2/debug/beacon/states/{state_id}": {http.MethodGet},
 		"/eth/v2/debug/beacon/heads":             {http.MethodGet},
 		"/eth/v1/debug/fork_choice":              {http.MethodGet},
+		"/prysm/v1/debug/fork_choice/dot":        {http.MethodGet},
 	}
 
 	eventsRoutes := map[string][]string{
@@ -120,11 +122,14 @@ func Test_endpoints(t *testing.T) {
 		"/prysm/v1/node/trusted_peers":           {http.MethodGet, http.MethodPost},
 		"/prysm/node/trusted_peers/{peer_id}":    {http.MethodDelete},
 		"/prysm/v1/node/trusted_peers/{peer_id}": {http.MethodDelete},
+		"/prysm/v1/node/peers/scores":            {http.MethodGet},
+		"/prysm/v1/node/peers/{peer_id}/ban":     {http.MethodPost, http.MethodDelete},
 	}
 
 	prysmValidatorRoutes := map[string][]string{
-		"/prysm/validators/performance":    {http.MethodPost},
-		"/prysm/v1/validators/performance": {http.MethodPost},
+		"/prysm/validators/performance":              {http.MethodPost},
+		"/prysm/v1/validators/performance":           {http.MethodPost},
+		"/prysm/v1/validator/blocks/{slot}/simulate": {http.MethodGet},
 	}
 
 	s := &Service{cfg: &Config{}}
@@ -30,6 +30,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
@@ -117,9 +118,11 @@ func (s *Server) getBlockV2Ssz(w http.ResponseWriter, blk interfaces.ReadOnlySig
 	result, err := s.getBlockResponseBodySsz(blk)
 	if err != nil {
 		httputil.HandleError(w, "Could not get signed beacon block: "+err.Error(), http.StatusInternalServerError)
+		return
 	}
 	if result == nil {
 		httputil.HandleError(w, fmt.Sprintf("Unknown block type %T", blk), http.StatusInternalServerError)
+		return
 	}
 	w.Header().Set(api.VersionHeader, version.String(blk.Version()))
 	httputil.WriteSsz(w, result, "beacon_block.ssz")
@@ -150,6 +153,7 @@ func (s *Server) getBlockV2Json(ctx context.Context, w http.ResponseWriter, blk
 	result, err := s.getBlockResponseBodyJson(ctx, blk)
 	if err != nil {
 		httputil.HandleError(w, "Error processing request: "+err.Error(), http.StatusInternalServerError)
+		return
 	}
 	w.Header().Set(api.VersionHeader, result.Version)
 	httputil.WriteJson(w, result)
@@ -207,10 +211,12 @@ func (s *Server) GetBlockAttestations(w http.ResponseWriter, r *http.Request) {
 
 	consensusAtts := blk.Block().Body().Attestations()
 	atts := make([]*structs.Attestation, len(consensusAtts))
+	sszAtts := make([]*eth.Attestation, len(consensusAtts))
 	for i, att := range consensusAtts {
 		a, ok := att.(*eth.Attestation)
 		if ok {
 			atts[i] = structs.AttFromConsensus(a)
+			sszAtts[i] = a
 		} else {
 			httputil.HandleError(w, fmt.Sprintf("unable to convert consensus attestations of type %T", att), http.StatusInternalServerError)
 			return
@@ -232,7 +238,7 @@ func (s *Server) GetBlockAttestations(w http.ResponseWriter, r *http.Request) {
 		ExecutionOptimistic: isOptimistic,
 		Finalized:           s.FinalizationFetcher.IsFinalized(ctx, root),
 	}
-	httputil.WriteJson(w, resp)
+	httputil.WriteResponse(w, r, resp, httputil.SszList(sszAtts, true), "attestations.ssz")
 }
 
 // PublishBlindedBlock instructs the beacon node to use the components of the `SignedBlindedBeaconBlock` to construct
@@ -289,6 +295,7 @@ func (s *Server) publishBlindedBlockSSZ(ctx context.Context, w http.ResponseWrit
 	versionHeader := r.Header.Get(api.VersionHeader)
 	if versionRequired && versionHeader == "" {
 		httputil.HandleError(w, api.VersionHeader+" header is required", http.StatusBadRequest)
+		return
 	}
 
 	denebBlock := &eth.SignedBlindedBeaconBlockDeneb{}
@@ -418,6 +425,7 @@ func (s *Server) publishBlindedBlock(ctx context.Context, w http.ResponseWriter,
 	versionHeader := r.Header.Get(api.VersionHeader)
 	if versionRequired && versionHeader == "" {
 		httputil.HandleError(w, api.VersionHeader+" header is required", http.StatusBadRequest)
+		return
 	}
 
 	var consensusBlock *eth.GenericSignedBeaconBlock
@@ -1117,6 +1125,7 @@ func (s *Server) GetCommittees(w http.ResponseWriter, r *http.Request) {
 	}
 	committeesPerSlot := corehelpers.SlotCommitteeCount(activeCount)
 	committees := make([]*structs.Committee, 0)
+	sszCommittees := make([]*ethpbv1.Committee, 0)
 	for slot := startSlot; slot <= endSlot; slot++ {
 		if rawSlot != "" && slot != primitives.Slot(sl) {
 			continue
@@ -1140,6 +1149,11 @@ func (s *Server) GetCommittees(w http.ResponseWriter, r *http.Request) {
 				Validators: validators,
 			}
 			committees = append(committees, committeeContainer)
+			sszValidators := make([]uint64, len(committee))
+			for j, v := range committee {
+				sszValidators[j] = uint64(v)
+			}
+			sszCommittees = append(sszCommittees, &ethpbv1.Committee{Index: index, Slot: slot, Validators: sszValidators})
 		}
 	}
 
@@ -1155,7 +1169,8 @@ func (s *Server) GetCommittees(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	isFinalized := s.FinalizationFetcher.IsFinalized(ctx, blockRoot)
-	httputil.WriteJson(w, &structs.GetCommitteesResponse{Data: committees, ExecutionOptimistic: isOptimistic, Finalized: isFinalized})
+	resp := &structs.GetCommitteesResponse{Data: committees, ExecutionOptimistic: isOptimistic, Finalized: isFinalized}
+	httputil.WriteResponse(w, r, resp, httputil.SszList(sszCommittees, true), "committees.ssz")
 }
 
 // GetBlockHeaders retrieves block headers matching given query. By default it will fetch current head slot blocks.
@@ -1206,6 +1221,7 @@ func (s *Server) GetBlockHeaders(w http.ResponseWriter, r *http.Request) {
 	isOptimistic := false
 	isFinalized := true
 	blkHdrs := make([]*structs.SignedBeaconBlockHeaderContainer, len(blks))
+	sszHdrs := make([]*eth.SignedBeaconBlockHeader, len(blks))
 	for i, bl := range blks {
 		v1alpha1Header, err := bl.Header()
 		if err != nil {
@@ -1240,6 +1256,7 @@ func (s *Server) GetBlockHeaders(w http.ResponseWriter, r *http.Request) {
 			Root:      hexutil.Encode(headerRoot[:]),
 			Canonical: canonical,
 		}
+		sszHdrs[i] = v1alpha1Header
 	}
 
 	response := &structs.GetBlockHeadersResponse{
@@ -1247,7 +1264,7 @@ func (s *Server) GetBlockHeaders(w http.ResponseWriter, r *http.Request) {
 		ExecutionOptimistic: isOptimistic,
 		Finalized:           isFinalized,
 	}
-	httputil.WriteJson(w, response)
+	httputil.WriteResponse(w, r, response, httputil.SszList(sszHdrs, false), "block_headers.ssz")
 }
 
 // GetBlockHeader retrieves block header for given block id.
@@ -1304,7 +1321,7 @@ func (s *Server) GetBlockHeader(w http.ResponseWriter, r *http.Request) {
 		ExecutionOptimistic: isOptimistic,
 		Finalized:           s.FinalizationFetcher.IsFinalized(ctx, blkRoot),
 	}
-	httputil.WriteJson(w, resp)
+	httputil.WriteResponse(w, r, resp, blockHeader, "block_header.ssz")
 }
 
 // GetFinalityCheckpoints returns finality checkpoints for state with given 'stateId'. In case finality is
@@ -57,21 +57,24 @@ func (s *Server) ListAttestations(w http.ResponseWriter, r *http.Request) {
 	isEmptyReq := rawSlot == "" && rawCommitteeIndex == ""
 	if isEmptyReq {
 		allAtts := make([]*structs.Attestation, len(attestations))
+		sszAtts := make([]*eth.Attestation, len(attestations))
 		for i, att := range attestations {
 			a, ok := att.(*eth.Attestation)
 			if ok {
 				allAtts[i] = structs.AttFromConsensus(a)
+				sszAtts[i] = a
 			} else {
 				httputil.HandleError(w, fmt.Sprintf("unable to convert attestations of type %T", att), http.StatusInternalServerError)
 				return
 			}
 		}
-		httputil.WriteJson(w, &structs.ListAttestationsResponse{Data: allAtts})
+		httputil.WriteResponse(w, r, &structs.ListAttestationsResponse{Data: allAtts}, httputil.SszList(sszAtts, true), "attestations.ssz")
 		return
 	}
 
 	bothDefined := rawSlot != "" && rawCommitteeIndex != ""
 	filteredAtts := make([]*structs.Attestation, 0, len(attestations))
+	sszAtts := make([]*eth.Attestation, 0, len(attestations))
 	for _, att := range attestations {
 		committeeIndexMatch := rawCommitteeIndex != "" && att.GetData().CommitteeIndex == primitives.CommitteeIndex(committeeIndex)
 		slotMatch := rawSlot != "" && att.GetData().Slot == primitives.Slot(slot)
@@ -80,13 +83,14 @@ func (s *Server) ListAttestations(w http.ResponseWriter, r *http.Request) {
 			a, ok := att.(*eth.Attestation)
 			if ok {
 				filteredAtts = append(filteredAtts, structs.AttFromConsensus(a))
+				sszAtts = append(sszAtts, a)
 			} else {
 				httputil.HandleError(w, fmt.Sprintf("unable to convert attestations of type %T", att), http.StatusInternalServerError)
 				return
 			}
 		}
 	}
-	httputil.WriteJson(w, &structs.ListAttestationsResponse{Data: filteredAtts})
+	httputil.WriteResponse(w, r, &structs.ListAttestationsResponse{Data: filteredAtts}, httputil.SszList(sszAtts, true), "attestations.ssz")
 }
 
 // SubmitAttestations submits an attestation object to node. If the attestation passes all validation
@@ -95,33 +99,50 @@ func (s *Server) SubmitAttestations(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitAttestations")
 	defer span.End()
 
-	var req structs.SubmitAttestationsRequest
-	err := json.NewDecoder(r.Body).Decode(&req.Data)
-	switch {
-	case err == io.EOF:
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
-	case err != nil:
-		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
-		return
-	}
-	if len(req.Data) == 0 {
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
+	var atts []*eth.Attestation
+	var attFailures []*server.IndexedVerificationFailure
+	if httputil.IsRequestSsz(r) {
+		var ok bool
+		atts, ok = httputil.ReadSszListRequest(w, r, 0, func() *eth.Attestation { return &eth.Attestation{} })
+		if !ok {
+			return
+		}
+	} else {
+		var req structs.SubmitAttestationsRequest
+		err := json.NewDecoder(r.Body).Decode(&req.Data)
+		switch {
+		case err == io.EOF:
+			httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+			return
+		case err != nil:
+			httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(req.Data) == 0 {
+			httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+			return
+		}
+		// Attestations that can't be converted are left nil, so that failures keep the index of the attestation.
+		atts = make([]*eth.Attestation, len(req.Data))
+		for i, sourceAtt := range req.Data {
+			att, err := sourceAtt.ToConsensus()
+			if err != nil {
+				attFailures = append(attFailures, &server.IndexedVerificationFailure{
+					Index:   i,
+					Message: "Could not convert request attestation to consensus attestation: " + err.Error(),
+				})
+				continue
+			}
+			atts[i] = att
+		}
 	}
 
 	var validAttestations []*eth.Attestation
-	var attFailures []*server.IndexedVerificationFailure
-	for i, sourceAtt := range req.Data {
-		att, err := sourceAtt.ToConsensus()
-		if err != nil {
-			attFailures = append(attFailures, &server.IndexedVerificationFailure{
-				Index:   i,
-				Message: "Could not convert request attestation to consensus attestation: " + err.Error(),
-			})
+	for i, att := range atts {
+		if att == nil {
 			continue
 		}
-		if _, err = bls.SignatureFromBytes(att.Signature); err != nil {
+		if _, err := bls.SignatureFromBytes(att.Signature); err != nil {
 			attFailures = append(attFailures, &server.IndexedVerificationFailure{
 				Index:   i,
 				Message: "Incorrect attestation signature: " + err.Error(),
@@ -204,7 +225,7 @@ func (s *Server) ListVoluntaryExits(w http.ResponseWriter, r *http.Request) {
 		exits[i] = structs.SignedExitFromConsensus(e)
 	}
 
-	httputil.WriteJson(w, &structs.ListVoluntaryExitsResponse{Data: exits})
+	httputil.WriteResponse(w, r, &structs.ListVoluntaryExitsResponse{Data: exits}, httputil.SszList(sourceExits, false), "voluntary_exits.ssz")
 }
 
 // SubmitVoluntaryExit submits a SignedVoluntaryExit object to node's pool
@@ -213,21 +234,27 @@ func (s *Server) SubmitVoluntaryExit(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitVoluntaryExit")
 	defer span.End()
 
-	var req structs.SignedVoluntaryExit
-	err := json.NewDecoder(r.Body).Decode(&req)
-	switch {
-	case err == io.EOF:
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
-	case err != nil:
-		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
-		return
-	}
-
-	exit, err := req.ToConsensus()
-	if err != nil {
-		httputil.HandleError(w, "Could not convert request exit to consensus exit: "+err.Error(), http.StatusBadRequest)
-		return
+	exit := &eth.SignedVoluntaryExit{}
+	if httputil.IsRequestSsz(r) {
+		if !httputil.ReadSszRequest(w, r, exit) {
+			return
+		}
+	} else {
+		var req structs.SignedVoluntaryExit
+		err := json.NewDecoder(r.Body).Decode(&req)
+		switch {
+		case err == io.EOF:
+			httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+			return
+		case err != nil:
+			httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		exit, err = req.ToConsensus()
+		if err != nil {
+			httputil.HandleError(w, "Could not convert request exit to consensus exit: "+err.Error(), http.StatusBadRequest)
+			return
+		}
 	}
 
 	headState, err := s.ChainInfoFetcher.HeadState(ctx)
@@ -271,33 +298,42 @@ func (s *Server) SubmitSyncCommitteeSignatures(w http.ResponseWriter, r *http.Re
 	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitPoolSyncCommitteeSignatures")
 	defer span.End()
 
-	var req structs.SubmitSyncCommitteeSignaturesRequest
-	err := json.NewDecoder(r.Body).Decode(&req.Data)
-	switch {
-	case err == io.EOF:
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
-	case err != nil:
-		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
-		return
-	}
-	if len(req.Data) == 0 {
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
-	}
-
 	var validMessages []*eth.SyncCommitteeMessage
 	var msgFailures []*server.IndexedVerificationFailure
-	for i, sourceMsg := range req.Data {
-		msg, err := sourceMsg.ToConsensus()
-		if err != nil {
-			msgFailures = append(msgFailures, &server.IndexedVerificationFailure{
-				Index:   i,
-				Message: "Could not convert request message to consensus message: " + err.Error(),
-			})
-			continue
+	if httputil.IsRequestSsz(r) {
+		var ok bool
+		validMessages, ok = httputil.ReadSszListRequest(w, r, (&eth.SyncCommitteeMessage{}).SizeSSZ(), func() *eth.SyncCommitteeMessage {
+			return &eth.SyncCommitteeMessage{}
+		})
+		if !ok {
+			return
+		}
+	} else {
+		var req structs.SubmitSyncCommitteeSignaturesRequest
+		err := json.NewDecoder(r.Body).Decode(&req.Data)
+		switch {
+		case err == io.EOF:
+			httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+			return
+		case err != nil:
+			httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(req.Data) == 0 {
+			httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+			return
+		}
+		for i, sourceMsg := range req.Data {
+			msg, err := sourceMsg.ToConsensus()
+			if err != nil {
+				msgFailures = append(msgFailures, &server.IndexedVerificationFailure{
+					Index:   i,
+					Message: "Could not convert request message to consensus message: " + err.Error(),
+				})
+				continue
+			}
+			validMessages = append(validMessages, msg)
 		}
-		validMessages = append(validMessages, msg)
 	}
 
 	for _, msg := range validMessages {
@@ -330,28 +366,47 @@ func (s *Server) SubmitBLSToExecutionChanges(w http.ResponseWriter, r *http.Requ
 	var failures []*server.IndexedVerificationFailure
 	var toBroadcast []*eth.SignedBLSToExecutionChange
 
-	var req []*structs.SignedBLSToExecutionChange
-	err = json.NewDecoder(r.Body).Decode(&req)
-	switch {
-	case err == io.EOF:
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
-	case err != nil:
-		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
-		return
-	}
-	if len(req) == 0 {
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
+	var changes []*eth.SignedBLSToExecutionChange
+	if httputil.IsRequestSsz(r) {
+		var ok bool
+		changes, ok = httputil.ReadSszListRequest(w, r, (&eth.SignedBLSToExecutionChange{}).SizeSSZ(), func() *eth.SignedBLSToExecutionChange {
+			return &eth.SignedBLSToExecutionChange{}
+		})
+		if !ok {
+			return
+		}
+	} else {
+		var req []*structs.SignedBLSToExecutionChange
+		err = json.NewDecoder(r.Body).Decode(&req)
+		switch {
+		case err == io.EOF:
+			httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+			return
+		case err != nil:
+			httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(req) == 0 {
+			httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+			return
+		}
+		// Changes that can't be converted are left nil, so that failures keep the index of the change.
+		changes = make([]*eth.SignedBLSToExecutionChange, len(req))
+		for i, change := range req {
+			sbls, err := change.ToConsensus()
+			if err != nil {
+				failures = append(failures, &server.IndexedVerificationFailure{
+					Index:   i,
+					Message: "Unable to decode SignedBLSToExecutionChange: " + err.Error(),
+				})
+				continue
+			}
+			changes[i] = sbls
+		}
 	}
 
-	for i, change := range req {
-		sbls, err := change.ToConsensus()
-		if err != nil {
-			failures = append(failures, &server.IndexedVerificationFailure{
-				Index:   i,
-				Message: "Unable to decode SignedBLSToExecutionChange: " + err.Error(),
-			})
+	for i, sbls := range changes {
+		if sbls == nil {
 			continue
 		}
 		_, err = blocks.ValidateBLSToExecutionChange(st, sbls)
@@ -450,9 +505,10 @@ func (s *Server) ListBLSToExecutionChanges(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	httputil.WriteJson(w, &structs.BLSToExecutionChangesPoolResponse{
+	resp := &structs.BLSToExecutionChangesPoolResponse{
 		Data: structs.SignedBLSChangesFromConsensus(sourceChanges),
-	})
+	}
+	httputil.WriteResponse(w, r, resp, httputil.SszList(sourceChanges, false), "bls_to_execution_changes.ssz")
 }
 
 // GetAttesterSlashings retrieves attester slashings known by the node but
@@ -480,7 +536,7 @@ func (s *Server) GetAttesterSlashings(w http.ResponseWriter, r *http.Request) {
 	}
 	slashings := structs.AttesterSlashingsFromConsensus(ss)
 
-	httputil.WriteJson(w, &structs.GetAttesterSlashingsResponse{Data: slashings})
+	httputil.WriteResponse(w, r, &structs.GetAttesterSlashingsResponse{Data: slashings}, httputil.SszList(ss, true), "attester_slashings.ssz")
 }
 
 // SubmitAttesterSlashing submits an attester slashing object to node's pool and
@@ -489,21 +545,27 @@ func (s *Server) SubmitAttesterSlashing(w http.ResponseWriter, r *http.Request)
 	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitAttesterSlashing")
 	defer span.End()
 
-	var req structs.AttesterSlashing
-	err := json.NewDecoder(r.Body).Decode(&req)
-	switch {
-	case err == io.EOF:
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
-	case err != nil:
-		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
-		return
-	}
-
-	slashing, err := req.ToConsensus()
-	if err != nil {
-		httputil.HandleError(w, "Could not convert request slashing to consensus slashing: "+err.Error(), http.StatusBadRequest)
-		return
+	slashing := &eth.AttesterSlashing{}
+	if httputil.IsRequestSsz(r) {
+		if !httputil.ReadSszRequest(w, r, slashing) {
+			return
+		}
+	} else {
+		var req structs.AttesterSlashing
+		err := json.NewDecoder(r.Body).Decode(&req)
+		switch {
+		case err == io.EOF:
+			httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+			return
+		case err != nil:
+			httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		slashing, err = req.ToConsensus()
+		if err != nil {
+			httputil.HandleError(w, "Could not convert request slashing to consensus slashing: "+err.Error(), http.StatusBadRequest)
+			return
+		}
 	}
 	headState, err := s.ChainInfoFetcher.HeadState(ctx)
 	if err != nil {
@@ -554,7 +616,7 @@ func (s *Server) GetProposerSlashings(w http.ResponseWriter, r *http.Request) {
 	sourceSlashings := s.SlashingsPool.PendingProposerSlashings(ctx, headState, true /* return unlimited slashings */)
 	slashings := structs.ProposerSlashingsFromConsensus(sourceSlashings)
 
-	httputil.WriteJson(w, &structs.GetProposerSlashingsResponse{Data: slashings})
+	httputil.WriteResponse(w, r, &structs.GetProposerSlashingsResponse{Data: slashings}, httputil.SszList(sourceSlashings, false), "proposer_slashings.ssz")
 }
 
 // SubmitProposerSlashing submits a proposer slashing object to node's pool and if
@@ -563,21 +625,27 @@ func (s *Server) SubmitProposerSlashing(w http.ResponseWriter, r *http.Request)
 	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitProposerSlashing")
 	defer span.End()
 
-	var req structs.ProposerSlashing
-	err := json.NewDecoder(r.Body).Decode(&req)
-	switch {
-	case err == io.EOF:
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
-	case err != nil:
-		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
-		return
-	}
-
-	slashing, err := req.ToConsensus()
-	if err != nil {
-		httputil.HandleError(w, "Could not convert request slashing to consensus slashing: "+err.Error(), http.StatusBadRequest)
-		return
+	slashing := &eth.ProposerSlashing{}
+	if httputil.IsRequestSsz(r) {
+		if !httputil.ReadSszRequest(w, r, slashing) {
+			return
+		}
+	} else {
+		var req structs.ProposerSlashing
+		err := json.NewDecoder(r.Body).Decode(&req)
+		switch {
+		case err == io.EOF:
+			httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+			return
+		case err != nil:
+			httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		slashing, err = req.ToConsensus()
+		if err != nil {
+			httputil.HandleError(w, "Could not convert request slashing to consensus slashing: "+err.Error(), http.StatusBadRequest)
+			return
+		}
 	}
 	headState, err := s.ChainInfoFetcher.HeadState(ctx)
 	if err != nil {
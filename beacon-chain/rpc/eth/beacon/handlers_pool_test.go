@@ -13,6 +13,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	blockchainmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
@@ -184,6 +185,22 @@ func TestListAttestations(t *testing.T) {
 			assert.Equal(t, "4", a.Data.CommitteeIndex)
 		}
 	})
+	t.Run("ssz", func(t *testing.T) {
+		url := "http://example.com?slot=2&committee_index=4"
+		request := httptest.NewRequest(http.MethodGet, url, nil)
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListAttestations(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		atts, err := httputil.UnmarshalSszList(writer.Body.Bytes(), 0, func() *ethpbv1alpha1.Attestation {
+			return &ethpbv1alpha1.Attestation{}
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(atts))
+		assert.DeepEqual(t, att4, atts[0])
+	})
 }
 
 func TestSubmitAttestations(t *testing.T) {
@@ -269,6 +286,42 @@ func TestSubmitAttestations(t *testing.T) {
 		assert.Equal(t, 2, broadcaster.NumAttestations())
 		assert.Equal(t, 2, s.AttestationsPool.UnaggregatedAttestationCount())
 	})
+	t.Run("ssz", func(t *testing.T) {
+		broadcaster := &p2pMock.MockBroadcaster{}
+		s.Broadcaster = broadcaster
+		s.AttestationsPool = attestations.NewPool()
+
+		var req []*structs.Attestation
+		require.NoError(t, json.Unmarshal([]byte(multipleAtts), &req))
+		atts := make([]*ethpbv1alpha1.Attestation, len(req))
+		for i, a := range req {
+			atts[i], err = a.ToConsensus()
+			require.NoError(t, err)
+		}
+		sszAtts, err := httputil.SszList(atts, true).MarshalSSZ()
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader(sszAtts))
+		request.Header.Set("Content-Type", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitAttestations(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, 2, broadcaster.NumAttestations())
+		assert.Equal(t, 2, s.AttestationsPool.UnaggregatedAttestationCount())
+	})
+	t.Run("ssz invalid", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader([]byte{0x01, 0x02, 0x03}))
+		request.Header.Set("Content-Type", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitAttestations(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, true, strings.Contains(e.Message, "Could not decode request body into SSZ"))
+	})
 	t.Run("no body", func(t *testing.T) {
 		request := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
 		writer := httptest.NewRecorder()
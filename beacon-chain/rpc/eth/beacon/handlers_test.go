@@ -3282,6 +3282,22 @@ func TestServer_GetBlockHeader(t *testing.T) {
 		assert.Equal(t, "0xdd32cbaa01c6c0ef399b293f86884ce6a15b532d34682edb16a48fa70ea5bc79", resp.Data.Header.Message.BodyRoot)
 		assert.Equal(t, "0x7374617465726f6f740000000000000000000000000000000000000000000000", resp.Data.Header.Message.StateRoot)
 	})
+	t.Run("ssz", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/headers/{block_id}", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_id": "head"})
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBlockHeader(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, api.OctetStreamMediaType, writer.Header().Get("Content-Type"))
+		header := &eth.SignedBeaconBlockHeader{}
+		require.NoError(t, header.UnmarshalSSZ(writer.Body.Bytes()))
+		assert.Equal(t, primitives.Slot(123), header.Header.Slot)
+		assert.Equal(t, primitives.ValidatorIndex(123), header.Header.ProposerIndex)
+		assert.DeepEqual(t, bytesutil.PadTo([]byte("sig"), 96), header.Signature)
+	})
 	t.Run("missing block_id", func(t *testing.T) {
 		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/headers/{block_id}", nil)
 		writer := httptest.NewRecorder()
@@ -10,6 +10,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
@@ -20,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"go.opencensus.io/trace"
 )
@@ -89,7 +91,7 @@ func (s *Server) GetValidators(w http.ResponseWriter, r *http.Request) {
 			ExecutionOptimistic: isOptimistic,
 			Finalized:           isFinalized,
 		}
-		httputil.WriteJson(w, resp)
+		httputil.WriteResponse(w, r, resp, validatorContainersSsz(resp.Data), "validators.ssz")
 		return
 	}
 
@@ -124,7 +126,7 @@ func (s *Server) GetValidators(w http.ResponseWriter, r *http.Request) {
 			ExecutionOptimistic: isOptimistic,
 			Finalized:           isFinalized,
 		}
-		httputil.WriteJson(w, resp)
+		httputil.WriteResponse(w, r, resp, validatorContainersSsz(resp.Data), "validators.ssz")
 		return
 	}
 
@@ -170,7 +172,7 @@ func (s *Server) GetValidators(w http.ResponseWriter, r *http.Request) {
 		ExecutionOptimistic: isOptimistic,
 		Finalized:           isFinalized,
 	}
-	httputil.WriteJson(w, resp)
+	httputil.WriteResponse(w, r, resp, validatorContainersSsz(resp.Data), "validators.ssz")
 }
 
 // GetValidator returns a validator specified by state and id or public key along with status and balance.
@@ -235,7 +237,7 @@ func (s *Server) GetValidator(w http.ResponseWriter, r *http.Request) {
 		ExecutionOptimistic: isOptimistic,
 		Finalized:           isFinalized,
 	}
-	httputil.WriteJson(w, resp)
+	httputil.WriteResponse(w, r, resp, validatorContainerSsz{container}, "validator.ssz")
 }
 
 // GetValidatorBalances returns a filterable list of validator balances.
@@ -292,7 +294,7 @@ func (s *Server) GetValidatorBalances(w http.ResponseWriter, r *http.Request) {
 			ExecutionOptimistic: isOptimistic,
 			Finalized:           isFinalized,
 		}
-		httputil.WriteJson(w, resp)
+		httputil.WriteResponse(w, r, resp, validatorBalancesSsz(resp.Data), "validator_balances.ssz")
 		return
 	}
 
@@ -321,7 +323,7 @@ func (s *Server) GetValidatorBalances(w http.ResponseWriter, r *http.Request) {
 		ExecutionOptimistic: isOptimistic,
 		Finalized:           isFinalized,
 	}
-	httputil.WriteJson(w, resp)
+	httputil.WriteResponse(w, r, resp, validatorBalancesSsz(resp.Data), "validator_balances.ssz")
 }
 
 // decodeIds takes in a list of validator ID strings (as either a pubkey or a validator index)
@@ -414,3 +416,103 @@ func valContainerFromReadOnlyVal(
 		},
 	}
 }
+
+// validatorContainersSsz SSZ encodes validator containers as a list of ethpbv1.ValidatorContainer. The containers are
+// only converted if the response is SSZ encoded, which keeps JSON responses for the whole registry cheap.
+type validatorContainersSsz []*structs.ValidatorContainer
+
+// MarshalSSZ returns the SSZ encoding of the validator containers.
+func (c validatorContainersSsz) MarshalSSZ() ([]byte, error) {
+	containers := make([]*ethpbv1.ValidatorContainer, len(c))
+	for i, container := range c {
+		var err error
+		if containers[i], err = validatorContainerToProto(container); err != nil {
+			return nil, err
+		}
+	}
+	return httputil.SszList(containers, false).MarshalSSZ()
+}
+
+// validatorContainerSsz SSZ encodes a single validator container as an ethpbv1.ValidatorContainer.
+type validatorContainerSsz struct {
+	container *structs.ValidatorContainer
+}
+
+// MarshalSSZ returns the SSZ encoding of the validator container.
+func (c validatorContainerSsz) MarshalSSZ() ([]byte, error) {
+	container, err := validatorContainerToProto(c.container)
+	if err != nil {
+		return nil, err
+	}
+	return container.MarshalSSZ()
+}
+
+// validatorBalancesSsz SSZ encodes validator balances as a list of ethpbv1.ValidatorBalance.
+type validatorBalancesSsz []*structs.ValidatorBalance
+
+// MarshalSSZ returns the SSZ encoding of the validator balances.
+func (b validatorBalancesSsz) MarshalSSZ() ([]byte, error) {
+	balances := make([]*ethpbv1.ValidatorBalance, len(b))
+	for i, bal := range b {
+		index, err := strconv.ParseUint(bal.Index, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid validator index %s", bal.Index)
+		}
+		balance, err := strconv.ParseUint(bal.Balance, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid balance %s", bal.Balance)
+		}
+		balances[i] = &ethpbv1.ValidatorBalance{Index: primitives.ValidatorIndex(index), Balance: balance}
+	}
+	return httputil.SszList(balances, false).MarshalSSZ()
+}
+
+func validatorContainerToProto(c *structs.ValidatorContainer) (*ethpbv1.ValidatorContainer, error) {
+	index, err := strconv.ParseUint(c.Index, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid validator index %s", c.Index)
+	}
+	balance, err := strconv.ParseUint(c.Balance, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid balance %s", c.Balance)
+	}
+	ok, status := validator.StatusFromString(c.Status)
+	if !ok {
+		return nil, fmt.Errorf("invalid status %s", c.Status)
+	}
+	v := c.Validator
+	pubkey, err := hexutil.Decode(v.Pubkey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid pubkey %s", v.Pubkey)
+	}
+	withdrawalCredentials, err := hexutil.Decode(v.WithdrawalCredentials)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid withdrawal credentials %s", v.WithdrawalCredentials)
+	}
+	effectiveBalance, err := strconv.ParseUint(v.EffectiveBalance, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid effective balance %s", v.EffectiveBalance)
+	}
+	var epochs [4]uint64
+	for i, epoch := range []string{v.ActivationEligibilityEpoch, v.ActivationEpoch, v.ExitEpoch, v.WithdrawableEpoch} {
+		if epochs[i], err = strconv.ParseUint(epoch, 10, 64); err != nil {
+			return nil, errors.Wrapf(err, "invalid epoch %s", epoch)
+		}
+	}
+	return &ethpbv1.ValidatorContainer{
+		Index:   primitives.ValidatorIndex(index),
+		Balance: balance,
+		// validator.Status values match ethpbv1.ValidatorStatus values.
+		Status: uint64(status),
+		Validator: &ethpbv1.Validator{
+			Pubkey:                     pubkey,
+			WithdrawalCredentials:      withdrawalCredentials,
+			EffectiveBalance:           effectiveBalance,
+			Slashed:                    v.Slashed,
+			ActivationEligibilityEpoch: primitives.Epoch(epochs[0]),
+			ActivationEpoch:            primitives.Epoch(epochs[1]),
+			ExitEpoch:                  primitives.Epoch(epochs[2]),
+			WithdrawableEpoch:          primitives.Epoch(epochs[3]),
+		},
+	}, nil
+}
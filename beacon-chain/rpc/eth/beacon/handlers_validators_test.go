@@ -12,6 +12,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	chainMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
@@ -21,6 +22,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -70,6 +72,36 @@ func TestGetValidators(t *testing.T) {
 		assert.Equal(t, "18446744073709551615", val.Validator.ExitEpoch)
 		assert.Equal(t, "18446744073709551615", val.Validator.WithdrawableEpoch)
 	})
+	t.Run("ssz", func(t *testing.T) {
+		chainService := &chainMock.ChainService{}
+		s := Server{
+			Stater: &testutil.MockStater{
+				BeaconState: st,
+			},
+			HeadFetcher:           chainService,
+			OptimisticModeFetcher: chainService,
+			FinalizationFetcher:   chainService,
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/validators", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidators(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		containers, err := httputil.UnmarshalSszList(writer.Body.Bytes(), (&ethpbv1.ValidatorContainer{}).SizeSSZ(), func() *ethpbv1.ValidatorContainer {
+			return &ethpbv1.ValidatorContainer{}
+		})
+		require.NoError(t, err)
+		require.Equal(t, 4, len(containers))
+		assert.Equal(t, primitives.ValidatorIndex(exitedValIndex), containers[exitedValIndex].Index)
+		assert.Equal(t, uint64(32000000000), containers[0].Balance)
+		assert.Equal(t, ethpbv1.ValidatorStatus_ACTIVE_ONGOING, ethpbv1.ValidatorStatus(containers[0].Status))
+		assert.Equal(t, ethpbv1.ValidatorStatus_EXITED_UNSLASHED, ethpbv1.ValidatorStatus(containers[exitedValIndex].Status))
+		assert.DeepEqual(t, st.Validators()[0].PublicKey, containers[0].Validator.Pubkey)
+	})
 	t.Run("get by index", func(t *testing.T) {
 		chainService := &chainMock.ChainService{}
 		s := Server{
@@ -0,0 +1,83 @@
+package debug
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emicklei/dot"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/forkchoice"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+)
+
+// forkChoiceGraph renders the tree of a fork choice dump as a graph in which blocks point to their parents. Blocks
+// are labeled with their weight, balance and checkpoints. Optimistic blocks are yellow and invalid ones red, blocks
+// that are not viable for head are dashed and grey, the head has a thick border and the proposer boost holder is
+// orange.
+func forkChoiceGraph(dump *forkchoice.Dump) *dot.Graph {
+	g := dot.NewGraph(dot.Directed)
+	g.Attr("rankdir", "RL")
+	g.Attr("labeljust", "l")
+	g.Attr("labelloc", "t")
+	g.Label(fmt.Sprintf("justified: epoch %d %#x\nfinalized: epoch %d %#x\nunrealized justified: epoch %d\nunrealized finalized: epoch %d",
+		dump.JustifiedCheckpoint.Epoch, bytesutil.Trunc(dump.JustifiedCheckpoint.Root),
+		dump.FinalizedCheckpoint.Epoch, bytesutil.Trunc(dump.FinalizedCheckpoint.Root),
+		dump.UnrealizedJustifiedCheckpoint.Epoch, dump.UnrealizedFinalizedCheckpoint.Epoch))
+
+	headRoot := bytesutil.ToBytes32(dump.HeadRoot)
+	boostRoot := bytesutil.ToBytes32(dump.ProposerBoostRoot)
+	nodes := make(map[[32]byte]dot.Node, len(dump.ForkChoiceNodes))
+	for _, n := range dump.ForkChoiceNodes {
+		root := bytesutil.ToBytes32(n.BlockRoot)
+		lines := []string{
+			fmt.Sprintf("slot: %d", n.Slot),
+			fmt.Sprintf("root: %#x", bytesutil.Trunc(n.BlockRoot)),
+			fmt.Sprintf("weight: %d", n.Weight),
+			fmt.Sprintf("balance: %d", n.Balance),
+			fmt.Sprintf("justified: %d (unrealized %d)", n.JustifiedEpoch, n.UnrealizedJustifiedEpoch),
+			fmt.Sprintf("finalized: %d (unrealized %d)", n.FinalizedEpoch, n.UnrealizedFinalizedEpoch),
+			n.Validity.String(),
+		}
+		styles := []string{"filled"}
+		fill := "white"
+		switch n.Validity {
+		case forkchoice.Optimistic:
+			fill = "lightyellow"
+		case forkchoice.Invalid:
+			fill = "lightcoral"
+		}
+		if root == boostRoot {
+			lines = append(lines, "proposer boost")
+			fill = "orange"
+		}
+		if !n.ViableForHead {
+			lines = append(lines, "not viable for head")
+			styles = append(styles, "dashed")
+		}
+		if root == headRoot {
+			lines = append(lines, "head")
+			styles = append(styles, "bold")
+		}
+		dn := g.Node(fmt.Sprintf("%#x", n.BlockRoot)).Box().
+			Label(strings.Join(lines, "\n")).
+			Attr("style", strings.Join(styles, ",")).
+			Attr("fillcolor", fill)
+		if !n.ViableForHead {
+			dn.Attr("color", "grey").Attr("fontcolor", "grey")
+		}
+		if root == headRoot {
+			dn.Attr("penwidth", "3")
+		}
+		nodes[root] = dn
+	}
+	for _, n := range dump.ForkChoiceNodes {
+		parent, ok := nodes[bytesutil.ToBytes32(n.ParentRoot)]
+		if !ok || bytesutil.ToBytes32(n.ParentRoot) == bytesutil.ToBytes32(n.BlockRoot) {
+			continue
+		}
+		e := g.Edge(nodes[bytesutil.ToBytes32(n.BlockRoot)], parent)
+		if !n.ViableForHead {
+			e.Attr("color", "grey").Attr("style", "dashed")
+		}
+	}
+	return g
+}
@@ -0,0 +1,48 @@
+package debug
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/forkchoice"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestForkChoiceGraph(t *testing.T) {
+	root := func(b byte) []byte {
+		return bytesutil.PadTo([]byte{b}, 32)
+	}
+	cp := &ethpb.Checkpoint{Epoch: 1, Root: root(1)}
+	dump := &forkchoice.Dump{
+		JustifiedCheckpoint:           cp,
+		FinalizedCheckpoint:           cp,
+		UnrealizedJustifiedCheckpoint: cp,
+		UnrealizedFinalizedCheckpoint: cp,
+		ProposerBoostRoot:             root(3),
+		HeadRoot:                      root(3),
+		ForkChoiceNodes: []*forkchoice.Node{
+			{Slot: 32, BlockRoot: root(1), ParentRoot: make([]byte, 32), Weight: 300, ViableForHead: true},
+			{Slot: 33, BlockRoot: root(2), ParentRoot: root(1), Weight: 100, Validity: forkchoice.Optimistic},
+			{Slot: 34, BlockRoot: root(3), ParentRoot: root(1), Weight: 200, Balance: 50, ViableForHead: true},
+		},
+	}
+
+	g := forkChoiceGraph(dump).String()
+	require.Equal(t, true, strings.HasPrefix(g, "digraph"))
+	assert.Equal(t, 2, strings.Count(g, "->"))
+	for _, want := range []string{
+		`slot: 33\nroot: 0x020000000000\nweight: 100\nbalance: 0\njustified: 0 (unrealized 0)\nfinalized: 0 (unrealized 0)\noptimistic\nnot viable for head"`,
+		`slot: 34\nroot: 0x030000000000\nweight: 200\nbalance: 50`,
+		`valid\nproposer boost\nhead"`,
+		`fillcolor="lightyellow"`,
+		`fillcolor="orange"`,
+		`style="filled,dashed"`,
+		`style="filled,bold"`,
+		`justified: epoch 1 0x010000000000`,
+	} {
+		assert.Equal(t, true, strings.Contains(g, want), "graph does not contain %s:\n%s", want, g)
+	}
+}
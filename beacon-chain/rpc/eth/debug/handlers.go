@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -198,3 +199,20 @@ func (s *Server) GetForkChoice(w http.ResponseWriter, r *http.Request) {
 	}
 	httputil.WriteJson(w, resp)
 }
+
+// GetForkChoiceDOT renders the current fork choice tree as a graph in the DOT language of Graphviz.
+func (s *Server) GetForkChoiceDOT(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.GetForkChoiceDOT")
+	defer span.End()
+
+	dump, err := s.ForkchoiceFetcher.ForkChoiceDump(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get forkchoice dump: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", api.GraphvizMediaType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.WriteString(w, forkChoiceGraph(dump).String()); err != nil {
+		log.WithError(err).Error("Could not write response message")
+	}
+}
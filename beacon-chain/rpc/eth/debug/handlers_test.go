@@ -459,3 +459,21 @@ func TestGetForkChoice(t *testing.T) {
 	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
 	require.Equal(t, "2", resp.FinalizedCheckpoint.Epoch)
 }
+
+func TestGetForkChoiceDOT(t *testing.T) {
+	store := doublylinkedtree.New()
+	fRoot := [32]byte{'a'}
+	fc := &forkchoicetypes.Checkpoint{Epoch: 2, Root: fRoot}
+	require.NoError(t, store.UpdateFinalizedCheckpoint(fc))
+	s := &Server{ForkchoiceFetcher: &blockchainmock.ChainService{ForkChoiceStore: store}}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/fork_choice/dot", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetForkChoiceDOT(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	assert.Equal(t, api.GraphvizMediaType, writer.Header().Get("Content-Type"))
+	assert.StringContains(t, "digraph", writer.Body.String())
+	assert.StringContains(t, "finalized: epoch 2 0x610000000000", writer.Body.String())
+}
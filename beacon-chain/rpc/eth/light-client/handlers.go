@@ -8,17 +8,18 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"go.opencensus.io/trace"
 
 	"github.com/wealdtech/go-bytesutil"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
-	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
-	types "github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 )
 
@@ -48,7 +49,7 @@ func (s *Server) GetLightClientBootstrap(w http.ResponseWriter, req *http.Reques
 		return
 	}
 
-	bootstrap, err := createLightClientBootstrap(ctx, state)
+	bootstrap, err := createLightClientBootstrap(ctx, state, blk)
 	if err != nil {
 		httputil.HandleError(w, "could not get light client bootstrap: "+err.Error(), http.StatusInternalServerError)
 		return
@@ -56,10 +57,11 @@ func (s *Server) GetLightClientBootstrap(w http.ResponseWriter, req *http.Reques
 
 	response := &structs.LightClientBootstrapResponse{
 		Version: version.String(blk.Version()),
-		Data:    bootstrap,
+		Data:    newLightClientBootstrapToJSON(bootstrap),
 	}
 
-	httputil.WriteJson(w, response)
+	w.Header().Set(api.VersionHeader, version.String(blk.Version()))
+	httputil.WriteResponse(w, req, response, bootstrap, "light_client_bootstrap.ssz")
 }
 
 // GetLightClientUpdatesByRange - implements https://github.com/ethereum/beacon-APIs/blob/263f4ed6c263c967f13279c7a9f5629b51c5fc55/apis/beacon/light_client/updates.yaml
@@ -118,102 +120,31 @@ func (s *Server) GetLightClientUpdatesByRange(w http.ResponseWriter, req *http.R
 		endPeriod = maxSlot / slotsPerPeriod
 	}
 
+	// The requested range may end before the Altair fork once clamped
+	if endPeriod < startPeriod {
+		httputil.HandleError(w, "no updates found", http.StatusNotFound)
+		return
+	}
+
 	// Populate updates
+	storedUpdates, err := s.BeaconDB.LightClientUpdates(ctx, startPeriod, endPeriod)
+	if err != nil {
+		httputil.HandleError(w, "could not get light client updates: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	var updates []*structs.LightClientUpdateWithVersion
+	var sszUpdates []ethpb.LightClientUpdate
 	for period := startPeriod; period <= endPeriod; period++ {
-		// Get the last known state of the period,
-		//    1. We wish the block has a parent in the same period if possible
-		//	  2. We wish the block has a state in the same period
-		lastSlotInPeriod := period*slotsPerPeriod + slotsPerPeriod - 1
-		if lastSlotInPeriod > maxSlot {
-			lastSlotInPeriod = maxSlot
-		}
-		firstSlotInPeriod := period * slotsPerPeriod
-
-		// Let's not use the first slot in the period, otherwise the attested header will be in previous period
-		firstSlotInPeriod++
-
-		var state state.BeaconState
-		var block interfaces.ReadOnlySignedBeaconBlock
-		for slot := lastSlotInPeriod; slot >= firstSlotInPeriod; slot-- {
-			state, err = s.Stater.StateBySlot(ctx, types.Slot(slot))
-			if err != nil {
-				continue
-			}
-
-			// Get the block
-			latestBlockHeader := state.LatestBlockHeader()
-			latestStateRoot, err := state.HashTreeRoot(ctx)
-			if err != nil {
-				continue
-			}
-			latestBlockHeader.StateRoot = latestStateRoot[:]
-			blockRoot, err := latestBlockHeader.HashTreeRoot()
-			if err != nil {
-				continue
-			}
-
-			block, err = s.Blocker.Block(ctx, blockRoot[:])
-			if err != nil || block == nil {
-				continue
-			}
-
-			syncAggregate, err := block.Block().Body().SyncAggregate()
-			if err != nil || syncAggregate == nil {
-				continue
-			}
-
-			if syncAggregate.SyncCommitteeBits.Count()*3 < config.SyncCommitteeSize*2 {
-				// Not enough votes
-				continue
-			}
-
-			break
-		}
-
-		if block == nil {
-			// No valid block found for the period
-			continue
-		}
-
-		// Get attested state
-		attestedRoot := block.Block().ParentRoot()
-		attestedBlock, err := s.Blocker.Block(ctx, attestedRoot[:])
-		if err != nil || attestedBlock == nil {
-			continue
-		}
-
-		attestedSlot := attestedBlock.Block().Slot()
-		attestedState, err := s.Stater.StateBySlot(ctx, attestedSlot)
-		if err != nil {
+		update, ok := storedUpdates[period]
+		if !ok {
 			continue
 		}
-
-		// Get finalized block
-		var finalizedBlock interfaces.ReadOnlySignedBeaconBlock
-		finalizedCheckPoint := attestedState.FinalizedCheckpoint()
-		if finalizedCheckPoint != nil {
-			finalizedRoot := bytesutil.ToBytes32(finalizedCheckPoint.Root)
-			finalizedBlock, err = s.Blocker.Block(ctx, finalizedRoot[:])
-			if err != nil {
-				finalizedBlock = nil
-			}
-		}
-
-		update, err := createLightClientUpdate(
-			ctx,
-			state,
-			block,
-			attestedState,
-			finalizedBlock,
-		)
-
-		if err == nil {
-			updates = append(updates, &structs.LightClientUpdateWithVersion{
-				Version: version.String(attestedState.Version()),
-				Data:    update,
-			})
-		}
+		updates = append(updates, &structs.LightClientUpdateWithVersion{
+			Version: version.String(update.Version()),
+			Data:    newStoredLightClientUpdateToJSON(update),
+		})
+		sszUpdates = append(sszUpdates, update)
 	}
 
 	if len(updates) == 0 {
@@ -221,7 +152,8 @@ func (s *Server) GetLightClientUpdatesByRange(w http.ResponseWriter, req *http.R
 		return
 	}
 
-	httputil.WriteJson(w, updates)
+	resp := &lightClientUpdatesSsz{updates: sszUpdates, genesisValidatorsRoot: headState.GenesisValidatorsRoot()}
+	httputil.WriteResponse(w, req, updates, resp, "light_client_updates.ssz")
 }
 
 // GetLightClientFinalityUpdate - implements https://github.com/ethereum/beacon-APIs/blob/263f4ed6c263c967f13279c7a9f5629b51c5fc55/apis/beacon/light_client/finality_update.yaml
@@ -271,7 +203,7 @@ func (s *Server) GetLightClientFinalityUpdate(w http.ResponseWriter, req *http.R
 		}
 	}
 
-	update, err := newLightClientFinalityUpdateFromBeaconState(
+	update, err := blockchain.NewLightClientFinalityUpdateFromBeaconState(
 		ctx,
 		state,
 		block,
@@ -282,13 +214,23 @@ func (s *Server) GetLightClientFinalityUpdate(w http.ResponseWriter, req *http.R
 		httputil.HandleError(w, "could not get light client finality update: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	finalityUpdate, err := blockchain.NewLightClientFinalityUpdateForFork(
+		blockchain.CreateLightClientFinalityUpdate(update),
+		attestedBlock,
+		finalizedBlock,
+	)
+	if err != nil {
+		httputil.HandleError(w, "could not get light client finality update: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
 
 	response := &structs.LightClientUpdateWithVersion{
 		Version: version.String(attestedState.Version()),
-		Data:    update,
+		Data:    newLightClientUpdateToJSON(update),
 	}
 
-	httputil.WriteJson(w, response)
+	w.Header().Set(api.VersionHeader, version.String(attestedState.Version()))
+	httputil.WriteResponse(w, req, response, finalityUpdate, "light_client_finality_update.ssz")
 }
 
 // GetLightClientOptimisticUpdate - implements https://github.com/ethereum/beacon-APIs/blob/263f4ed6c263c967f13279c7a9f5629b51c5fc55/apis/beacon/light_client/optimistic_update.yaml
@@ -329,7 +271,7 @@ func (s *Server) GetLightClientOptimisticUpdate(w http.ResponseWriter, req *http
 		return
 	}
 
-	update, err := newLightClientOptimisticUpdateFromBeaconState(
+	update, err := blockchain.NewLightClientOptimisticUpdateFromBeaconState(
 		ctx,
 		state,
 		block,
@@ -339,13 +281,22 @@ func (s *Server) GetLightClientOptimisticUpdate(w http.ResponseWriter, req *http
 		httputil.HandleError(w, "could not get light client optimistic update: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	optimisticUpdate, err := blockchain.NewLightClientOptimisticUpdateForFork(
+		blockchain.CreateLightClientOptimisticUpdate(update),
+		attestedBlock,
+	)
+	if err != nil {
+		httputil.HandleError(w, "could not get light client optimistic update: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
 
 	response := &structs.LightClientUpdateWithVersion{
 		Version: version.String(attestedState.Version()),
-		Data:    update,
+		Data:    newLightClientUpdateToJSON(update),
 	}
 
-	httputil.WriteJson(w, response)
+	w.Header().Set(api.VersionHeader, version.String(attestedState.Version()))
+	httputil.WriteResponse(w, req, response, optimisticUpdate, "light_client_optimistic_update.ssz")
 }
 
 // getLightClientEventBlock - returns the block that should be used for light client events, which satisfies the minimum number of signatures from sync committee
@@ -11,20 +11,24 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	dbtesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
-	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
 func TestLightClientHandler_GetLightClientBootstrap(t *testing.T) {
@@ -32,8 +36,8 @@ func TestLightClientHandler_GetLightClientBootstrap(t *testing.T) {
 	slot := primitives.Slot(params.BeaconConfig().AltairForkEpoch * primitives.Epoch(params.BeaconConfig().SlotsPerEpoch)).Add(1)
 
 	b := util.NewBeaconBlockCapella()
-	b.Block.StateRoot = bytesutil.PadTo([]byte("foo"), 32)
 	b.Block.Slot = slot
+	b.Block.Body.ExecutionPayload.BlockNumber = 7
 
 	signedBlock, err := blocks.NewSignedBeaconBlock(b)
 
@@ -41,18 +45,21 @@ func TestLightClientHandler_GetLightClientBootstrap(t *testing.T) {
 	header, err := signedBlock.Header()
 	require.NoError(t, err)
 
-	r, err := b.Block.HashTreeRoot()
-	require.NoError(t, err)
-
-	bs, err := util.NewBeaconStateCapella(func(state *ethpb.BeaconStateCapella) error {
-		state.BlockRoots[0] = r[:]
-		return nil
-	})
+	bs, err := util.NewBeaconStateCapella()
 	require.NoError(t, err)
 
 	require.NoError(t, bs.SetSlot(slot))
 	require.NoError(t, bs.SetLatestBlockHeader(header.Header))
 
+	// The block commits to its post-state.
+	stateRoot, err := bs.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	b.Block.StateRoot = stateRoot[:]
+	signedBlock, err = blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+
 	mockBlocker := &testutil.MockBlocker{BlockToReturn: signedBlock}
 	mockChainService := &mock.ChainService{Optimistic: true, Slot: &slot}
 	s := &Server{
@@ -76,6 +83,20 @@ func TestLightClientHandler_GetLightClientBootstrap(t *testing.T) {
 	require.Equal(t, "capella", resp.Version)
 	require.Equal(t, hexutil.Encode(header.Header.BodyRoot), resp.Data.Header.BodyRoot)
 	require.NotNil(t, resp.Data)
+
+	request = httptest.NewRequest("GET", "http://foo.com/", nil)
+	request = mux.SetURLVars(request, muxVars)
+	request.Header.Set("Accept", api.OctetStreamMediaType)
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetLightClientBootstrap(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	require.Equal(t, "capella", writer.Header().Get(api.VersionHeader))
+	bootstrap := &ethpb.LightClientBootstrapCapella{}
+	require.NoError(t, bootstrap.UnmarshalSSZ(writer.Body.Bytes()))
+	require.DeepEqual(t, header.Header.BodyRoot, bootstrap.Header.Beacon.BodyRoot)
+	require.Equal(t, uint64(7), bootstrap.Header.Execution.BlockNumber)
 }
 
 func TestLightClientHandler_GetLightClientUpdatesByRange(t *testing.T) {
@@ -141,27 +162,16 @@ func TestLightClientHandler_GetLightClientUpdatesByRange(t *testing.T) {
 	signedBlock, err = blocks.NewSignedBeaconBlock(block)
 	require.NoError(t, err)
 
-	root, err := block.Block.HashTreeRoot()
+	update, err := blockchain.NewLightClientUpdateFromBeaconState(ctx, st, signedBlock, attestedState, signedParent, nil)
 	require.NoError(t, err)
+	beaconDB := dbtesting.SetupDB(t)
+	period := slots.SyncCommitteePeriod(slots.ToEpoch(attestedState.Slot()))
+	require.NoError(t, beaconDB.SaveLightClientUpdate(ctx, period, update))
 
-	mockBlocker := &testutil.MockBlocker{
-		RootBlockMap: map[[32]byte]interfaces.ReadOnlySignedBeaconBlock{
-			parentRoot: signedParent,
-			root:       signedBlock,
-		},
-		SlotBlockMap: map[primitives.Slot]interfaces.ReadOnlySignedBeaconBlock{
-			slot.Sub(1): signedParent,
-			slot:        signedBlock,
-		},
-	}
 	mockChainService := &mock.ChainService{Optimistic: true, Slot: &slot, State: st}
 	s := &Server{
-		Stater: &testutil.MockStater{StatesBySlot: map[primitives.Slot]state.BeaconState{
-			slot.Sub(1): attestedState,
-			slot:        st,
-		}},
-		Blocker:     mockBlocker,
 		HeadFetcher: mockChainService,
+		BeaconDB:    beaconDB,
 	}
 	startPeriod := slot.Div(uint64(config.EpochsPerSyncCommitteePeriod)).Div(uint64(config.SlotsPerEpoch))
 	url := fmt.Sprintf("http://foo.com/?count=1&start_period=%d", startPeriod)
@@ -243,27 +253,16 @@ func TestLightClientHandler_GetLightClientUpdatesByRange_TooBigInputCount(t *tes
 	signedBlock, err = blocks.NewSignedBeaconBlock(block)
 	require.NoError(t, err)
 
-	root, err := block.Block.HashTreeRoot()
+	update, err := blockchain.NewLightClientUpdateFromBeaconState(ctx, st, signedBlock, attestedState, signedParent, nil)
 	require.NoError(t, err)
+	beaconDB := dbtesting.SetupDB(t)
+	period := slots.SyncCommitteePeriod(slots.ToEpoch(attestedState.Slot()))
+	require.NoError(t, beaconDB.SaveLightClientUpdate(ctx, period, update))
 
-	mockBlocker := &testutil.MockBlocker{
-		RootBlockMap: map[[32]byte]interfaces.ReadOnlySignedBeaconBlock{
-			parentRoot: signedParent,
-			root:       signedBlock,
-		},
-		SlotBlockMap: map[primitives.Slot]interfaces.ReadOnlySignedBeaconBlock{
-			slot.Sub(1): signedParent,
-			slot:        signedBlock,
-		},
-	}
 	mockChainService := &mock.ChainService{Optimistic: true, Slot: &slot, State: st}
 	s := &Server{
-		Stater: &testutil.MockStater{StatesBySlot: map[primitives.Slot]state.BeaconState{
-			slot.Sub(1): attestedState,
-			slot:        st,
-		}},
-		Blocker:     mockBlocker,
 		HeadFetcher: mockChainService,
+		BeaconDB:    beaconDB,
 	}
 	startPeriod := slot.Div(uint64(config.EpochsPerSyncCommitteePeriod)).Div(uint64(config.SlotsPerEpoch))
 	count := 129 // config.MaxRequestLightClientUpdates is 128
@@ -346,27 +345,16 @@ func TestLightClientHandler_GetLightClientUpdatesByRange_TooEarlyPeriod(t *testi
 	signedBlock, err = blocks.NewSignedBeaconBlock(block)
 	require.NoError(t, err)
 
-	root, err := block.Block.HashTreeRoot()
+	update, err := blockchain.NewLightClientUpdateFromBeaconState(ctx, st, signedBlock, attestedState, signedParent, nil)
 	require.NoError(t, err)
+	beaconDB := dbtesting.SetupDB(t)
+	period := slots.SyncCommitteePeriod(slots.ToEpoch(attestedState.Slot()))
+	require.NoError(t, beaconDB.SaveLightClientUpdate(ctx, period, update))
 
-	mockBlocker := &testutil.MockBlocker{
-		RootBlockMap: map[[32]byte]interfaces.ReadOnlySignedBeaconBlock{
-			parentRoot: signedParent,
-			root:       signedBlock,
-		},
-		SlotBlockMap: map[primitives.Slot]interfaces.ReadOnlySignedBeaconBlock{
-			slot.Sub(1): signedParent,
-			slot:        signedBlock,
-		},
-	}
 	mockChainService := &mock.ChainService{Optimistic: true, Slot: &slot, State: st}
 	s := &Server{
-		Stater: &testutil.MockStater{StatesBySlot: map[primitives.Slot]state.BeaconState{
-			slot.Sub(1): attestedState,
-			slot:        st,
-		}},
-		Blocker:     mockBlocker,
 		HeadFetcher: mockChainService,
+		BeaconDB:    beaconDB,
 	}
 	startPeriod := 1 // very early period before Altair fork
 	count := 1
@@ -449,27 +437,16 @@ func TestLightClientHandler_GetLightClientUpdatesByRange_TooBigCount(t *testing.
 	signedBlock, err = blocks.NewSignedBeaconBlock(block)
 	require.NoError(t, err)
 
-	root, err := block.Block.HashTreeRoot()
+	update, err := blockchain.NewLightClientUpdateFromBeaconState(ctx, st, signedBlock, attestedState, signedParent, nil)
 	require.NoError(t, err)
+	beaconDB := dbtesting.SetupDB(t)
+	period := slots.SyncCommitteePeriod(slots.ToEpoch(attestedState.Slot()))
+	require.NoError(t, beaconDB.SaveLightClientUpdate(ctx, period, update))
 
-	mockBlocker := &testutil.MockBlocker{
-		RootBlockMap: map[[32]byte]interfaces.ReadOnlySignedBeaconBlock{
-			parentRoot: signedParent,
-			root:       signedBlock,
-		},
-		SlotBlockMap: map[primitives.Slot]interfaces.ReadOnlySignedBeaconBlock{
-			slot.Sub(1): signedParent,
-			slot:        signedBlock,
-		},
-	}
 	mockChainService := &mock.ChainService{Optimistic: true, Slot: &slot, State: st}
 	s := &Server{
-		Stater: &testutil.MockStater{StatesBySlot: map[primitives.Slot]state.BeaconState{
-			slot.Sub(1): attestedState,
-			slot:        st,
-		}},
-		Blocker:     mockBlocker,
 		HeadFetcher: mockChainService,
+		BeaconDB:    beaconDB,
 	}
 	startPeriod := 1 // very early period before Altair fork
 	count := 10      // This is big count as we only have one period in test case.
@@ -491,15 +468,47 @@ func TestLightClientHandler_GetLightClientUpdatesByRange_TooBigCount(t *testing.
 
 func TestLightClientHandler_GetLightClientUpdatesByRange_BeforeAltair(t *testing.T) {
 	helpers.ClearCache()
-	ctx := context.Background()
 	config := params.BeaconConfig()
 	slot := primitives.Slot(config.AltairForkEpoch * primitives.Epoch(config.SlotsPerEpoch)).Sub(1)
 
+	st, err := util.NewBeaconStateCapella()
+	require.NoError(t, err)
+	err = st.SetSlot(slot)
+	require.NoError(t, err)
+
+	mockChainService := &mock.ChainService{Optimistic: true, Slot: &slot, State: st}
+	s := &Server{
+		HeadFetcher: mockChainService,
+		BeaconDB:    dbtesting.SetupDB(t),
+	}
+	startPeriod := slot.Div(uint64(config.EpochsPerSyncCommitteePeriod)).Div(uint64(config.SlotsPerEpoch))
+	count := 1
+	url := fmt.Sprintf("http://foo.com/?count=%d&start_period=%d", count, startPeriod)
+	request := httptest.NewRequest("GET", url, nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetLightClientUpdatesByRange(writer, request)
+
+	require.Equal(t, http.StatusNotFound, writer.Code)
+}
+
+func TestLightClientHandler_GetLightClientFinalityUpdate(t *testing.T) {
+	helpers.ClearCache()
+	ctx := context.Background()
+	config := params.BeaconConfig()
+	slot := primitives.Slot(config.AltairForkEpoch * primitives.Epoch(config.SlotsPerEpoch)).Add(1)
+
 	attestedState, err := util.NewBeaconStateCapella()
 	require.NoError(t, err)
 	err = attestedState.SetSlot(slot.Sub(1))
 	require.NoError(t, err)
 
+	require.NoError(t, attestedState.SetFinalizedCheckpoint(&ethpb.Checkpoint{
+		Epoch: config.AltairForkEpoch - 10,
+		Root:  make([]byte, 32),
+	}))
+
 	parent := util.NewBeaconBlockCapella()
 	parent.Block.Slot = slot.Sub(1)
 
@@ -565,7 +574,9 @@ func TestLightClientHandler_GetLightClientUpdatesByRange_BeforeAltair(t *testing
 			slot:        signedBlock,
 		},
 	}
-	mockChainService := &mock.ChainService{Optimistic: true, Slot: &slot, State: st}
+	mockChainService := &mock.ChainService{Optimistic: true, Slot: &slot, State: st, FinalizedRoots: map[[32]byte]bool{
+		root: true,
+	}}
 	s := &Server{
 		Stater: &testutil.MockStater{StatesBySlot: map[primitives.Slot]state.BeaconState{
 			slot.Sub(1): attestedState,
@@ -574,19 +585,21 @@ func TestLightClientHandler_GetLightClientUpdatesByRange_BeforeAltair(t *testing
 		Blocker:     mockBlocker,
 		HeadFetcher: mockChainService,
 	}
-	startPeriod := slot.Div(uint64(config.EpochsPerSyncCommitteePeriod)).Div(uint64(config.SlotsPerEpoch))
-	count := 1
-	url := fmt.Sprintf("http://foo.com/?count=%d&start_period=%d", count, startPeriod)
-	request := httptest.NewRequest("GET", url, nil)
+	request := httptest.NewRequest("GET", "http://foo.com", nil)
 	writer := httptest.NewRecorder()
 	writer.Body = &bytes.Buffer{}
 
-	s.GetLightClientUpdatesByRange(writer, request)
+	s.GetLightClientFinalityUpdate(writer, request)
 
-	require.Equal(t, http.StatusNotFound, writer.Code)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.LightClientUpdateWithVersion{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, "capella", resp.Version)
+	require.Equal(t, hexutil.Encode(attestedHeader.BodyRoot), resp.Data.AttestedHeader.BodyRoot)
+	require.NotNil(t, resp.Data)
 }
 
-func TestLightClientHandler_GetLightClientFinalityUpdate(t *testing.T) {
+func TestLightClientHandler_GetLightClientOptimisticUpdate(t *testing.T) {
 	helpers.ClearCache()
 	ctx := context.Background()
 	config := params.BeaconConfig()
@@ -682,7 +695,7 @@ func TestLightClientHandler_GetLightClientFinalityUpdate(t *testing.T) {
 	writer := httptest.NewRecorder()
 	writer.Body = &bytes.Buffer{}
 
-	s.GetLightClientFinalityUpdate(writer, request)
+	s.GetLightClientOptimisticUpdate(writer, request)
 
 	require.Equal(t, http.StatusOK, writer.Code)
 	resp := &structs.LightClientUpdateWithVersion{}
@@ -692,85 +705,92 @@ func TestLightClientHandler_GetLightClientFinalityUpdate(t *testing.T) {
 	require.NotNil(t, resp.Data)
 }
 
-func TestLightClientHandler_GetLightClientOptimisticUpdate(t *testing.T) {
-	helpers.ClearCache()
+// newLightClientUpdateServer returns a server whose head block, signed by the full sync committee, attests to a parent
+// block of the given fork.
+func newLightClientUpdateServer(t *testing.T, v int) (*Server, *ethpb.BeaconBlockHeader) {
 	ctx := context.Background()
 	config := params.BeaconConfig()
 	slot := primitives.Slot(config.AltairForkEpoch * primitives.Epoch(config.SlotsPerEpoch)).Add(1)
 
-	attestedState, err := util.NewBeaconStateCapella()
-	require.NoError(t, err)
-	err = attestedState.SetSlot(slot.Sub(1))
-	require.NoError(t, err)
+	var newState func() (state.BeaconState, error)
+	var newBlock func() (interfaces.SignedBeaconBlock, error)
+	switch v {
+	case version.Altair:
+		newState = func() (state.BeaconState, error) { return util.NewBeaconStateAltair() }
+		newBlock = func() (interfaces.SignedBeaconBlock, error) {
+			return blocks.NewSignedBeaconBlock(util.NewBeaconBlockAltair())
+		}
+	case version.Bellatrix:
+		newState = func() (state.BeaconState, error) { return util.NewBeaconStateBellatrix() }
+		newBlock = func() (interfaces.SignedBeaconBlock, error) {
+			return blocks.NewSignedBeaconBlock(util.NewBeaconBlockBellatrix())
+		}
+	case version.Capella:
+		newState = func() (state.BeaconState, error) { return util.NewBeaconStateCapella() }
+		newBlock = func() (interfaces.SignedBeaconBlock, error) {
+			return blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+		}
+	case version.Deneb:
+		newState = func() (state.BeaconState, error) { return util.NewBeaconStateDeneb() }
+		newBlock = func() (interfaces.SignedBeaconBlock, error) {
+			return blocks.NewSignedBeaconBlock(util.NewBeaconBlockDeneb())
+		}
+	default:
+		newState = func() (state.BeaconState, error) { return util.NewBeaconStateElectra() }
+		newBlock = func() (interfaces.SignedBeaconBlock, error) {
+			return blocks.NewSignedBeaconBlock(util.NewBeaconBlockElectra())
+		}
+	}
 
+	attestedState, err := newState()
+	require.NoError(t, err)
+	require.NoError(t, attestedState.SetSlot(slot.Sub(1)))
 	require.NoError(t, attestedState.SetFinalizedCheckpoint(&ethpb.Checkpoint{
 		Epoch: config.AltairForkEpoch - 10,
 		Root:  make([]byte, 32),
 	}))
-
-	parent := util.NewBeaconBlockCapella()
-	parent.Block.Slot = slot.Sub(1)
-
-	signedParent, err := blocks.NewSignedBeaconBlock(parent)
+	parent, err := newBlock()
 	require.NoError(t, err)
-
-	parentHeader, err := signedParent.Header()
-	require.NoError(t, err)
-	attestedHeader := parentHeader.Header
-
-	err = attestedState.SetLatestBlockHeader(attestedHeader)
+	parent.SetSlot(slot.Sub(1))
+	parentHeader, err := parent.Header()
 	require.NoError(t, err)
+	require.NoError(t, attestedState.SetLatestBlockHeader(parentHeader.Header))
 	attestedStateRoot, err := attestedState.HashTreeRoot(ctx)
 	require.NoError(t, err)
-
-	// get a new signed block so the root is updated with the new state root
-	parent.Block.StateRoot = attestedStateRoot[:]
-	signedParent, err = blocks.NewSignedBeaconBlock(parent)
+	parent.SetStateRoot(attestedStateRoot[:])
+	parentRoot, err := parent.Block().HashTreeRoot()
 	require.NoError(t, err)
 
-	st, err := util.NewBeaconStateCapella()
+	st, err := newState()
 	require.NoError(t, err)
-	err = st.SetSlot(slot)
+	require.NoError(t, st.SetSlot(slot))
+	block, err := newBlock()
 	require.NoError(t, err)
-
-	parentRoot, err := signedParent.Block().HashTreeRoot()
+	block.SetSlot(slot)
+	block.SetParentRoot(parentRoot[:])
+	syncAggregate, err := block.Block().Body().SyncAggregate()
 	require.NoError(t, err)
-
-	block := util.NewBeaconBlockCapella()
-	block.Block.Slot = slot
-	block.Block.ParentRoot = parentRoot[:]
-
 	for i := uint64(0); i < config.SyncCommitteeSize; i++ {
-		block.Block.Body.SyncAggregate.SyncCommitteeBits.SetBitAt(i, true)
+		syncAggregate.SyncCommitteeBits.SetBitAt(i, true)
 	}
-
-	signedBlock, err := blocks.NewSignedBeaconBlock(block)
-	require.NoError(t, err)
-
-	h, err := signedBlock.Header()
-	require.NoError(t, err)
-
-	err = st.SetLatestBlockHeader(h.Header)
+	require.NoError(t, block.SetSyncAggregate(syncAggregate))
+	h, err := block.Header()
 	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(h.Header))
 	stateRoot, err := st.HashTreeRoot(ctx)
 	require.NoError(t, err)
-
-	// get a new signed block so the root is updated with the new state root
-	block.Block.StateRoot = stateRoot[:]
-	signedBlock, err = blocks.NewSignedBeaconBlock(block)
-	require.NoError(t, err)
-
-	root, err := block.Block.HashTreeRoot()
+	block.SetStateRoot(stateRoot[:])
+	root, err := block.Block().HashTreeRoot()
 	require.NoError(t, err)
 
 	mockBlocker := &testutil.MockBlocker{
 		RootBlockMap: map[[32]byte]interfaces.ReadOnlySignedBeaconBlock{
-			parentRoot: signedParent,
-			root:       signedBlock,
+			parentRoot: parent,
+			root:       block,
 		},
 		SlotBlockMap: map[primitives.Slot]interfaces.ReadOnlySignedBeaconBlock{
-			slot.Sub(1): signedParent,
-			slot:        signedBlock,
+			slot.Sub(1): parent,
+			slot:        block,
 		},
 	}
 	mockChainService := &mock.ChainService{Optimistic: true, Slot: &slot, State: st, FinalizedRoots: map[[32]byte]bool{
@@ -784,18 +804,73 @@ func TestLightClientHandler_GetLightClientOptimisticUpdate(t *testing.T) {
 		Blocker:     mockBlocker,
 		HeadFetcher: mockChainService,
 	}
-	request := httptest.NewRequest("GET", "http://foo.com", nil)
-	writer := httptest.NewRecorder()
-	writer.Body = &bytes.Buffer{}
+	parentHeader, err = parent.Header()
+	require.NoError(t, err)
+	return s, parentHeader.Header
+}
 
-	s.GetLightClientOptimisticUpdate(writer, request)
+func TestLightClientHandler_GetLightClientFinalityUpdate_SSZ(t *testing.T) {
+	tests := []struct {
+		version int
+		update  ethpb.LightClientFinalityUpdate
+	}{
+		{version: version.Altair, update: &ethpb.LightClientFinalityUpdateAltair{}},
+		{version: version.Bellatrix, update: &ethpb.LightClientFinalityUpdateAltair{}},
+		{version: version.Capella, update: &ethpb.LightClientFinalityUpdateCapella{}},
+		{version: version.Deneb, update: &ethpb.LightClientFinalityUpdateDeneb{}},
+		{version: version.Electra, update: &ethpb.LightClientFinalityUpdateElectra{}},
+	}
+	for _, tt := range tests {
+		t.Run(version.String(tt.version), func(t *testing.T) {
+			helpers.ClearCache()
+			s, attestedHeader := newLightClientUpdateServer(t, tt.version)
+			request := httptest.NewRequest("GET", "http://foo.com", nil)
+			request.Header.Set("Accept", api.OctetStreamMediaType)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.GetLightClientFinalityUpdate(writer, request)
+			require.Equal(t, http.StatusOK, writer.Code)
+			require.Equal(t, version.String(tt.version), writer.Header().Get(api.VersionHeader))
+			require.NoError(t, tt.update.UnmarshalSSZ(writer.Body.Bytes()))
+			require.DeepEqual(t, attestedHeader.BodyRoot, tt.update.GetAttestedHeaderVal().GetBeacon().BodyRoot)
+			enc, err := tt.update.MarshalSSZ()
+			require.NoError(t, err)
+			require.DeepEqual(t, writer.Body.Bytes(), enc)
+		})
+	}
+}
 
-	require.Equal(t, http.StatusOK, writer.Code)
-	resp := &structs.LightClientUpdateWithVersion{}
-	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
-	require.Equal(t, "capella", resp.Version)
-	require.Equal(t, hexutil.Encode(attestedHeader.BodyRoot), resp.Data.AttestedHeader.BodyRoot)
-	require.NotNil(t, resp.Data)
+func TestLightClientHandler_GetLightClientOptimisticUpdate_SSZ(t *testing.T) {
+	tests := []struct {
+		version int
+		update  ethpb.LightClientOptimisticUpdate
+	}{
+		{version: version.Altair, update: &ethpb.LightClientOptimisticUpdateAltair{}},
+		{version: version.Bellatrix, update: &ethpb.LightClientOptimisticUpdateAltair{}},
+		{version: version.Capella, update: &ethpb.LightClientOptimisticUpdateCapella{}},
+		{version: version.Deneb, update: &ethpb.LightClientOptimisticUpdateDeneb{}},
+		{version: version.Electra, update: &ethpb.LightClientOptimisticUpdateElectra{}},
+	}
+	for _, tt := range tests {
+		t.Run(version.String(tt.version), func(t *testing.T) {
+			helpers.ClearCache()
+			s, attestedHeader := newLightClientUpdateServer(t, tt.version)
+			request := httptest.NewRequest("GET", "http://foo.com", nil)
+			request.Header.Set("Accept", api.OctetStreamMediaType)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.GetLightClientOptimisticUpdate(writer, request)
+			require.Equal(t, http.StatusOK, writer.Code)
+			require.Equal(t, version.String(tt.version), writer.Header().Get(api.VersionHeader))
+			require.NoError(t, tt.update.UnmarshalSSZ(writer.Body.Bytes()))
+			require.DeepEqual(t, attestedHeader.BodyRoot, tt.update.GetAttestedHeaderVal().GetBeacon().BodyRoot)
+			enc, err := tt.update.MarshalSSZ()
+			require.NoError(t, err)
+			require.DeepEqual(t, writer.Body.Bytes(), enc)
+		})
+	}
 }
 
 func TestLightClientHandler_GetLightClientEventBlock(t *testing.T) {
@@ -1,20 +1,28 @@
 package rewards
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"net/http"
 	"strconv"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
+	coreblocks "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	prysmbytesutil "github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
@@ -61,6 +69,180 @@ func (s *Server) BlockRewards(w http.ResponseWriter, r *http.Request) {
 	httputil.WriteJson(w, response)
 }
 
+// ProposerRewards is an HTTP handler for the Prysm extension of getBlockRewards. On top of the consensus rewards of the
+// block proposer, it reports the priority fees paid to the fee recipient of the execution payload, which are computed
+// from the receipts of the execution block, and whether the payload was built locally or by a builder.
+func (s *Server) ProposerRewards(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.ProposerRewards")
+	defer span.End()
+	segments := strings.Split(r.URL.Path, "/")
+	blockId := segments[len(segments)-1]
+
+	blk, err := s.Blocker.Block(ctx, []byte(blockId))
+	if !shared.WriteBlockFetchError(w, blk, err) {
+		return
+	}
+	if blk.Version() == version.Phase0 {
+		httputil.HandleError(w, "Block rewards are not supported for Phase 0 blocks", http.StatusBadRequest)
+		return
+	}
+
+	optimistic, err := s.OptimisticModeFetcher.IsOptimistic(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get optimistic mode info: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	blkRoot, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		httputil.HandleError(w, "Could not get block root: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	blockRewards, httpError := s.BlockRewardFetcher.GetBlockRewardsData(ctx, blk.Block())
+	if httpError != nil {
+		httputil.WriteError(w, httpError)
+		return
+	}
+	executionRewards, httpError := s.executionPayloadRewards(ctx, blk.Block(), blkRoot)
+	if httpError != nil {
+		httputil.WriteError(w, httpError)
+		return
+	}
+	response := &structs.ProposerRewardsResponse{
+		Data: &structs.ProposerRewards{
+			ProposerIndex:    blockRewards.ProposerIndex,
+			ConsensusRewards: blockRewards,
+			ExecutionRewards: executionRewards,
+		},
+		ExecutionOptimistic: optimistic,
+		Finalized:           s.FinalizationFetcher.IsFinalized(ctx, blkRoot),
+	}
+	httputil.WriteJson(w, response)
+}
+
+// executionPayloadRewards sums up the priority fees, in wei, paid by the transactions of the execution payload of the
+// block to its fee recipient. For payloads from a builder, the fee recipient is usually the builder rather than the
+// proposer, so the payment of the builder to the proposer is reported as well when it can be found. Nil is returned
+// for blocks without an execution payload.
+func (s *Server) executionPayloadRewards(
+	ctx context.Context,
+	blk interfaces.ReadOnlyBeaconBlock,
+	blkRoot [32]byte,
+) (*structs.ExecutionPayloadRewards, *httputil.DefaultJsonError) {
+	isExecution, err := coreblocks.IsExecutionBlock(blk.Body())
+	if err != nil {
+		return nil, &httputil.DefaultJsonError{
+			Message: "Could not check if block is an execution block: " + err.Error(),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	if !isExecution {
+		return nil, nil
+	}
+	payload, err := blk.Body().Execution()
+	if err != nil {
+		return nil, &httputil.DefaultJsonError{
+			Message: "Could not get execution payload: " + err.Error(),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	blockHash := common.BytesToHash(payload.BlockHash())
+	receipts, err := s.ExecutionEngineCaller.ExecutionBlockReceipts(ctx, blockHash)
+	if err != nil {
+		return nil, &httputil.DefaultJsonError{
+			Message: "Could not get execution block receipts: " + err.Error(),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	// Priority fees are what is left of the effective gas price of transactions once the base fee is burnt.
+	baseFee := prysmbytesutil.LittleEndianBytesToBigInt(payload.BaseFeePerGas())
+	priorityFees := big.NewInt(0)
+	for _, receipt := range receipts {
+		if receipt.EffectiveGasPrice == nil {
+			continue
+		}
+		fee := new(big.Int).Sub(receipt.EffectiveGasPrice, baseFee)
+		priorityFees.Add(priorityFees, fee.Mul(fee, new(big.Int).SetUint64(receipt.GasUsed)))
+	}
+
+	source := PayloadSourceUnknown
+	fromBuilder, err := s.BeaconDB.PayloadFromBuilder(ctx, blkRoot)
+	switch {
+	case db.IsNotFound(err):
+	case err != nil:
+		return nil, &httputil.DefaultJsonError{
+			Message: "Could not get payload source: " + err.Error(),
+			Code:    http.StatusInternalServerError,
+		}
+	case fromBuilder:
+		source = PayloadSourceBuilder
+	default:
+		source = PayloadSourceLocal
+	}
+
+	rewards := &structs.ExecutionPayloadRewards{
+		BlockHash:     blockHash.Hex(),
+		FeeRecipient:  common.BytesToAddress(payload.FeeRecipient()).Hex(),
+		PriorityFees:  priorityFees.String(),
+		PayloadSource: source,
+	}
+	if source == PayloadSourceBuilder {
+		tx, err := s.lastTransaction(ctx, payload)
+		if err != nil {
+			return nil, &httputil.DefaultJsonError{
+				Message: "Could not get last execution transaction: " + err.Error(),
+				Code:    http.StatusInternalServerError,
+			}
+		}
+		rewards.BuilderPayment = builderPayment(tx, payload.FeeRecipient())
+	}
+	return rewards, nil
+}
+
+// lastTransaction returns the last transaction of the execution payload. The transactions of blinded blocks are not
+// stored, so they are fetched from the execution client along with the execution block. Nil is returned if the
+// payload has no transactions.
+func (s *Server) lastTransaction(ctx context.Context, payload interfaces.ExecutionData) (*gethtypes.Transaction, error) {
+	if payload.IsBlinded() {
+		blk, err := s.ExecutionEngineCaller.ExecutionBlockByHash(ctx, common.BytesToHash(payload.BlockHash()), true /* with txs */)
+		if err != nil {
+			return nil, err
+		}
+		if blk == nil || len(blk.Transactions) == 0 {
+			return nil, nil
+		}
+		return blk.Transactions[len(blk.Transactions)-1], nil
+	}
+	txs, err := payload.Transactions()
+	if err != nil {
+		return nil, err
+	}
+	if len(txs) == 0 {
+		return nil, nil
+	}
+	tx := &gethtypes.Transaction{}
+	if err := tx.UnmarshalBinary(txs[len(txs)-1]); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// builderPayment returns the payment of the builder of the execution payload to the proposer which, by convention,
+// is the last transaction of the payload, sent from its fee recipient. Nil is returned if the last transaction is not
+// such a payment.
+func builderPayment(tx *gethtypes.Transaction, feeRecipient []byte) *structs.BuilderPayment {
+	if tx == nil || tx.To() == nil {
+		return nil
+	}
+	from, err := gethtypes.Sender(gethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil || from != common.BytesToAddress(feeRecipient) {
+		return nil
+	}
+	return &structs.BuilderPayment{
+		Recipient: tx.To().Hex(),
+		Value:     tx.Value().String(),
+	}
+}
+
 // AttestationRewards retrieves attestation reward info for validators specified by array of public keys or validator index.
 // If no array is provided, return reward info for every validator.
 func (s *Server) AttestationRewards(w http.ResponseWriter, r *http.Request) {
@@ -5,12 +5,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
 	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
@@ -19,6 +24,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	dbutil "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	mockstategen "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen/mock"
@@ -31,6 +37,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls/blst"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -363,6 +370,204 @@ func TestBlockRewards(t *testing.T) {
 	})
 }
 
+func TestProposerRewards(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	phase0block, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+
+	t.Run("phase 0", func(t *testing.T) {
+		mockChainService := &mock.ChainService{Optimistic: true}
+		s := &Server{
+			Blocker: &testutil.MockBlocker{SlotBlockMap: map[primitives.Slot]interfaces.ReadOnlySignedBeaconBlock{
+				0: phase0block,
+			}},
+			OptimisticModeFetcher: mockChainService,
+			FinalizationFetcher:   mockChainService,
+		}
+		url := "http://only.the.slot.number.at.the.end.is.important/0"
+		request := httptest.NewRequest("GET", url, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ProposerRewards(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, "Block rewards are not supported for Phase 0 blocks", e.Message)
+	})
+	t.Run("no execution payload", func(t *testing.T) {
+		st, sbb, err := BlockRewardTestSetup(t, "altair")
+		require.NoError(t, err)
+
+		mockChainService := &mock.ChainService{Optimistic: true}
+		s := &Server{
+			Blocker: &testutil.MockBlocker{SlotBlockMap: map[primitives.Slot]interfaces.ReadOnlySignedBeaconBlock{
+				0: phase0block,
+				2: sbb,
+			}},
+			OptimisticModeFetcher: mockChainService,
+			FinalizationFetcher:   mockChainService,
+			BlockRewardFetcher: &BlockRewardService{
+				Replayer: mockstategen.NewReplayerBuilder(mockstategen.WithMockState(st)),
+				DB:       db,
+			},
+			BeaconDB:              db,
+			ExecutionEngineCaller: &mockExecution.EngineClient{},
+		}
+
+		url := "http://only.the.slot.number.at.the.end.is.important/2"
+		request := httptest.NewRequest("GET", url, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ProposerRewards(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ProposerRewardsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "12", resp.Data.ProposerIndex)
+		assert.Equal(t, "125089490", resp.Data.ConsensusRewards.Total)
+		assert.Equal(t, (*structs.ExecutionPayloadRewards)(nil), resp.Data.ExecutionRewards)
+	})
+
+	st, sbb, err := BlockRewardTestSetup(t, "deneb")
+	require.NoError(t, err)
+	execution, err := sbb.Block().Body().Execution()
+	require.NoError(t, err)
+	payload, ok := execution.Proto().(*enginev1.ExecutionPayloadDeneb)
+	require.Equal(t, true, ok)
+	payload.BlockHash = bytesutil.PadTo([]byte("hash"), 32)
+	// The builder pays the proposer from the fee recipient in the last transaction of the payload.
+	builderKey, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	payload.FeeRecipient = gethcrypto.PubkeyToAddress(builderKey.PublicKey).Bytes()
+	proposerRecipient := common.BytesToAddress(bytesutil.PadTo([]byte("proposer"), 20))
+	paymentTx, err := gethtypes.SignNewTx(builderKey, gethtypes.LatestSignerForChainID(big.NewInt(1)), &gethtypes.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		To:        &proposerRecipient,
+		Value:     big.NewInt(1000000),
+		Gas:       21000,
+		GasFeeCap: big.NewInt(10),
+	})
+	require.NoError(t, err)
+	encodedTx, err := paymentTx.MarshalBinary()
+	require.NoError(t, err)
+	payload.Transactions = [][]byte{encodedTx}
+	// Base fee of 10 wei, in little-endian.
+	payload.BaseFeePerGas = bytesutil.PadTo([]byte{10}, 32)
+	execution, err = blocks.WrappedExecutionPayloadDeneb(payload, big.NewInt(0))
+	require.NoError(t, err)
+	require.NoError(t, sbb.SetExecution(execution))
+	blkRoot, err := sbb.Block().HashTreeRoot()
+	require.NoError(t, err)
+	engine := &mockExecution.EngineClient{
+		ReceiptsByHashMap: map[[32]byte][]*gethtypes.Receipt{
+			bytesutil.ToBytes32(payload.BlockHash): {
+				{EffectiveGasPrice: big.NewInt(15), GasUsed: 21000},
+				{EffectiveGasPrice: big.NewInt(12), GasUsed: 50000},
+			},
+		},
+	}
+	// Computing the consensus rewards applies the slashings of the block to the state, so each server gets a copy.
+	newServer := func() *Server {
+		mockChainService := &mock.ChainService{Optimistic: true}
+		return &Server{
+			Blocker: &testutil.MockBlocker{SlotBlockMap: map[primitives.Slot]interfaces.ReadOnlySignedBeaconBlock{
+				0: phase0block,
+				2: sbb,
+			}},
+			OptimisticModeFetcher: mockChainService,
+			FinalizationFetcher:   mockChainService,
+			BlockRewardFetcher: &BlockRewardService{
+				Replayer: mockstategen.NewReplayerBuilder(mockstategen.WithMockState(st.Copy())),
+				DB:       db,
+			},
+			BeaconDB:              db,
+			ExecutionEngineCaller: engine,
+		}
+	}
+	proposerRewards := func(t *testing.T, s *Server) *structs.ProposerRewardsResponse {
+		url := "http://only.the.slot.number.at.the.end.is.important/2"
+		request := httptest.NewRequest("GET", url, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ProposerRewards(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ProposerRewardsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		return resp
+	}
+
+	t.Run("unknown payload source", func(t *testing.T) {
+		resp := proposerRewards(t, newServer())
+		assert.Equal(t, "12", resp.Data.ProposerIndex)
+		assert.Equal(t, "125089490", resp.Data.ConsensusRewards.Total)
+		assert.DeepEqual(t, &structs.ExecutionPayloadRewards{
+			BlockHash:     hexutil.Encode(payload.BlockHash),
+			FeeRecipient:  common.BytesToAddress(payload.FeeRecipient).Hex(),
+			PriorityFees:  "205000",
+			PayloadSource: PayloadSourceUnknown,
+		}, resp.Data.ExecutionRewards)
+		assert.Equal(t, true, resp.ExecutionOptimistic)
+		assert.Equal(t, false, resp.Finalized)
+	})
+	t.Run("builder payload", func(t *testing.T) {
+		require.NoError(t, db.SavePayloadFromBuilder(context.Background(), blkRoot, true))
+		resp := proposerRewards(t, newServer())
+		assert.Equal(t, "205000", resp.Data.ExecutionRewards.PriorityFees)
+		assert.Equal(t, PayloadSourceBuilder, resp.Data.ExecutionRewards.PayloadSource)
+		assert.DeepEqual(t, &structs.BuilderPayment{
+			Recipient: proposerRecipient.Hex(),
+			Value:     "1000000",
+		}, resp.Data.ExecutionRewards.BuilderPayment)
+	})
+	t.Run("blinded builder payload", func(t *testing.T) {
+		require.NoError(t, db.SavePayloadFromBuilder(context.Background(), blkRoot, true))
+		blinded, err := sbb.ToBlinded()
+		require.NoError(t, err)
+		s := newServer()
+		s.Blocker = &testutil.MockBlocker{SlotBlockMap: map[primitives.Slot]interfaces.ReadOnlySignedBeaconBlock{
+			0: phase0block,
+			2: blinded,
+		}}
+		// The transactions of blinded blocks are fetched from the execution client.
+		s.ExecutionEngineCaller = &mockExecution.EngineClient{
+			ReceiptsByHashMap: engine.ReceiptsByHashMap,
+			BlockByHashMap: map[[32]byte]*enginev1.ExecutionBlock{
+				bytesutil.ToBytes32(payload.BlockHash): {Transactions: []*gethtypes.Transaction{paymentTx}},
+			},
+		}
+		resp := proposerRewards(t, s)
+		assert.Equal(t, "205000", resp.Data.ExecutionRewards.PriorityFees)
+		assert.Equal(t, PayloadSourceBuilder, resp.Data.ExecutionRewards.PayloadSource)
+		assert.DeepEqual(t, &structs.BuilderPayment{
+			Recipient: proposerRecipient.Hex(),
+			Value:     "1000000",
+		}, resp.Data.ExecutionRewards.BuilderPayment)
+	})
+	t.Run("local payload", func(t *testing.T) {
+		require.NoError(t, db.SavePayloadFromBuilder(context.Background(), blkRoot, false))
+		resp := proposerRewards(t, newServer())
+		assert.Equal(t, "205000", resp.Data.ExecutionRewards.PriorityFees)
+		assert.Equal(t, PayloadSourceLocal, resp.Data.ExecutionRewards.PayloadSource)
+		assert.Equal(t, (*structs.BuilderPayment)(nil), resp.Data.ExecutionRewards.BuilderPayment)
+	})
+	t.Run("receipts not available", func(t *testing.T) {
+		s := newServer()
+		s.ExecutionEngineCaller = &mockExecution.EngineClient{}
+		url := "http://only.the.slot.number.at.the.end.is.important/2"
+		request := httptest.NewRequest("GET", url, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ProposerRewards(writer, request)
+		assert.Equal(t, http.StatusInternalServerError, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Could not get execution block receipts", e.Message)
+	})
+}
+
 func TestAttestationRewards(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 	cfg := params.BeaconConfig()
@@ -2,6 +2,8 @@ package rewards
 
 import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
 )
 
@@ -13,4 +15,14 @@ type Server struct {
 	Stater                lookup.Stater
 	HeadFetcher           blockchain.HeadFetcher
 	BlockRewardFetcher    BlockRewardsFetcher
+	BeaconDB              db.ReadOnlyDatabase
+	ExecutionEngineCaller execution.EngineCaller
 }
+
+// Sources of the execution payload of a block, as reported by the proposer rewards endpoint. The source is only known
+// for blocks proposed through this node.
+const (
+	PayloadSourceLocal   = "local"
+	PayloadSourceBuilder = "builder"
+	PayloadSourceUnknown = "unknown"
+)
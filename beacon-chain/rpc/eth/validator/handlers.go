@@ -118,27 +118,41 @@ func (s *Server) SubmitContributionAndProofs(w http.ResponseWriter, r *http.Requ
 	ctx, span := trace.StartSpan(r.Context(), "validator.SubmitContributionAndProofs")
 	defer span.End()
 
-	var req structs.SubmitContributionAndProofsRequest
-	err := json.NewDecoder(r.Body).Decode(&req.Data)
-	switch {
-	case err == io.EOF:
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
-	case err != nil:
-		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
-		return
-	}
-	if len(req.Data) == 0 {
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
-	}
-
-	for _, item := range req.Data {
-		consensusItem, err := item.ToConsensus()
-		if err != nil {
-			httputil.HandleError(w, "Could not convert request contribution to consensus contribution: "+err.Error(), http.StatusBadRequest)
+	var contributions []*ethpbalpha.SignedContributionAndProof
+	if httputil.IsRequestSsz(r) {
+		var ok bool
+		contributions, ok = httputil.ReadSszListRequest(w, r, (&ethpbalpha.SignedContributionAndProof{}).SizeSSZ(), func() *ethpbalpha.SignedContributionAndProof {
+			return &ethpbalpha.SignedContributionAndProof{}
+		})
+		if !ok {
+			return
+		}
+	} else {
+		var req structs.SubmitContributionAndProofsRequest
+		err := json.NewDecoder(r.Body).Decode(&req.Data)
+		switch {
+		case err == io.EOF:
+			httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+			return
+		case err != nil:
+			httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(req.Data) == 0 {
+			httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
 			return
 		}
+		contributions = make([]*ethpbalpha.SignedContributionAndProof, len(req.Data))
+		for i, item := range req.Data {
+			contributions[i], err = item.ToConsensus()
+			if err != nil {
+				httputil.HandleError(w, "Could not convert request contribution to consensus contribution: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+	}
+
+	for _, consensusItem := range contributions {
 		rpcError := s.CoreService.SubmitSignedContributionAndProof(ctx, consensusItem)
 		if rpcError != nil {
 			httputil.HandleError(w, rpcError.Err.Error(), core.ErrorReasonToHTTP(rpcError.Reason))
@@ -151,28 +165,42 @@ func (s *Server) SubmitAggregateAndProofs(w http.ResponseWriter, r *http.Request
 	ctx, span := trace.StartSpan(r.Context(), "validator.SubmitAggregateAndProofs")
 	defer span.End()
 
-	var req structs.SubmitAggregateAndProofsRequest
-	err := json.NewDecoder(r.Body).Decode(&req.Data)
-	switch {
-	case err == io.EOF:
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
-	case err != nil:
-		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
-		return
-	}
-	if len(req.Data) == 0 {
-		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
-		return
+	var aggregates []*ethpbalpha.SignedAggregateAttestationAndProof
+	if httputil.IsRequestSsz(r) {
+		var ok bool
+		aggregates, ok = httputil.ReadSszListRequest(w, r, 0, func() *ethpbalpha.SignedAggregateAttestationAndProof {
+			return &ethpbalpha.SignedAggregateAttestationAndProof{}
+		})
+		if !ok {
+			return
+		}
+	} else {
+		var req structs.SubmitAggregateAndProofsRequest
+		err := json.NewDecoder(r.Body).Decode(&req.Data)
+		switch {
+		case err == io.EOF:
+			httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+			return
+		case err != nil:
+			httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(req.Data) == 0 {
+			httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+			return
+		}
+		aggregates = make([]*ethpbalpha.SignedAggregateAttestationAndProof, len(req.Data))
+		for i, item := range req.Data {
+			aggregates[i], err = item.ToConsensus()
+			if err != nil {
+				httputil.HandleError(w, "Could not convert request aggregate to consensus aggregate: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
 	}
 
 	broadcastFailed := false
-	for _, item := range req.Data {
-		consensusItem, err := item.ToConsensus()
-		if err != nil {
-			httputil.HandleError(w, "Could not convert request aggregate to consensus aggregate: "+err.Error(), http.StatusBadRequest)
-			return
-		}
+	for _, consensusItem := range aggregates {
 		rpcError := s.CoreService.SubmitSignedAggregateSelectionProof(
 			ctx,
 			consensusItem,
@@ -0,0 +1,205 @@
+package node
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// ListPeerScores retrieves every known peer along with its score as calculated by each of the peer scorers, its gossip
+// topic scores and why it is banned, if it is. Peers are sorted from the lowest to the highest score.
+func (s *Server) ListPeerScores(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.ListPeerScores")
+	defer span.End()
+
+	peerStatus := s.PeersFetcher.Peers()
+	allIds := peerStatus.All()
+	scores := make([]*structs.PeerScore, 0, len(allIds))
+	totals := make(map[string]float64, len(allIds))
+	for _, id := range allIds {
+		ps, total, err := peerScore(peerStatus, id)
+		if err != nil {
+			if errors.Is(err, peerdata.ErrPeerUnknown) {
+				// The peer has been pruned in the meantime.
+				continue
+			}
+			httputil.HandleError(w, "Could not get peer score: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		totals[ps.PeerId] = total
+		scores = append(scores, ps)
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if totals[scores[i].PeerId] != totals[scores[j].PeerId] {
+			return totals[scores[i].PeerId] < totals[scores[j].PeerId]
+		}
+		return scores[i].PeerId < scores[j].PeerId
+	})
+	httputil.WriteJson(w, &structs.PeerScoresResponse{Data: scores})
+}
+
+// BanPeer bans the given peer, disconnecting from it if connected, for the number of seconds given in the request
+// body, or until it is unbanned if no duration is given. Manual bans also apply to trusted peers.
+func (s *Server) BanPeer(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.BanPeer")
+	defer span.End()
+
+	id, ok := peerIdFromRoute(w, r)
+	if !ok {
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		httputil.HandleError(w, "Could not read request body: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	req := &structs.BanPeerRequest{}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, req); err != nil {
+			httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	var duration time.Duration
+	if req.Duration != "" {
+		seconds, err := strconv.ParseUint(req.Duration, 10, 32)
+		if err != nil {
+			httputil.HandleError(w, "Invalid duration: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		duration = time.Duration(seconds) * time.Second
+	}
+
+	peerStatus := s.PeersFetcher.Peers()
+	peerStatus.Ban(id, duration, req.Reason)
+	log.WithFields(logrus.Fields{
+		"peer":     id,
+		"duration": duration,
+		"reason":   req.Reason,
+	}).Info("Manually banned peer")
+	if state, err := peerStatus.ConnectionState(id); err == nil && state == peers.PeerConnected && s.PeerManager != nil {
+		if err := s.PeerManager.Disconnect(id); err != nil {
+			log.WithError(err).WithField("peer", id).Error("Could not disconnect from banned peer")
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// UnbanPeer lifts a manual ban of the given peer.
+func (s *Server) UnbanPeer(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.UnbanPeer")
+	defer span.End()
+
+	id, ok := peerIdFromRoute(w, r)
+	if !ok {
+		return
+	}
+	if err := s.PeersFetcher.Peers().Unban(id); err != nil {
+		if errors.Is(err, peerdata.ErrPeerUnknown) {
+			httputil.HandleError(w, "Peer not found: "+err.Error(), http.StatusNotFound)
+			return
+		}
+		httputil.HandleError(w, "Could not unban peer: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	log.WithField("peer", id).Info("Unbanned peer")
+	w.WriteHeader(http.StatusOK)
+}
+
+func peerIdFromRoute(w http.ResponseWriter, r *http.Request) (peer.ID, bool) {
+	rawId := mux.Vars(r)["peer_id"]
+	if rawId == "" {
+		httputil.HandleError(w, "peer_id is required in URL params", http.StatusBadRequest)
+		return "", false
+	}
+	id, err := peer.Decode(rawId)
+	if err != nil {
+		httputil.HandleError(w, "Invalid peer ID: "+err.Error(), http.StatusBadRequest)
+		return "", false
+	}
+	return id, true
+}
+
+// peerScore returns the scores of the given peer, along with its total score for sorting.
+func peerScore(peerStatus *peers.Status, id peer.ID) (*structs.PeerScore, float64, error) {
+	connState, err := peerStatus.ConnectionState(id)
+	if err != nil {
+		return nil, 0, err
+	}
+	direction, err := peerStatus.Direction(id)
+	if err != nil {
+		return nil, 0, err
+	}
+	address, err := peerStatus.Address(id)
+	if err != nil {
+		return nil, 0, err
+	}
+	scorers := peerStatus.Scorers()
+	badResponses, err := scorers.BadResponsesScorer().Count(id)
+	if err != nil {
+		return nil, 0, err
+	}
+	gossipScore, behaviourPenalty, topicScores, err := scorers.GossipScorer().GossipData(id)
+	if err != nil {
+		return nil, 0, err
+	}
+	scores := scorers.Scores(id)
+	ps := &structs.PeerScore{
+		PeerId:             id.String(),
+		State:              eth.ConnectionState(connState).String(),
+		Direction:          eth.PeerDirection(direction).String(),
+		Trusted:            peerStatus.IsTrustedPeers(id),
+		Score:              formatScore(scores.Total),
+		BadResponsesScore:  formatScore(scores.BadResponses),
+		BlockProviderScore: formatScore(scores.BlockProvider),
+		PeerStatusScore:    formatScore(scores.PeerStatus),
+		GossipScore:        formatScore(gossipScore),
+		BehaviourPenalty:   formatScore(behaviourPenalty),
+		TopicScores:        make(map[string]*structs.TopicScore, len(topicScores)),
+		BadResponses:       strconv.Itoa(badResponses),
+		ProcessedBlocks:    strconv.FormatUint(scorers.BlockProviderScorer().ProcessedBlocks(id), 10),
+		BanReasons:         []string{},
+	}
+	if address != nil {
+		ps.Address = address.String()
+	}
+	for topic, ts := range topicScores {
+		ps.TopicScores[topic] = &structs.TopicScore{
+			TimeInMesh:               strconv.FormatUint(ts.TimeInMesh, 10),
+			FirstMessageDeliveries:   strconv.FormatFloat(float64(ts.FirstMessageDeliveries), 'f', -1, 32),
+			MeshMessageDeliveries:    strconv.FormatFloat(float64(ts.MeshMessageDeliveries), 'f', -1, 32),
+			InvalidMessageDeliveries: strconv.FormatFloat(float64(ts.InvalidMessageDeliveries), 'f', -1, 32),
+		}
+	}
+	if ban := peerStatus.BanInfo(id); ban != nil {
+		ps.Banned = true
+		ps.BanReasons = ban.Reasons
+		if !ban.Until.IsZero() {
+			left := ban.Until.Sub(prysmTime.Now())
+			if left < 0 {
+				left = 0
+			}
+			ps.BanTimeLeft = strconv.FormatInt(int64(left.Seconds()), 10)
+		}
+	}
+	return ps, scores.Total, nil
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'f', -1, 64)
+}
@@ -0,0 +1,139 @@
+package node
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gorilla/mux"
+	corenet "github.com/libp2p/go-libp2p/core/network"
+	libp2ptest "github.com/libp2p/go-libp2p/p2p/host/peerstore/test"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestListPeerScores(t *testing.T) {
+	ids := libp2ptest.GeneratePeerIDs(3)
+	peerFetcher := &mockp2p.MockPeersProvider{}
+	peerFetcher.ClearPeers()
+	peerStatus := peerFetcher.Peers()
+	for i, id := range ids {
+		addr, err := ma.NewMultiaddr("/ip4/127.0.0." + strconv.Itoa(i) + "/tcp/13000")
+		require.NoError(t, err)
+		peerStatus.Add(nil, id, addr, corenet.DirOutbound)
+		peerStatus.SetConnectionState(id, peers.PeerConnected)
+	}
+	peerStatus.Scorers().BadResponsesScorer().Increment(ids[1])
+	peerStatus.Scorers().GossipScorer().SetGossipData(ids[2], 1.5, -0.5, map[string]*pb.TopicScoreSnapshot{
+		"beacon_block": {TimeInMesh: 12000, FirstMessageDeliveries: 3, MeshMessageDeliveries: 2, InvalidMessageDeliveries: 1},
+	})
+	peerStatus.Ban(ids[0], 0, "testing")
+	s := Server{PeersFetcher: peerFetcher}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/peers/scores", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.ListPeerScores(writer, request)
+	assert.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.PeerScoresResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 3, len(resp.Data))
+
+	// Peers are sorted from the lowest score.
+	bad, banned, gossiping := resp.Data[0], resp.Data[1], resp.Data[2]
+	assert.Equal(t, ids[1].String(), bad.PeerId)
+	assert.Equal(t, "1", bad.BadResponses)
+	assert.Equal(t, "-2", bad.BadResponsesScore)
+	assert.Equal(t, false, bad.Banned)
+	assert.Equal(t, 0, len(bad.BanReasons))
+
+	assert.Equal(t, ids[0].String(), banned.PeerId)
+	assert.Equal(t, true, banned.Banned)
+	assert.DeepEqual(t, []string{"manually banned: testing"}, banned.BanReasons)
+	assert.Equal(t, "", banned.BanTimeLeft)
+	assert.Equal(t, "CONNECTED", banned.State)
+	assert.Equal(t, "OUTBOUND", banned.Direction)
+	assert.Equal(t, "/ip4/127.0.0.0/tcp/13000", banned.Address)
+
+	assert.Equal(t, ids[2].String(), gossiping.PeerId)
+	assert.Equal(t, "1.5", gossiping.GossipScore)
+	assert.Equal(t, "-0.5", gossiping.BehaviourPenalty)
+	require.NotNil(t, gossiping.TopicScores["beacon_block"])
+	assert.DeepEqual(t, &structs.TopicScore{
+		TimeInMesh:               "12000",
+		FirstMessageDeliveries:   "3",
+		MeshMessageDeliveries:    "2",
+		InvalidMessageDeliveries: "1",
+	}, gossiping.TopicScores["beacon_block"])
+}
+
+func TestBanPeer(t *testing.T) {
+	id := libp2ptest.GeneratePeerIDs(1)[0]
+	peerFetcher := &mockp2p.MockPeersProvider{}
+	peerFetcher.ClearPeers()
+	addr, err := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/13000")
+	require.NoError(t, err)
+	peerFetcher.Peers().Add(nil, id, addr, corenet.DirOutbound)
+	peerFetcher.Peers().SetConnectionState(id, peers.PeerConnected)
+	s := Server{PeersFetcher: peerFetcher, PeerManager: &mockp2p.MockPeerManager{}}
+
+	t.Run("ban", func(t *testing.T) {
+		body, err := json.Marshal(&structs.BanPeerRequest{Duration: "3600", Reason: "spam"})
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader(body))
+		request = mux.SetURLVars(request, map[string]string{"peer_id": id.String()})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.BanPeer(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		info := peerFetcher.Peers().BanInfo(id)
+		require.NotNil(t, info)
+		assert.DeepEqual(t, []string{"manually banned: spam"}, info.Reasons)
+		assert.Equal(t, false, info.Until.IsZero())
+	})
+	t.Run("unban", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodDelete, "http://example.com", nil)
+		request = mux.SetURLVars(request, map[string]string{"peer_id": id.String()})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.UnbanPeer(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, false, peerFetcher.Peers().IsBad(id))
+	})
+	t.Run("unban unknown peer", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodDelete, "http://example.com", nil)
+		request = mux.SetURLVars(request, map[string]string{"peer_id": libp2ptest.GeneratePeerIDs(1)[0].String()})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.UnbanPeer(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("invalid duration", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", bytes.NewBufferString(`{"duration":"-1"}`))
+		request = mux.SetURLVars(request, map[string]string{"peer_id": id.String()})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.BanPeer(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Invalid duration", e.Message)
+	})
+	t.Run("invalid peer id", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+		request = mux.SetURLVars(request, map[string]string{"peer_id": "foo"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.BanPeer(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
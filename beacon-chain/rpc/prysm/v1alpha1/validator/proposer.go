@@ -25,6 +25,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
@@ -74,9 +75,34 @@ func (vs *Server) GetBeaconBlock(ctx context.Context, req *ethpb.BlockRequest) (
 	if err != nil {
 		return nil, err
 	}
+	sBlk, _, err := vs.buildBeaconBlock(ctx, req, head, parentRoot, false /* dryRun */)
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"slot":               req.Slot,
+		"sinceSlotStartTime": time.Since(t),
+		"validator":          sBlk.Block().ProposerIndex(),
+	}).Info("Finished building block")
+
+	// Blob cache is updated after BuildBlockParallel
+	return vs.constructGenericBeaconBlock(sBlk, bundleCache.get(req.Slot))
+}
+
+// buildBeaconBlock builds the block of the request on top of the given parent state. A dry run has no side effects on
+// the node: attestations are not pruned from the pool, builders are not queried, and the local payload is requested
+// independently of the payload of an actual proposal. Its blobs bundle is returned in a dry run, and cached otherwise.
+func (vs *Server) buildBeaconBlock(
+	ctx context.Context,
+	req *ethpb.BlockRequest,
+	head state.BeaconState,
+	parentRoot [32]byte,
+	dryRun bool,
+) (interfaces.SignedBeaconBlock, *enginev1.BlobsBundle, error) {
 	sBlk, err := getEmptyBlock(req.Slot)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not prepare block: %v", err)
+		return nil, nil, status.Errorf(codes.Internal, "Could not prepare block: %v", err)
 	}
 	// Set slot, graffiti, randao reveal, and parent root.
 	sBlk.SetSlot(req.Slot)
@@ -87,7 +113,7 @@ func (vs *Server) GetBeaconBlock(ctx context.Context, req *ethpb.BlockRequest) (
 	// Set proposer index.
 	idx, err := helpers.BeaconProposerIndex(ctx, head)
 	if err != nil {
-		return nil, fmt.Errorf("could not calculate proposer index %v", err)
+		return nil, nil, fmt.Errorf("could not calculate proposer index %v", err)
 	}
 	sBlk.SetProposerIndex(idx)
 
@@ -96,24 +122,17 @@ func (vs *Server) GetBeaconBlock(ctx context.Context, req *ethpb.BlockRequest) (
 		builderBoostFactor = req.BuilderBoostFactor.Value
 	}
 
-	if err = vs.BuildBlockParallel(ctx, sBlk, head, req.SkipMevBoost, builderBoostFactor); err != nil {
-		return nil, errors.Wrap(err, "could not build block in parallel")
+	bundle, err := vs.buildBlockParallel(ctx, sBlk, head, req.SkipMevBoost || dryRun, builderBoostFactor, dryRun)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not build block in parallel")
 	}
 
 	sr, err := vs.computeStateRoot(ctx, sBlk)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not compute state root: %v", err)
+		return nil, nil, status.Errorf(codes.Internal, "Could not compute state root: %v", err)
 	}
 	sBlk.SetStateRoot(sr)
-
-	log.WithFields(logrus.Fields{
-		"slot":               req.Slot,
-		"sinceSlotStartTime": time.Since(t),
-		"validator":          sBlk.Block().ProposerIndex(),
-	}).Info("Finished building block")
-
-	// Blob cache is updated after BuildBlockParallel
-	return vs.constructGenericBeaconBlock(sBlk, bundleCache.get(req.Slot))
+	return sBlk, bundle, nil
 }
 
 func (vs *Server) handleSuccesfulReorgAttempt(ctx context.Context, slot primitives.Slot, parentRoot, headRoot [32]byte) (state.BeaconState, error) {
@@ -185,6 +204,18 @@ func (vs *Server) getParentState(ctx context.Context, slot primitives.Slot) (sta
 }
 
 func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.SignedBeaconBlock, head state.BeaconState, skipMevBoost bool, builderBoostFactor uint64) error {
+	_, err := vs.buildBlockParallel(ctx, sBlk, head, skipMevBoost, builderBoostFactor, false /* dryRun */)
+	return err
+}
+
+func (vs *Server) buildBlockParallel(
+	ctx context.Context,
+	sBlk interfaces.SignedBeaconBlock,
+	head state.BeaconState,
+	skipMevBoost bool,
+	builderBoostFactor uint64,
+	dryRun bool,
+) (*enginev1.BlobsBundle, error) {
 	// Build consensus fields in background
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -200,18 +231,10 @@ func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.Signed
 		sBlk.SetEth1Data(eth1Data)
 
 		// Set deposit and attestation.
-		deposits, atts, err := vs.packDepositsAndAttestations(ctx, head, sBlk.Block().Slot(), eth1Data) // TODO: split attestations and deposits
-		if err != nil {
-			sBlk.SetDeposits([]*ethpb.Deposit{})
-			if err := sBlk.SetAttestations([]ethpb.Att{}); err != nil {
-				log.WithError(err).Error("Could not set attestations on block")
-			}
-			log.WithError(err).Error("Could not pack deposits and attestations")
-		} else {
-			sBlk.SetDeposits(deposits)
-			if err := sBlk.SetAttestations(atts); err != nil {
-				log.WithError(err).Error("Could not set attestations on block")
-			}
+		deposits, atts := vs.packDepositsAndAttestations(ctx, head, sBlk.Block().Slot(), eth1Data, dryRun)
+		sBlk.SetDeposits(deposits)
+		if err := sBlk.SetAttestations(atts); err != nil {
+			log.WithError(err).Error("Could not set attestations on block")
 		}
 
 		// Set slashings.
@@ -231,9 +254,17 @@ func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.Signed
 		vs.setBlsToExecData(sBlk, head)
 	}()
 
-	localPayload, overrideBuilder, err := vs.getLocalPayload(ctx, sBlk.Block(), head)
+	var localPayload interfaces.ExecutionData
+	var bundle *enginev1.BlobsBundle
+	var overrideBuilder bool
+	var err error
+	if dryRun {
+		localPayload, bundle, err = vs.getDryRunLocalPayload(ctx, sBlk.Block(), head)
+	} else {
+		localPayload, overrideBuilder, err = vs.getLocalPayload(ctx, sBlk.Block(), head)
+	}
 	if err != nil {
-		return status.Errorf(codes.Internal, "Could not get local payload: %v", err)
+		return nil, status.Errorf(codes.Internal, "Could not get local payload: %v", err)
 	}
 
 	// There's no reason to try to get a builder bid if local override is true.
@@ -248,13 +279,18 @@ func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.Signed
 		}
 	}
 
-	if err := setExecutionData(ctx, sBlk, localPayload, builderPayload, builderKzgCommitments, builderBoostFactor); err != nil {
-		return status.Errorf(codes.Internal, "Could not set execution data: %v", err)
+	if dryRun {
+		// A dry run never queries the builder, and its blobs bundle is not in the bundle cache.
+		if err := setDryRunExecution(sBlk, localPayload, bundle); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not set execution data: %v", err)
+		}
+	} else if err := setExecutionData(ctx, sBlk, localPayload, builderPayload, builderKzgCommitments, builderBoostFactor); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not set execution data: %v", err)
 	}
 
 	wg.Wait() // Wait until block is built via consensus and execution fields.
 
-	return nil
+	return bundle, nil
 }
 
 // ProposeBeaconBlock handles the proposal of beacon blocks.
@@ -272,7 +308,8 @@ func (vs *Server) ProposeBeaconBlock(ctx context.Context, req *ethpb.GenericSign
 	}
 
 	var sidecars []*ethpb.BlobSidecar
-	if block.IsBlinded() {
+	blinded := block.IsBlinded()
+	if blinded {
 		block, sidecars, err = vs.handleBlindedBlock(ctx, block)
 	} else {
 		sidecars, err = vs.handleUnblindedBlock(block, req)
@@ -308,6 +345,14 @@ func (vs *Server) ProposeBeaconBlock(ctx context.Context, req *ethpb.GenericSign
 		return nil, status.Errorf(codes.Internal, "Could not broadcast/receive block: %v", err)
 	}
 
+	// Blinded blocks are only proposed with a payload from a builder. The source of the payload is kept for the
+	// reporting of proposer rewards, so failing to save it does not fail the proposal.
+	if block.Version() >= version.Bellatrix {
+		if err := vs.BeaconDB.SavePayloadFromBuilder(ctx, root, blinded); err != nil {
+			log.WithError(err).Error("Could not save payload source")
+		}
+	}
+
 	return &ethpb.ProposeResponse{BlockRoot: root[:]}, nil
 }
 
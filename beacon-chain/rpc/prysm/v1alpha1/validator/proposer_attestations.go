@@ -22,12 +22,21 @@ import (
 
 type proposerAtts []ethpb.Att
 
-func (vs *Server) packAttestations(ctx context.Context, latestState state.BeaconState, blkSlot primitives.Slot) ([]ethpb.Att, error) {
+func (vs *Server) packAttestations(ctx context.Context, latestState state.BeaconState, blkSlot primitives.Slot, dryRun bool) ([]ethpb.Att, error) {
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.packAttestations")
 	defer span.End()
 
+	// Invalid attestations are only deleted from the pool for actual proposals.
+	validAtts := vs.validateAndDeleteAttsInPool
+	if dryRun {
+		validAtts = func(ctx context.Context, st state.BeaconState, atts []ethpb.Att) ([]ethpb.Att, error) {
+			valid, _ := proposerAtts(atts).filter(ctx, st)
+			return valid, nil
+		}
+	}
+
 	atts := vs.AttPool.AggregatedAttestations()
-	atts, err := vs.validateAndDeleteAttsInPool(ctx, latestState, atts)
+	atts, err := validAtts(ctx, latestState, atts)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not filter attestations")
 	}
@@ -36,7 +45,7 @@ func (vs *Server) packAttestations(ctx context.Context, latestState state.Beacon
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get unaggregated attestations")
 	}
-	uAtts, err = vs.validateAndDeleteAttsInPool(ctx, latestState, uAtts)
+	uAtts, err = validAtts(ctx, latestState, uAtts)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not filter attestations")
 	}
@@ -105,9 +114,23 @@ func (vs *Server) packAttestations(ctx context.Context, latestState state.Beacon
 	if err != nil {
 		return nil, err
 	}
-	sorted, err := deduped.sortByProfitability()
-	if err != nil {
-		return nil, err
+	var sorted proposerAtts
+	if latestState.Version() >= version.Altair {
+		var reward uint64
+		sorted, reward, err = deduped.sortByReward(ctx, latestState)
+		if err == nil {
+			if !dryRun {
+				packedAttestationsRewardGauge.Set(float64(reward))
+			}
+		} else {
+			log.WithError(err).Warn("Could not sort attestations by reward, sorting them by profitability instead")
+		}
+	}
+	if sorted == nil {
+		sorted, err = deduped.sortByProfitability()
+		if err != nil {
+			return nil, err
+		}
 	}
 	atts = sorted.limitToMaxAttestations()
 	return atts, nil
@@ -210,19 +233,20 @@ func (a proposerAtts) limitToMaxAttestations() proposerAtts {
 	if len(a) == 0 {
 		return a
 	}
-
-	var limit uint64
-	if a[0].Version() == version.Phase0 {
-		limit = params.BeaconConfig().MaxAttestations
-	} else {
-		limit = params.BeaconConfig().MaxAttestationsElectra
-	}
-	if uint64(len(a)) > limit {
+	if limit := a.maxAttestations(); uint64(len(a)) > limit {
 		return a[:limit]
 	}
 	return a
 }
 
+// maxAttestations returns the maximum attestations per block for the version of the attestations.
+func (a proposerAtts) maxAttestations() uint64 {
+	if a[0].Version() == version.Phase0 {
+		return params.BeaconConfig().MaxAttestations
+	}
+	return params.BeaconConfig().MaxAttestationsElectra
+}
+
 // dedup removes duplicate attestations (ones with the same bits set on).
 // Important: not only exact duplicates are removed, but proper subsets are removed too
 // (their known bits are redundant and are already contained in their supersets).
@@ -0,0 +1,400 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+var packedAttestationsRewardGauge = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "proposer_packed_attestations_reward_gwei",
+	Help: "Expected proposer reward in gwei of the attestations packed in the last proposed block",
+})
+
+// maxEnumeratedAttestations is the size up to which all subsets of a group of overlapping attestations are evaluated.
+// Larger groups are packed greedily.
+const maxEnumeratedAttestations = 8
+
+// participationFlag identifies a participation flag of a validator in the previous or current epoch.
+type participationFlag uint64
+
+func newParticipationFlag(index uint64, currentEpoch bool, flagIndex uint8) participationFlag {
+	f := index<<3 | uint64(flagIndex)
+	if currentEpoch {
+		f |= 1 << 2
+	}
+	return participationFlag(f)
+}
+
+// rewardCandidate is an attestation along with the participation flags it would set in the state.
+type rewardCandidate struct {
+	att          ethpb.Att
+	indices      []uint64
+	currentEpoch bool
+	attFlags     map[uint8]bool
+	// flags are the participation flags set by the attestation which are not yet set in the state.
+	flags       []participationFlag
+	flagRewards []uint64
+	// reward is the proposer reward numerator of the attestation when included on its own.
+	reward uint64
+}
+
+// sortByReward orders attestations so that the attestations which fit in a block are the ones with the highest proposer
+// reward, as paid by the state for each participation flag they set in the previous or current epoch participation.
+// Attestations which overlap are evaluated together, so that flags set by several attestations are only counted once.
+// It returns the expected proposer reward, in gwei, of the attestations which fit in a block.
+func (a proposerAtts) sortByReward(ctx context.Context, st state.BeaconState) (proposerAtts, uint64, error) {
+	if st.Version() < version.Altair {
+		return nil, 0, errors.New("proposer rewards are not paid per participation flag before Altair")
+	}
+	if len(a) == 0 {
+		return a, 0, nil
+	}
+	limit := int(a.maxAttestations())
+
+	candidates, leftover, err := a.rewardCandidates(ctx, st)
+	if err != nil {
+		return nil, 0, err
+	}
+	weights := make(map[participationFlag]uint64)
+	for _, c := range candidates {
+		for i, f := range c.flags {
+			weights[f] = c.flagRewards[i]
+		}
+	}
+
+	// Candidates which share no flag contribute independently of each other, so the subset of each group of
+	// overlapping candidates is chosen separately, and the number of attestations is then split between groups.
+	groups := groupOverlappingCandidates(candidates)
+	subsets := make([][][]int, len(groups))
+	values := make([][]uint64, len(groups))
+	for i, g := range groups {
+		subsets[i], values[i] = bestSubsets(g, weights, limit)
+	}
+	// best[j] is the highest reward numerator of at most j attestations from the groups evaluated so far, and
+	// choices[i][j] is how many of them come from group i.
+	best := make([]uint64, limit+1)
+	choices := make([][]int, len(groups))
+	for i := range groups {
+		next := make([]uint64, limit+1)
+		choices[i] = make([]int, limit+1)
+		for j := 0; j <= limit; j++ {
+			for k := 0; k <= j && k < len(values[i]); k++ {
+				if v := best[j-k] + values[i][k]; v > next[j] {
+					next[j] = v
+					choices[i][j] = k
+				}
+			}
+		}
+		best = next
+	}
+
+	var selected []*rewardCandidate
+	chosen := make(map[*rewardCandidate]bool)
+	for i, j := len(groups)-1, limit; i >= 0; i-- {
+		k := choices[i][j]
+		for _, idx := range subsets[i][k] {
+			selected = append(selected, groups[i][idx])
+			chosen[groups[i][idx]] = true
+		}
+		j -= k
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		if selected[i].reward != selected[j].reward {
+			return selected[i].reward > selected[j].reward
+		}
+		return selected[i].att.GetData().Slot > selected[j].att.GetData().Slot
+	})
+	for _, c := range candidates {
+		if !chosen[c] {
+			leftover = append(leftover, c.att)
+		}
+	}
+	leftover, err = leftover.sortByProfitability()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sorted := make(proposerAtts, 0, len(a))
+	for _, c := range selected {
+		sorted = append(sorted, c.att)
+	}
+	sorted = append(sorted, leftover...)
+	reward, err := sorted.limitToMaxAttestations().proposerReward(ctx, st)
+	if err != nil {
+		return nil, 0, err
+	}
+	return sorted, reward, nil
+}
+
+// rewardCandidates returns the attestations which would set participation flags in the state, and the ones which would
+// not.
+func (a proposerAtts) rewardCandidates(ctx context.Context, st state.BeaconState) ([]*rewardCandidate, proposerAtts, error) {
+	cfg := params.BeaconConfig()
+	flagWeights := map[uint8]uint64{
+		cfg.TimelySourceFlagIndex: cfg.TimelySourceWeight,
+		cfg.TimelyTargetFlagIndex: cfg.TimelyTargetWeight,
+		cfg.TimelyHeadFlagIndex:   cfg.TimelyHeadWeight,
+	}
+	totalBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get total active balance")
+	}
+	currentParticipation, err := st.CurrentEpochParticipation()
+	if err != nil {
+		return nil, nil, err
+	}
+	previousParticipation, err := st.PreviousEpochParticipation()
+	if err != nil {
+		return nil, nil, err
+	}
+	currentEpoch := time.CurrentEpoch(st)
+	baseRewards := make(map[uint64]uint64)
+
+	candidates := make([]*rewardCandidate, 0, len(a))
+	var leftover proposerAtts
+	for _, att := range a {
+		c, err := newRewardCandidate(ctx, st, att)
+		if err != nil {
+			return nil, nil, err
+		}
+		c.currentEpoch = att.GetData().Target.Epoch == currentEpoch
+		participation := previousParticipation
+		if c.currentEpoch {
+			participation = currentParticipation
+		}
+		for _, index := range c.indices {
+			if index >= uint64(len(participation)) {
+				return nil, nil, fmt.Errorf("index %d exceeds participation length %d", index, len(participation))
+			}
+			br, ok := baseRewards[index]
+			if !ok {
+				br, err = altair.BaseRewardWithTotalBalance(st, primitives.ValidatorIndex(index), totalBalance)
+				if err != nil {
+					return nil, nil, err
+				}
+				baseRewards[index] = br
+			}
+			for flagIndex, weight := range flagWeights {
+				if !c.attFlags[flagIndex] || (participation[index]>>flagIndex)&1 == 1 {
+					continue
+				}
+				c.flags = append(c.flags, newParticipationFlag(index, c.currentEpoch, flagIndex))
+				c.flagRewards = append(c.flagRewards, br*weight)
+				c.reward += br * weight
+			}
+		}
+		if len(c.flags) == 0 {
+			leftover = append(leftover, att)
+			continue
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates, leftover, nil
+}
+
+func newRewardCandidate(ctx context.Context, st state.BeaconState, att ethpb.Att) (*rewardCandidate, error) {
+	delay, err := st.Slot().SafeSubSlot(att.GetData().Slot)
+	if err != nil {
+		return nil, fmt.Errorf("att slot %d can't be greater than state slot %d", att.GetData().Slot, st.Slot())
+	}
+	attFlags, err := altair.AttestationParticipationFlagIndices(st, att.GetData(), delay)
+	if err != nil {
+		return nil, err
+	}
+	var committees [][]primitives.ValidatorIndex
+	if att.Version() < version.Electra {
+		committee, err := helpers.BeaconCommitteeFromState(ctx, st, att.GetData().Slot, att.GetData().CommitteeIndex)
+		if err != nil {
+			return nil, err
+		}
+		committees = [][]primitives.ValidatorIndex{committee}
+	} else {
+		committeeIndices := helpers.CommitteeIndices(att.GetCommitteeBitsVal())
+		committees = make([][]primitives.ValidatorIndex, len(committeeIndices))
+		for i, ci := range committeeIndices {
+			committees[i], err = helpers.BeaconCommitteeFromState(ctx, st, att.GetData().Slot, ci)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	indices, err := attestation.AttestingIndices(att, committees...)
+	if err != nil {
+		return nil, err
+	}
+	return &rewardCandidate{att: att, indices: indices, attFlags: attFlags}, nil
+}
+
+// groupOverlappingCandidates splits candidates into groups, so that candidates which set the same flag are in the same
+// group. Groups keep the order of the candidates.
+func groupOverlappingCandidates(candidates []*rewardCandidate) [][]*rewardCandidate {
+	parents := make([]int, len(candidates))
+	for i := range parents {
+		parents[i] = i
+	}
+	find := func(i int) int {
+		for parents[i] != i {
+			parents[i] = parents[parents[i]]
+			i = parents[i]
+		}
+		return i
+	}
+	owners := make(map[participationFlag]int)
+	for i, c := range candidates {
+		for _, f := range c.flags {
+			if j, ok := owners[f]; ok {
+				parents[find(i)] = find(j)
+				continue
+			}
+			owners[f] = i
+		}
+	}
+
+	var groups [][]*rewardCandidate
+	groupByRoot := make(map[int]int)
+	for i, c := range candidates {
+		root := find(i)
+		g, ok := groupByRoot[root]
+		if !ok {
+			g = len(groups)
+			groupByRoot[root] = g
+			groups = append(groups, nil)
+		}
+		groups[g] = append(groups[g], c)
+	}
+	return groups
+}
+
+// bestSubsets returns, for each number of attestations k up to the limit, the positions of the attestations of the
+// group whose flags are worth the most, along with the reward numerator of those flags. All subsets are evaluated for
+// small groups, and attestations are picked greedily for larger ones.
+func bestSubsets(group []*rewardCandidate, weights map[participationFlag]uint64, limit int) ([][]int, []uint64) {
+	n := len(group)
+	if n > limit {
+		n = limit
+	}
+	subsets := make([][]int, n+1)
+	values := make([]uint64, n+1)
+	subsets[0] = []int{}
+	// counts is how many of the chosen attestations set each flag.
+	counts := make(map[participationFlag]int)
+	var chosen []int
+	var value uint64
+	add := func(i int) {
+		chosen = append(chosen, i)
+		for _, f := range group[i].flags {
+			if counts[f] == 0 {
+				value += weights[f]
+			}
+			counts[f]++
+		}
+	}
+	remove := func() {
+		i := chosen[len(chosen)-1]
+		chosen = chosen[:len(chosen)-1]
+		for _, f := range group[i].flags {
+			counts[f]--
+			if counts[f] == 0 {
+				value -= weights[f]
+			}
+		}
+	}
+
+	if len(group) <= maxEnumeratedAttestations {
+		var visit func(i int)
+		visit = func(i int) {
+			if i == len(group) {
+				k := len(chosen)
+				if subsets[k] == nil || value > values[k] {
+					subsets[k] = append([]int{}, chosen...)
+					values[k] = value
+				}
+				return
+			}
+			visit(i + 1)
+			if len(chosen) < n {
+				add(i)
+				visit(i + 1)
+				remove()
+			}
+		}
+		visit(0)
+		return subsets, values
+	}
+
+	picked := make([]bool, len(group))
+	for k := 1; k <= n; k++ {
+		next, nextGain := -1, uint64(0)
+		for i, c := range group {
+			if picked[i] {
+				continue
+			}
+			var gain uint64
+			for _, f := range c.flags {
+				if counts[f] == 0 {
+					gain += weights[f]
+				}
+			}
+			if next == -1 || gain > nextGain {
+				next, nextGain = i, gain
+			}
+		}
+		picked[next] = true
+		add(next)
+		subsets[k] = append([]int{}, chosen...)
+		values[k] = value
+	}
+	return subsets, values
+}
+
+// proposerReward returns the proposer reward, in gwei, of including the attestations in a block in the given order.
+func (a proposerAtts) proposerReward(ctx context.Context, st state.BeaconState) (uint64, error) {
+	cfg := params.BeaconConfig()
+	totalBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get total active balance")
+	}
+	currentParticipation, err := st.CurrentEpochParticipation()
+	if err != nil {
+		return 0, err
+	}
+	previousParticipation, err := st.PreviousEpochParticipation()
+	if err != nil {
+		return 0, err
+	}
+	currentEpoch := time.CurrentEpoch(st)
+	denominator := (cfg.WeightDenominator - cfg.ProposerWeight) * cfg.WeightDenominator / cfg.ProposerWeight
+
+	var reward uint64
+	for _, att := range a {
+		c, err := newRewardCandidate(ctx, st, att)
+		if err != nil {
+			return 0, err
+		}
+		var numerator uint64
+		if att.GetData().Target.Epoch == currentEpoch {
+			numerator, currentParticipation, err = altair.EpochParticipation(st, c.indices, currentParticipation, c.attFlags, totalBalance)
+		} else {
+			numerator, previousParticipation, err = altair.EpochParticipation(st, c.indices, previousParticipation, c.attFlags, totalBalance)
+		}
+		if err != nil {
+			return 0, err
+		}
+		reward += numerator / denominator
+	}
+	return reward, nil
+}
@@ -2,10 +2,14 @@ package validator
 
 import (
 	"bytes"
+	"context"
 	"sort"
 	"testing"
 
 	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
@@ -423,3 +427,97 @@ func TestProposer_ProposerAtts_dedup(t *testing.T) {
 		})
 	}
 }
+
+func TestProposer_ProposerAtts_sortByReward(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.MaxAttestations = 2
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	// With 256 validators, the committee of each slot has 8 validators.
+	st, _ := util.DeterministicGenesisStateAltair(t, 256)
+	require.NoError(t, st.SetSlot(1))
+	newAtt := func(bits byte) ethpb.Att {
+		return util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{bits, 0b1}})
+	}
+	largest := newAtt(0b01111110)
+	first := newAtt(0b00001111)
+	second := newAtt(0b11110000)
+
+	br, err := altair.BaseReward(st, 0)
+	require.NoError(t, err)
+	weight := cfg.TimelySourceWeight + cfg.TimelyTargetWeight + cfg.TimelyHeadWeight
+	denominator := (cfg.WeightDenominator - cfg.ProposerWeight) * cfg.WeightDenominator / cfg.ProposerWeight
+	// reward is the proposer reward of an attestation setting all flags of the given number of validators.
+	reward := func(validators uint64) uint64 {
+		return validators * br * weight / denominator
+	}
+
+	t.Run("disjoint attestations over the largest one", func(t *testing.T) {
+		sorted, r, err := proposerAtts{largest, first, second}.sortByReward(ctx, st)
+		require.NoError(t, err)
+		require.DeepEqual(t, proposerAtts{first, second, largest}, sorted)
+		assert.Equal(t, 2*reward(4), r)
+
+		// Sorting by profitability only covers 7 validators.
+		sorted, err = proposerAtts{largest, first, second}.sortByProfitability()
+		require.NoError(t, err)
+		r, err = sorted.limitToMaxAttestations().proposerReward(ctx, st)
+		require.NoError(t, err)
+		assert.Equal(t, reward(6)+reward(1), r)
+	})
+
+	t.Run("flags already set", func(t *testing.T) {
+		st := st.Copy()
+		committee, err := helpers.BeaconCommitteeFromState(ctx, st, 0, 0)
+		require.NoError(t, err)
+		participation, err := st.CurrentEpochParticipation()
+		require.NoError(t, err)
+		for _, index := range committee[:4] {
+			participation[index] = 0b111
+		}
+		require.NoError(t, st.SetCurrentParticipationBits(participation))
+
+		sorted, r, err := proposerAtts{largest, first, second}.sortByReward(ctx, st)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(sorted))
+		assert.DeepEqual(t, second, sorted[0])
+		assert.Equal(t, reward(4), r)
+	})
+
+	t.Run("no attestations", func(t *testing.T) {
+		sorted, r, err := proposerAtts{}.sortByReward(ctx, st)
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(sorted))
+		assert.Equal(t, uint64(0), r)
+	})
+
+	t.Run("phase0 state", func(t *testing.T) {
+		st, _ := util.DeterministicGenesisState(t, 256)
+		_, _, err := proposerAtts{largest}.sortByReward(ctx, st)
+		assert.ErrorContains(t, "before Altair", err)
+	})
+}
+
+func TestProposer_bestSubsets(t *testing.T) {
+	weights := map[participationFlag]uint64{1: 5, 2: 4, 3: 4, 4: 1}
+	group := []*rewardCandidate{
+		{flags: []participationFlag{1, 2}},
+		{flags: []participationFlag{2, 3}},
+		{flags: []participationFlag{1, 4}},
+		{flags: []participationFlag{3}},
+	}
+	// The most valuable pair does not contain the most valuable attestation.
+	subsets, values := bestSubsets(group, weights, 3)
+	assert.DeepEqual(t, [][]int{{}, {0}, {1, 2}, {1, 2, 3}}, subsets)
+	assert.DeepEqual(t, []uint64{0, 9, 14, 14}, values)
+
+	// Groups too large to evaluate every subset are packed greedily.
+	for len(group) <= maxEnumeratedAttestations {
+		group = append(group, &rewardCandidate{flags: []participationFlag{4}})
+	}
+	subsets, values = bestSubsets(group, weights, 2)
+	assert.DeepEqual(t, [][]int{{}, {0}, {0, 1}}, subsets)
+	assert.DeepEqual(t, []uint64{0, 9, 13}, values)
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/math"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
 	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/sirupsen/logrus"
@@ -320,6 +321,19 @@ func setLocalExecution(blk interfaces.SignedBeaconBlock, execution interfaces.Ex
 	return setExecution(blk, execution, false, kzgCommitments)
 }
 
+// setDryRunExecution sets the execution context of a simulated block from the locally built
+// payload and its blobs bundle, without reading the bundle cache of actual proposals.
+func setDryRunExecution(blk interfaces.SignedBeaconBlock, execution interfaces.ExecutionData, bundle *enginev1.BlobsBundle) error {
+	if slots.ToEpoch(blk.Block().Slot()) < params.BeaconConfig().BellatrixForkEpoch {
+		return nil
+	}
+	var kzgCommitments [][]byte
+	if bundle != nil {
+		kzgCommitments = bundle.KzgCommitments
+	}
+	return setExecution(blk, execution, false, kzgCommitments)
+}
+
 // setBuilderExecution sets the execution context for a builder's beacon block.
 // It delegates to setExecution for the actual work.
 func setBuilderExecution(blk interfaces.SignedBeaconBlock, execution interfaces.ExecutionData, builderKzgCommitments [][]byte) error {
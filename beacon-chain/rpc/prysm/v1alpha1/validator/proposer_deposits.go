@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"math/big"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
@@ -16,54 +17,46 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
-	"golang.org/x/sync/errgroup"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
+// packDepositsAndAttestations packs the deposits and the attestations of a block concurrently. They are packed
+// independently, so that a failure to pack one of them leaves it empty without leaving the other out of the block.
 func (vs *Server) packDepositsAndAttestations(
 	ctx context.Context,
 	head state.BeaconState,
 	blkSlot primitives.Slot,
 	eth1Data *ethpb.Eth1Data,
-) ([]*ethpb.Deposit, []ethpb.Att, error) {
-	eg, egctx := errgroup.WithContext(ctx)
+	dryRun bool,
+) ([]*ethpb.Deposit, []ethpb.Att) {
+	var wg sync.WaitGroup
 	var deposits []*ethpb.Deposit
 	var atts []ethpb.Att
 
-	eg.Go(func() error {
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
 		// Pack ETH1 deposits which have not been included in the beacon chain.
-		localDeposits, err := vs.deposits(egctx, head, eth1Data)
+		var err error
+		deposits, err = vs.deposits(ctx, head, eth1Data)
 		if err != nil {
-			return status.Errorf(codes.Internal, "Could not get ETH1 deposits: %v", err)
+			log.WithError(err).Error("Could not get ETH1 deposits")
+			deposits = []*ethpb.Deposit{}
 		}
-		// if the original context is cancelled, then cancel this routine too
-		select {
-		case <-egctx.Done():
-			return egctx.Err()
-		default:
-		}
-		deposits = localDeposits
-		return nil
-	})
+	}()
 
-	eg.Go(func() error {
+	go func() {
+		defer wg.Done()
 		// Pack aggregated attestations which have not been included in the beacon chain.
-		localAtts, err := vs.packAttestations(egctx, head, blkSlot)
+		var err error
+		atts, err = vs.packAttestations(ctx, head, blkSlot, dryRun)
 		if err != nil {
-			return status.Errorf(codes.Internal, "Could not get attestations to pack into block: %v", err)
-		}
-		// if the original context is cancelled, then cancel this routine too
-		select {
-		case <-egctx.Done():
-			return egctx.Err()
-		default:
+			log.WithError(err).Error("Could not get attestations to pack into block")
+			atts = []ethpb.Att{}
 		}
-		atts = localAtts
-		return nil
-	})
+	}()
 
-	return deposits, atts, eg.Wait()
+	wg.Wait()
+	return deposits, atts
 }
 
 // deposits returns a list of pending deposits that are ready for inclusion in the next beacon
@@ -71,7 +71,6 @@ func (vs *Server) getLocalPayload(ctx context.Context, blk interfaces.ReadOnlyBe
 	}
 	setFeeRecipientIfBurnAddress(&val)
 
-	var err error
 	if ok && payloadId != [8]byte{} {
 		// Payload ID is cache hit. Return the cached payload ID.
 		var pid primitives.PayloadID
@@ -89,7 +88,8 @@ func (vs *Server) getLocalPayload(ctx context.Context, blk interfaces.ReadOnlyBe
 		}
 	}
 	log.WithFields(logFields).Debug("payload ID cache miss")
-	parentHash, err := vs.getParentBlockHash(ctx, st, slot)
+	payloadIDCacheMiss.Inc()
+	payload, bundle, overrideBuilder, err := vs.buildLocalPayload(ctx, blk, st, val.FeeRecipient)
 	switch {
 	case errors.Is(err, errActivationNotReached) || errors.Is(err, errNoTerminalBlockHash):
 		p, err := consensusblocks.WrappedExecutionPayload(emptyPayload())
@@ -100,11 +100,61 @@ func (vs *Server) getLocalPayload(ctx context.Context, blk interfaces.ReadOnlyBe
 	case err != nil:
 		return nil, false, err
 	}
-	payloadIDCacheMiss.Inc()
+	bundleCache.add(slot, bundle)
+	warnIfFeeRecipientDiffers(payload, val.FeeRecipient)
+	localValueGwei, err := payload.ValueInGwei()
+	if err == nil {
+		log.WithField("value", localValueGwei).Debug("received execution payload from local engine")
+	}
+	return payload, overrideBuilder, nil
+}
+
+// getDryRunLocalPayload returns a local execution payload for the block without side effects on the payload of an
+// actual proposal: the payload ID cache is not used, the payload is built for the zero fee recipient, which does not
+// change its value, and its blobs bundle is returned instead of being cached.
+//
+// The engine API has no way to build a payload without a forkchoice update, so the execution client is still sent one
+// with payload attributes for the zero fee recipient. This starts a payload build on the execution client, and moves
+// its head to the parent of the block, which is the current head of the node. Callers must rate limit dry runs.
+func (vs *Server) getDryRunLocalPayload(ctx context.Context, blk interfaces.ReadOnlyBeaconBlock, st state.BeaconState) (interfaces.ExecutionData, *enginev1.BlobsBundle, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.getDryRunLocalPayload")
+	defer span.End()
+
+	if blk.Version() < version.Bellatrix {
+		return nil, nil, nil
+	}
+	payload, bundle, _, err := vs.buildLocalPayload(ctx, blk, st, primitives.ExecutionAddress{})
+	switch {
+	case errors.Is(err, errActivationNotReached) || errors.Is(err, errNoTerminalBlockHash):
+		p, err := consensusblocks.WrappedExecutionPayload(emptyPayload())
+		if err != nil {
+			return nil, nil, err
+		}
+		return p, nil, nil
+	case err != nil:
+		return nil, nil, err
+	}
+	return payload, bundle, nil
+}
+
+// buildLocalPayload asks the execution client to build a payload on top of the parent of the block, and returns it
+// along with its blobs bundle. `errActivationNotReached` or `errNoTerminalBlockHash` is returned before the merge.
+func (vs *Server) buildLocalPayload(
+	ctx context.Context,
+	blk interfaces.ReadOnlyBeaconBlock,
+	st state.BeaconState,
+	feeRecipient primitives.ExecutionAddress,
+) (interfaces.ExecutionData, *enginev1.BlobsBundle, bool, error) {
+	slot := blk.Slot()
+	headRoot := blk.ParentRoot()
+	parentHash, err := vs.getParentBlockHash(ctx, st, slot)
+	if err != nil {
+		return nil, nil, false, err
+	}
 
 	random, err := helpers.RandaoMix(st, time.CurrentEpoch(st))
 	if err != nil {
-		return nil, false, err
+		return nil, nil, false, err
 	}
 
 	finalizedBlockHash := [32]byte{}
@@ -123,69 +173,59 @@ func (vs *Server) getLocalPayload(ctx context.Context, blk interfaces.ReadOnlyBe
 
 	t, err := slots.ToTime(st.GenesisTime(), slot)
 	if err != nil {
-		return nil, false, err
+		return nil, nil, false, err
 	}
 	var attr payloadattribute.Attributer
 	switch st.Version() {
 	case version.Deneb, version.Electra:
 		withdrawals, _, err := st.ExpectedWithdrawals()
 		if err != nil {
-			return nil, false, err
+			return nil, nil, false, err
 		}
 		attr, err = payloadattribute.New(&enginev1.PayloadAttributesV3{
 			Timestamp:             uint64(t.Unix()),
 			PrevRandao:            random,
-			SuggestedFeeRecipient: val.FeeRecipient[:],
+			SuggestedFeeRecipient: feeRecipient[:],
 			Withdrawals:           withdrawals,
 			ParentBeaconBlockRoot: headRoot[:],
 		})
 		if err != nil {
-			return nil, false, err
+			return nil, nil, false, err
 		}
 	case version.Capella:
 		withdrawals, _, err := st.ExpectedWithdrawals()
 		if err != nil {
-			return nil, false, err
+			return nil, nil, false, err
 		}
 		attr, err = payloadattribute.New(&enginev1.PayloadAttributesV2{
 			Timestamp:             uint64(t.Unix()),
 			PrevRandao:            random,
-			SuggestedFeeRecipient: val.FeeRecipient[:],
+			SuggestedFeeRecipient: feeRecipient[:],
 			Withdrawals:           withdrawals,
 		})
 		if err != nil {
-			return nil, false, err
+			return nil, nil, false, err
 		}
 	case version.Bellatrix:
 		attr, err = payloadattribute.New(&enginev1.PayloadAttributes{
 			Timestamp:             uint64(t.Unix()),
 			PrevRandao:            random,
-			SuggestedFeeRecipient: val.FeeRecipient[:],
+			SuggestedFeeRecipient: feeRecipient[:],
 		})
 		if err != nil {
-			return nil, false, err
+			return nil, nil, false, err
 		}
 	default:
-		return nil, false, errors.New("unknown beacon state version")
+		return nil, nil, false, errors.New("unknown beacon state version")
 	}
 	payloadID, _, err := vs.ExecutionEngineCaller.ForkchoiceUpdated(ctx, f, attr)
 	if err != nil {
-		return nil, false, errors.Wrap(err, "could not prepare payload")
+		return nil, nil, false, errors.Wrap(err, "could not prepare payload")
 	}
 	if payloadID == nil {
-		return nil, false, fmt.Errorf("nil payload with block hash: %#x", parentHash)
-	}
-	payload, bundle, overrideBuilder, err := vs.ExecutionEngineCaller.GetPayload(ctx, *payloadID, slot)
-	if err != nil {
-		return nil, false, err
-	}
-	bundleCache.add(slot, bundle)
-	warnIfFeeRecipientDiffers(payload, val.FeeRecipient)
-	localValueGwei, err := payload.ValueInGwei()
-	if err == nil {
-		log.WithField("value", localValueGwei).Debug("received execution payload from local engine")
+		return nil, nil, false, fmt.Errorf("nil payload with block hash: %#x", parentHash)
 	}
-	return payload, overrideBuilder, nil
+	return vs.ExecutionEngineCaller.GetPayload(ctx, *payloadID, slot)
 }
 
 // warnIfFeeRecipientDiffers logs a warning if the fee recipient in the included payload does not
@@ -0,0 +1,47 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SimulateBeaconBlock builds the block the node would propose at the requested slot through the same pipeline as
+// GetBeaconBlock, without side effects on the beacon node. The block is built on top of the current head: fork choice
+// is not updated and no proposer reorg is attempted. Builders are never queried, and the local payload is built
+// independently of the payload and blobs of an actual proposal. Building it does have a side effect on the execution
+// client, which is asked to start building a payload, see getDryRunLocalPayload.
+func (vs *Server) SimulateBeaconBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.GenericBeaconBlock, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.SimulateBeaconBlock")
+	defer span.End()
+
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+	if slots.ToEpoch(req.Slot) >= params.BeaconConfig().BellatrixForkEpoch {
+		if err := vs.optimisticStatus(ctx); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "Node is not ready to build blocks: %v", err)
+		}
+	}
+
+	r, err := vs.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head root: %v", err)
+	}
+	headRoot := bytesutil.ToBytes32(r)
+	head, err := vs.getParentStateFromReorgData(ctx, req.Slot, headRoot, headRoot, headRoot)
+	if err != nil {
+		return nil, err
+	}
+	sBlk, bundle, err := vs.buildBeaconBlock(ctx, req, head, headRoot, true /* dryRun */)
+	if err != nil {
+		return nil, err
+	}
+	return vs.constructGenericBeaconBlock(sBlk, bundle)
+}
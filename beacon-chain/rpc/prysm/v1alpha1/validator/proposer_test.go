@@ -21,6 +21,7 @@ import (
 	coretime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
 	dbutil "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
@@ -47,6 +48,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation"
 	attaggregation "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation/aggregation/attestations"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
@@ -965,6 +967,15 @@ func TestProposer_ProposeBlock_OK(t *testing.T) {
 				if res == nil || len(res.BlockRoot) == 0 {
 					t.Error("No block root was returned")
 				}
+				proposed, err := blocks.NewSignedBeaconBlock(blockToPropose.Block)
+				require.NoError(t, err)
+				fromBuilder, err := db.PayloadFromBuilder(ctx, bytesutil.ToBytes32(res.BlockRoot))
+				if proposed.Version() < version.Bellatrix {
+					require.ErrorIs(t, err, kv.ErrNotFoundPayloadSource)
+				} else {
+					require.NoError(t, err)
+					assert.Equal(t, proposed.IsBlinded(), fromBuilder)
+				}
 			}
 		})
 	}
@@ -1434,6 +1445,84 @@ func TestProposer_PendingDeposits_CantReturnBelowStateEth1DepositIndex(t *testin
 	assert.Equal(t, expectedDeposits, len(deposits), "Received unexpected number of pending deposits")
 }
 
+// failingAttPool is an attestation pool failing to return its unaggregated attestations.
+type failingAttPool struct {
+	attestations.Pool
+}
+
+func (failingAttPool) UnaggregatedAttestations() ([]ethpb.Att, error) {
+	return nil, errors.New("could not get unaggregated attestations")
+}
+
+func TestProposer_PackDepositsAndAttestations_Independently(t *testing.T) {
+	ctx := context.Background()
+	height := big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance))
+
+	beaconState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, beaconState.SetEth1Data(&ethpb.Eth1Data{
+		BlockHash:    bytesutil.PadTo([]byte("0x0"), 32),
+		DepositRoot:  make([]byte, 32),
+		DepositCount: 4,
+	}))
+	blk := util.NewBeaconBlock()
+	blkRoot, err := blk.HashTreeRoot()
+	require.NoError(t, err)
+
+	var mockSig [96]byte
+	var mockCreds [32]byte
+	depositTrie, err := trie.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err, "Could not setup deposit trie")
+	depositCache, err := depositsnapshot.New()
+	require.NoError(t, err)
+	for i := int64(0); i < 4; i++ {
+		dp := &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				Signature:             mockSig[:],
+				WithdrawalCredentials: mockCreds[:],
+			}}
+		depositHash, err := dp.Data.HashTreeRoot()
+		require.NoError(t, err, "Unable to determine hashed value of deposit")
+		assert.NoError(t, depositTrie.Insert(depositHash[:], int(i)))
+		root, err := depositTrie.HashTreeRoot()
+		require.NoError(t, err)
+		assert.NoError(t, depositCache.InsertDeposit(ctx, dp, uint64(i), i, root))
+		depositCache.InsertPendingDeposit(ctx, dp, uint64(i), i, root)
+	}
+
+	newServer := func(hashesByHeight map[int][]byte, pool attestations.Pool) *Server {
+		p := &mockExecution.Chain{
+			LatestBlockNumber: big.NewInt(0).Add(height, big.NewInt(10000)),
+			HashesByHeight:    hashesByHeight,
+		}
+		return &Server{
+			ChainStartFetcher:      p,
+			Eth1InfoFetcher:        p,
+			Eth1BlockFetcher:       p,
+			DepositFetcher:         depositCache,
+			PendingDepositsFetcher: depositCache,
+			AttPool:                pool,
+			HeadFetcher:            &mock.ChainService{State: beaconState, Root: blkRoot[:]},
+		}
+	}
+
+	t.Run("attestations fail", func(t *testing.T) {
+		bs := newServer(map[int][]byte{int(height.Int64()): []byte("0x0")}, failingAttPool{Pool: attestations.NewPool()})
+		deposits, atts := bs.packDepositsAndAttestations(ctx, beaconState.Copy(), 1, &ethpb.Eth1Data{}, false)
+		assert.Equal(t, 4, len(deposits))
+		assert.NotNil(t, atts)
+		assert.Equal(t, 0, len(atts))
+	})
+	t.Run("deposits fail", func(t *testing.T) {
+		bs := newServer(map[int][]byte{}, attestations.NewPool())
+		deposits, atts := bs.packDepositsAndAttestations(ctx, beaconState.Copy(), 1, &ethpb.Eth1Data{}, false)
+		assert.NotNil(t, deposits)
+		assert.Equal(t, 0, len(deposits))
+		assert.NotNil(t, atts)
+	})
+}
+
 func TestProposer_PendingDeposits_CantReturnMoreThanMax(t *testing.T) {
 	ctx := context.Background()
 
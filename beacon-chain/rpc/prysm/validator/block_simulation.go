@@ -0,0 +1,170 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"go.opencensus.io/trace"
+)
+
+// simulationLimiterKey is the key of the bucket shared by all block simulations in the simulation limiter.
+const simulationLimiterKey = "simulate"
+
+// SimulateBlock builds the block the node would propose at the given slot, through the same pipeline as block
+// proposals, and returns it along with a breakdown of its contents and value. No validator key is needed: the block is
+// built with an empty randao reveal and is not signed. The simulation has no side effects on the beacon node, in
+// particular on fork choice or on the payload of an actual proposal. Builders are never queried, so the execution
+// payload is the one of the local execution client, which is sent a forkchoice update with payload attributes for the
+// zero fee recipient to build it. As each simulation starts a payload build on the execution client, simulations are
+// rate limited.
+func (s *Server) SimulateBlock(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.SimulateBlock")
+	defer span.End()
+
+	if shared.IsSyncing(ctx, w, s.SyncChecker, s.HeadFetcher, s.TimeFetcher, s.OptimisticModeFetcher) {
+		return
+	}
+
+	rawSlot := mux.Vars(r)["slot"]
+	slot, valid := shared.ValidateUint(w, "slot", rawSlot)
+	if !valid {
+		return
+	}
+	if headSlot := s.HeadFetcher.HeadSlot(); primitives.Slot(slot) <= headSlot {
+		handleHTTPError(w, fmt.Sprintf("Slot %d is not after the head slot %d", slot, headSlot), http.StatusBadRequest)
+		return
+	}
+	// Blocks are only simulated up to an epoch ahead, as the state has to be advanced to the slot.
+	if maxSlot := s.TimeFetcher.CurrentSlot() + params.BeaconConfig().SlotsPerEpoch; primitives.Slot(slot) > maxSlot {
+		handleHTTPError(w, fmt.Sprintf("Slot %d is more than an epoch after the current slot", slot), http.StatusBadRequest)
+		return
+	}
+	if s.SimulationLimiter != nil && s.SimulationLimiter.Add(simulationLimiterKey, 1) < 1 {
+		handleHTTPError(w, "Too many block simulations, try again later", http.StatusTooManyRequests)
+		return
+	}
+	var graffiti []byte
+	if rawGraffiti := r.URL.Query().Get("graffiti"); rawGraffiti != "" {
+		g, err := bytesutil.DecodeHexWithLength(rawGraffiti, fieldparams.RootLength)
+		if err != nil {
+			handleHTTPError(w, "Unable to decode graffiti: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		graffiti = g
+	}
+
+	resp, err := s.BlockSimulator.SimulateBeaconBlock(ctx, &ethpb.BlockRequest{
+		Slot:         primitives.Slot(slot),
+		RandaoReveal: primitives.PointAtInfinity,
+		Graffiti:     graffiti,
+		SkipMevBoost: true,
+	})
+	if err != nil {
+		handleHTTPError(w, "Could not build block: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	blk, err := blocks.NewBeaconBlock(resp.Block)
+	if err != nil {
+		handleHTTPError(w, "Could not read block: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data, err := blockJson(blk)
+	if err != nil {
+		handleHTTPError(w, "Could not encode block: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	breakdown, httpErr := s.blockBreakdown(ctx, blk)
+	if httpErr != nil {
+		httputil.WriteError(w, httpErr)
+		return
+	}
+	breakdown.ExecutionPayloadValue = resp.PayloadValue
+	httputil.WriteJson(w, &structs.SimulateBlockResponse{
+		Version:   version.String(blk.Version()),
+		Data:      data,
+		Breakdown: breakdown,
+	})
+}
+
+// blockJson encodes an unsigned block the way the block is encoded in the message of a signed block.
+func blockJson(blk interfaces.ReadOnlyBeaconBlock) ([]byte, error) {
+	signed, err := blocks.BuildSignedBeaconBlock(blk, make([]byte, fieldparams.BLSSignatureLength))
+	if err != nil {
+		return nil, err
+	}
+	jsoner, err := structs.SignedBeaconBlockMessageJsoner(signed)
+	if err != nil {
+		return nil, err
+	}
+	return jsoner.MessageRawJson()
+}
+
+// blockBreakdown counts the operations of the block, and estimates the consensus rewards of its proposer.
+func (s *Server) blockBreakdown(ctx context.Context, blk interfaces.ReadOnlyBeaconBlock) (*structs.BlockSimulationBreakdown, *httputil.DefaultJsonError) {
+	body := blk.Body()
+	var aggregationBits uint64
+	for _, att := range body.Attestations() {
+		aggregationBits += att.GetAggregationBits().Count()
+	}
+	breakdown := &structs.BlockSimulationBreakdown{
+		Attestations:      strconv.Itoa(len(body.Attestations())),
+		AggregationBits:   strconv.FormatUint(aggregationBits, 10),
+		ProposerSlashings: strconv.Itoa(len(body.ProposerSlashings())),
+		AttesterSlashings: strconv.Itoa(len(body.AttesterSlashings())),
+		VoluntaryExits:    strconv.Itoa(len(body.VoluntaryExits())),
+		Deposits:          strconv.Itoa(len(body.Deposits())),
+	}
+	if blk.Version() >= version.Capella {
+		changes, err := body.BLSToExecutionChanges()
+		if err != nil {
+			return nil, &httputil.DefaultJsonError{
+				Message: "Could not get BLS to execution changes: " + err.Error(),
+				Code:    http.StatusInternalServerError,
+			}
+		}
+		breakdown.BlsToExecutionChanges = strconv.Itoa(len(changes))
+	}
+	// Sync aggregates and the rewards of the proposer for the operations of the block are new in Altair.
+	if blk.Version() == version.Phase0 {
+		return breakdown, nil
+	}
+	sa, err := body.SyncAggregate()
+	if err != nil {
+		return nil, &httputil.DefaultJsonError{
+			Message: "Could not get sync aggregate: " + err.Error(),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	breakdown.SyncAggregateParticipants = strconv.FormatUint(sa.SyncCommitteeBits.Count(), 10)
+	breakdown.SyncCommitteeSize = strconv.FormatUint(sa.SyncCommitteeBits.Len(), 10)
+
+	rewards, httpErr := s.BlockRewardFetcher.GetBlockRewardsData(ctx, blk)
+	if httpErr != nil {
+		return nil, httpErr
+	}
+	breakdown.ConsensusRewards = rewards
+	gwei, ok := big.NewInt(0).SetString(rewards.Total, 10)
+	if !ok {
+		return nil, &httputil.DefaultJsonError{
+			Message: "Could not parse consensus block value",
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	breakdown.ConsensusBlockValue = gwei.Mul(gwei, big.NewInt(1e9)).String()
+	return breakdown, nil
+}
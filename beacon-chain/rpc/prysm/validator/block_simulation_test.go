@@ -0,0 +1,178 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	blockchainTesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	rewardtesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/rewards/testing"
+	rpctesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared/testing"
+	mockSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	leakybucket "github.com/prysmaticlabs/prysm/v5/container/leaky-bucket"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+type mockBlockSimulator struct {
+	req   *eth.BlockRequest
+	block *eth.GenericBeaconBlock
+}
+
+func (m *mockBlockSimulator) SimulateBeaconBlock(_ context.Context, req *eth.BlockRequest) (*eth.GenericBeaconBlock, error) {
+	m.req = req
+	return m.block, nil
+}
+
+func TestSimulateBlock(t *testing.T) {
+	currentSlot := primitives.Slot(1)
+	chainService := &blockchainTesting.ChainService{Slot: &currentSlot}
+	rewards := &structs.BlockRewards{
+		ProposerIndex:     "4",
+		Total:             "10",
+		Attestations:      "6",
+		SyncAggregate:     "2",
+		ProposerSlashings: "1",
+		AttesterSlashings: "1",
+	}
+
+	t.Run("Capella", func(t *testing.T) {
+		var block *structs.SignedBeaconBlockCapella
+		require.NoError(t, json.Unmarshal([]byte(rpctesting.CapellaBlock), &block))
+		jsonBytes, err := json.Marshal(block.Message)
+		require.NoError(t, err)
+		generic, err := block.Message.ToGeneric()
+		require.NoError(t, err)
+		generic.PayloadValue = "2000"
+		simulator := &mockBlockSimulator{block: generic}
+		s := &Server{
+			HeadFetcher:        chainService,
+			TimeFetcher:        chainService,
+			SyncChecker:        &mockSync.Sync{IsSyncing: false},
+			BlockSimulator:     simulator,
+			BlockRewardFetcher: &rewardtesting.MockBlockRewardFetcher{Rewards: rewards},
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/prysm/v1/validator/blocks/1/simulate", nil)
+		request = mux.SetURLVars(request, map[string]string{"slot": "1"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.SimulateBlock(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.DeepEqual(t, &eth.BlockRequest{
+			Slot:         1,
+			RandaoReveal: primitives.PointAtInfinity,
+			SkipMevBoost: true,
+		}, simulator.req)
+		resp := &structs.SimulateBlockResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "capella", resp.Version)
+		assert.Equal(t, string(jsonBytes), string(resp.Data))
+		assert.DeepEqual(t, &structs.BlockSimulationBreakdown{
+			Attestations:              "1",
+			AggregationBits:           "141",
+			ProposerSlashings:         "1",
+			AttesterSlashings:         "1",
+			VoluntaryExits:            "1",
+			Deposits:                  "1",
+			BlsToExecutionChanges:     "1",
+			SyncAggregateParticipants: "267",
+			SyncCommitteeSize:         "512",
+			ConsensusRewards:          rewards,
+			ConsensusBlockValue:       "10000000000",
+			ExecutionPayloadValue:     "2000",
+		}, resp.Breakdown)
+	})
+	t.Run("slot not after head", func(t *testing.T) {
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(1))
+		chainService := &blockchainTesting.ChainService{Slot: &currentSlot, State: st}
+		s := &Server{
+			HeadFetcher: chainService,
+			TimeFetcher: chainService,
+			SyncChecker: &mockSync.Sync{IsSyncing: false},
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/prysm/v1/validator/blocks/1/simulate", nil)
+		request = mux.SetURLVars(request, map[string]string{"slot": "1"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.SimulateBlock(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "is not after the head slot", e.Message)
+	})
+	t.Run("slot too far ahead", func(t *testing.T) {
+		s := &Server{
+			HeadFetcher: chainService,
+			TimeFetcher: chainService,
+			SyncChecker: &mockSync.Sync{IsSyncing: false},
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/prysm/v1/validator/blocks/100/simulate", nil)
+		request = mux.SetURLVars(request, map[string]string{"slot": "100"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.SimulateBlock(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "more than an epoch after the current slot", e.Message)
+	})
+	t.Run("rate limited", func(t *testing.T) {
+		var block *structs.SignedBeaconBlockCapella
+		require.NoError(t, json.Unmarshal([]byte(rpctesting.CapellaBlock), &block))
+		generic, err := block.Message.ToGeneric()
+		require.NoError(t, err)
+		simulator := &mockBlockSimulator{block: generic}
+		s := &Server{
+			HeadFetcher:        chainService,
+			TimeFetcher:        chainService,
+			SyncChecker:        &mockSync.Sync{IsSyncing: false},
+			BlockSimulator:     simulator,
+			BlockRewardFetcher: &rewardtesting.MockBlockRewardFetcher{Rewards: rewards},
+			SimulationLimiter:  leakybucket.NewCollector(1, 1, time.Minute, false /* deleteEmptyBuckets */),
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/prysm/v1/validator/blocks/1/simulate", nil)
+		request = mux.SetURLVars(request, map[string]string{"slot": "1"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.SimulateBlock(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		simulator.req = nil
+		writer = httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.SimulateBlock(writer, request)
+		assert.Equal(t, http.StatusTooManyRequests, writer.Code)
+		assert.Equal(t, true, simulator.req == nil)
+	})
+	t.Run("syncing", func(t *testing.T) {
+		s := &Server{
+			HeadFetcher:           chainService,
+			TimeFetcher:           chainService,
+			OptimisticModeFetcher: chainService,
+			SyncChecker:           &mockSync.Sync{IsSyncing: true},
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/prysm/v1/validator/blocks/1/simulate", nil)
+		request = mux.SetURLVars(request, map[string]string{"slot": "1"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.SimulateBlock(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
@@ -1,9 +1,28 @@
 package validator
 
 import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/rewards"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
+	leakybucket "github.com/prysmaticlabs/prysm/v5/container/leaky-bucket"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
 type Server struct {
-	CoreService *core.Service
+	CoreService           *core.Service
+	HeadFetcher           blockchain.HeadFetcher
+	TimeFetcher           blockchain.TimeFetcher
+	SyncChecker           sync.Checker
+	OptimisticModeFetcher blockchain.OptimisticModeFetcher
+	BlockSimulator        BlockSimulator
+	BlockRewardFetcher    rewards.BlockRewardsFetcher
+	SimulationLimiter     *leakybucket.Collector
+}
+
+// BlockSimulator builds the block the node would propose at a slot, without side effects on the beacon node.
+type BlockSimulator interface {
+	SimulateBeaconBlock(ctx context.Context, req *eth.BlockRequest) (*eth.GenericBeaconBlock, error)
 }
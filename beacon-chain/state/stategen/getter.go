@@ -249,6 +249,7 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (state.
 // 1) block parent state is the last finalized state
 // 2) block parent state is the epoch boundary state and exists in epoch boundary cache
 // 3) block parent state is in DB
+// 4) block parent state, advanced by empty slots, is a hierarchical state in DB
 func (s *State) latestAncestor(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stateGen.latestAncestor")
 	defer span.End()
@@ -268,6 +269,7 @@ func (s *State) latestAncestor(ctx context.Context, blockRoot [32]byte) (state.B
 		return nil, err
 	}
 
+	hierarchical := newHierarchicalStateLookup(s.beaconDB)
 	for {
 		if ctx.Err() != nil {
 			return nil, ctx.Err()
@@ -310,6 +312,7 @@ func (s *State) latestAncestor(ctx context.Context, blockRoot [32]byte) (state.B
 			return s, errors.Wrap(err, "failed to retrieve state from db")
 		}
 
+		childSlot := b.Block().Slot()
 		b, err = s.beaconDB.Block(ctx, parentRoot)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to retrieve block from db")
@@ -317,6 +320,15 @@ func (s *State) latestAncestor(ctx context.Context, blockRoot [32]byte) (state.B
 		if b == nil || b.IsNil() {
 			return nil, errUnknownBlock
 		}
+
+		// Does a hierarchical state between the block and its child exist in DB.
+		hs, err := hierarchical.stateFor(ctx, parentRoot, b.Block().Slot(), childSlot-1)
+		if err != nil {
+			return nil, err
+		}
+		if hs != nil {
+			return hs, nil
+		}
 	}
 }
 
@@ -0,0 +1,145 @@
+package stategen
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// hierarchicalStateGetter retrieves the finalized states that the database stores as hierarchical diffs.
+type hierarchicalStateGetter interface {
+	HierarchicalStateSlot(ctx context.Context, slot primitives.Slot) (primitives.Slot, bool, error)
+	HierarchicalState(ctx context.Context, slot primitives.Slot) (state.BeaconState, error)
+}
+
+// hierarchicalStateLookup finds the hierarchical state, if any, on which a walk back through the ancestors of a block
+// can stop. The hierarchical state below the walk is looked up once, and only looked up again if the walk passes it
+// without it being the state of one of the blocks of the walk.
+type hierarchicalStateLookup struct {
+	h        hierarchicalStateGetter
+	resolved bool
+	found    bool
+	slot     primitives.Slot
+}
+
+func newHierarchicalStateLookup(h hierarchicalStateGetter) *hierarchicalStateLookup {
+	return &hierarchicalStateLookup{h: h}
+}
+
+// stateFor returns the hierarchical state stored between blockSlot and maxSlot, if the latest block applied to it is
+// the block of the given root. Such a state can be used as the post state of the block advanced to its slot. It
+// returns nil if there is no such state. The walk calls it with the blocks from the highest to the lowest, and maxSlot
+// only matters for the first call.
+func (l *hierarchicalStateLookup) stateFor(
+	ctx context.Context,
+	blockRoot [32]byte,
+	blockSlot, maxSlot primitives.Slot,
+) (state.BeaconState, error) {
+	if !l.resolved {
+		if err := l.resolve(ctx, maxSlot); err != nil {
+			return nil, err
+		}
+	}
+	if !l.found || l.slot < blockSlot {
+		return nil, nil
+	}
+	st, err := hierarchicalStateOf(ctx, l.h, l.slot, blockRoot)
+	if err != nil || st != nil {
+		return st, err
+	}
+	// The hierarchical state is not on the chain of the walk, which now goes below it.
+	if blockSlot == 0 {
+		l.found = false
+		return nil, nil
+	}
+	return nil, l.resolve(ctx, blockSlot-1)
+}
+
+// resolve looks up the highest hierarchical state at or below the slot.
+func (l *hierarchicalStateLookup) resolve(ctx context.Context, slot primitives.Slot) error {
+	var err error
+	l.slot, l.found, err = l.h.HierarchicalStateSlot(ctx, slot)
+	if err != nil {
+		return errors.Wrapf(err, "could not look up hierarchical state below slot %d", slot)
+	}
+	l.resolved = true
+	return nil
+}
+
+// hierarchicalStateOf returns the hierarchical state stored at the slot, if the latest block applied to it is the
+// block of the given root, and nil otherwise.
+func hierarchicalStateOf(ctx context.Context, h hierarchicalStateGetter, slot primitives.Slot, blockRoot [32]byte) (state.BeaconState, error) {
+	st, err := h.HierarchicalState(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get hierarchical state at slot %d", slot)
+	}
+	if st == nil || st.IsNil() {
+		return nil, nil
+	}
+
+	// The state root of the latest block header is only filled in by the slot processing following the block.
+	header := st.LatestBlockHeader()
+	if bytesutil.ToBytes32(header.StateRoot) == params.BeaconConfig().ZeroHash {
+		stateRoot, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return nil, err
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	headerRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	if headerRoot != blockRoot {
+		return nil, nil
+	}
+	return st, nil
+}
+
+// saveHierarchicalState saves the finalized state of the given slot as a hierarchical state. It returns the root of
+// the latest block applied to the state.
+func (s *State) saveHierarchicalState(ctx context.Context, slot primitives.Slot) ([32]byte, error) {
+	var st state.BeaconState
+	var root [32]byte
+	cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
+	if err != nil {
+		return [32]byte{}, errors.Wrapf(err, "could not get epoch boundary state for slot %d", slot)
+	}
+	if exists && cached.state.Slot() == slot {
+		st, root = cached.state, cached.root
+	} else {
+		// The state at a skipped slot is the state of the highest block below it, advanced to the slot.
+		_, roots, err := s.beaconDB.HighestRootsBelowSlot(ctx, slot+1)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		// Given the block has been finalized, the db should not have more than one block in a given slot.
+		if len(roots) != 1 {
+			return [32]byte{}, errUnknownBlock
+		}
+		root = roots[0]
+		st, err = s.StateByRoot(ctx, root)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		if st.Slot() < slot {
+			st, err = ReplayProcessSlots(ctx, st.Copy(), slot)
+			if err != nil {
+				return [32]byte{}, errors.Wrapf(err, "could not process slots up to %d", slot)
+			}
+		}
+	}
+
+	if err := s.beaconDB.SaveHierarchicalState(ctx, st); err != nil {
+		return [32]byte{}, err
+	}
+	log.WithFields(logrus.Fields{
+		"slot": slot,
+	}).Info("Saved hierarchical state in DB")
+	return root, nil
+}
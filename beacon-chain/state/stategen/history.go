@@ -103,6 +103,7 @@ func (c *CanonicalHistory) bestForSlot(ctx context.Context, roots [][32]byte) ([
 // and the stategen transition helper methods. This implementation uses the following algorithm:
 // - find the highest canonical block <= the target slot
 // - starting with this block, recursively search backwards for a stored state, and accumulate intervening blocks
+// - a hierarchical state stored at a slot between a block and its child stops the search like a state of that block
 func (c *CanonicalHistory) chainForSlot(ctx context.Context, target primitives.Slot) (state.BeaconState, []interfaces.ReadOnlySignedBeaconBlock, error) {
 	ctx, span := trace.StartSpan(ctx, "canonicalChainer.chainForSlot")
 	defer span.End()
@@ -114,7 +115,16 @@ func (c *CanonicalHistory) chainForSlot(ctx context.Context, target primitives.S
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "unable to retrieve canonical block for slot, root=%#x", r)
 	}
-	s, descendants, err := c.ancestorChain(ctx, b)
+	// A hierarchical state between the block and the target slot only needs slot processing.
+	hierarchical := newHierarchicalStateLookup(c.h)
+	hs, err := hierarchical.stateFor(ctx, r, b.Block().Slot(), target)
+	if err != nil {
+		return nil, nil, err
+	}
+	if hs != nil {
+		return hs, nil, nil
+	}
+	s, descendants, err := c.ancestorChainWith(ctx, b, hierarchical)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to query for ancestor and descendant blocks")
 	}
@@ -141,6 +151,15 @@ func (c *CanonicalHistory) getState(ctx context.Context, blockRoot [32]byte) (st
 // Note that this function assumes that the tail is a canonical block, and therefore assumes that
 // all ancestors are also canonical.
 func (c *CanonicalHistory) ancestorChain(ctx context.Context, tail interfaces.ReadOnlySignedBeaconBlock) (state.BeaconState, []interfaces.ReadOnlySignedBeaconBlock, error) {
+	return c.ancestorChainWith(ctx, tail, newHierarchicalStateLookup(c.h))
+}
+
+// ancestorChainWith is ancestorChain, continuing the lookup of hierarchical states of a walk which started above tail.
+func (c *CanonicalHistory) ancestorChainWith(
+	ctx context.Context,
+	tail interfaces.ReadOnlySignedBeaconBlock,
+	hierarchical *hierarchicalStateLookup,
+) (state.BeaconState, []interfaces.ReadOnlySignedBeaconBlock, error) {
 	ctx, span := trace.StartSpan(ctx, "canonicalChainer.ancestorChain")
 	defer span.End()
 	chain := make([]interfaces.ReadOnlySignedBeaconBlock, 0)
@@ -181,6 +200,16 @@ func (c *CanonicalHistory) ancestorChain(ctx context.Context, tail interfaces.Re
 			return nil, nil, errors.Wrap(db.ErrNotFound, msg)
 		}
 		chain = append(chain, tail)
+		// a hierarchical state between the parent and the current block is the parent state advanced by empty slots,
+		// on top of which the accumulated blocks can be applied.
+		hs, err := hierarchical.stateFor(ctx, b.ParentRoot(), parent.Block().Slot(), b.Slot()-1)
+		if err != nil {
+			return nil, nil, err
+		}
+		if hs != nil {
+			reverseChain(chain)
+			return hs, chain, nil
+		}
 		tail = parent
 	}
 }
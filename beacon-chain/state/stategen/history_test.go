@@ -377,6 +377,89 @@ func TestAncestorChainOK(t *testing.T) {
 	require.Equal(t, expectedHTR, actualHTR)
 }
 
+func TestAncestorChainHierarchicalState(t *testing.T) {
+	ctx := context.Background()
+	var begin, middle, end primitives.Slot = 100, 150, 155
+	specs := []mockHistorySpec{
+		{slot: begin, savedState: true},
+		{slot: middle},
+		{slot: end, canonicalBlock: true},
+	}
+	hist := newMockHistory(t, specs, end+1)
+	// the hierarchical state of slot 152 is the state of the middle block advanced by empty slots.
+	hs, err := ReplayProcessSlots(ctx, hist.hiddenStates[hist.slotMap[middle]].Copy(), middle+2)
+	require.NoError(t, err)
+	hist.hierarchicalStates = map[primitives.Slot]state.BeaconState{middle + 2: hs}
+	ch := &CanonicalHistory{h: hist, cc: hist, cs: hist}
+
+	endBlock := hist.blocks[hist.slotMap[end]]
+	st, bs, err := ch.ancestorChain(ctx, endBlock)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(bs))
+	require.DeepEqual(t, endBlock, bs[0])
+	require.Equal(t, middle+2, st.Slot())
+
+	st, err = ch.ReplayerForSlot(end).ReplayBlocks(ctx)
+	require.NoError(t, err)
+	expectedHTR, err := hist.hiddenStates[hist.slotMap[end]].HashTreeRoot(ctx)
+	require.NoError(t, err)
+	actualHTR, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expectedHTR, actualHTR)
+
+	// a hierarchical state that does not descend from the parent block is ignored.
+	other, err := ReplayProcessSlots(ctx, hist.states[hist.slotMap[begin]].Copy(), middle+2)
+	require.NoError(t, err)
+	hist.hierarchicalStates = map[primitives.Slot]state.BeaconState{middle + 2: other}
+	st, bs, err = ch.ancestorChain(ctx, endBlock)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(bs))
+	require.Equal(t, begin, st.Slot())
+}
+
+func TestAncestorChainHierarchicalStateLookedUpOnce(t *testing.T) {
+	ctx := context.Background()
+	specs := []mockHistorySpec{{slot: 100, savedState: true}}
+	for slot := primitives.Slot(110); slot <= 150; slot += 10 {
+		specs = append(specs, mockHistorySpec{slot: slot, canonicalBlock: true})
+	}
+	hist := newMockHistory(t, specs, 151)
+	// the hierarchical state is below the saved state, so the walk never reaches it.
+	hist.hierarchicalStates = map[primitives.Slot]state.BeaconState{64: hist.states[hist.slotMap[100]]}
+	ch := &CanonicalHistory{h: hist, cc: hist, cs: hist}
+
+	st, bs, err := ch.ancestorChain(ctx, hist.blocks[hist.slotMap[150]])
+	require.NoError(t, err)
+	require.Equal(t, 5, len(bs))
+	require.Equal(t, primitives.Slot(100), st.Slot())
+	require.Equal(t, 1, hist.hierarchicalStateLookups)
+}
+
+func TestChainForSlotHierarchicalState(t *testing.T) {
+	ctx := context.Background()
+	var begin, end primitives.Slot = 100, 150
+	specs := []mockHistorySpec{
+		{slot: begin, canonicalBlock: true, savedState: true},
+		{slot: end, canonicalBlock: true},
+	}
+	hist := newMockHistory(t, specs, end+64)
+	hs, err := ReplayProcessSlots(ctx, hist.hiddenStates[hist.slotMap[end]].Copy(), end+32)
+	require.NoError(t, err)
+	hist.hierarchicalStates = map[primitives.Slot]state.BeaconState{end + 32: hs}
+	ch := &CanonicalHistory{h: hist, cc: hist, cs: hist}
+
+	st, bs, err := ch.chainForSlot(ctx, end+40)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(bs))
+	require.Equal(t, end+32, st.Slot())
+
+	// the hierarchical state is after the target slot.
+	st, bs, err = ch.chainForSlot(ctx, end+8)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(bs))
+	require.Equal(t, begin, st.Slot())
+}
+
 func TestChainForSlot(t *testing.T) {
 	ctx := context.Background()
 	var zero, one, two, three primitives.Slot = 50, 51, 150, 151
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/sirupsen/logrus"
@@ -13,7 +14,7 @@ import (
 
 // MigrateToCold advances the finalized info in between the cold and hot state sections.
 // It moves the recent finalized states from the hot section to the cold section and
-// only preserves the ones that are on archived point.
+// only preserves the ones that are on archived point, or on hierarchical state slots.
 func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "stateGen.MigrateToCold")
 	defer span.End()
@@ -44,6 +45,23 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 			return ctx.Err()
 		}
 
+		// Hierarchical states replace the archived points, when the database stores them.
+		if interval := s.beaconDB.HierarchicalStateInterval(); interval > 0 {
+			if slot%interval == 0 {
+				root, err := s.saveHierarchicalState(ctx, slot)
+				if err != nil {
+					return err
+				}
+				// A hot state saved to the DB for the block is redundant with the hierarchical state.
+				if s.removeSavedHotStateRoot(root) {
+					if err := s.beaconDB.DeleteState(ctx, root); err != nil {
+						return errors.Wrapf(err, "could not delete hot state of block %#x", root)
+					}
+				}
+			}
+			continue
+		}
+
 		if slot%s.slotsPerArchivedPoint == 0 && slot != 0 {
 			cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
 			if err != nil {
@@ -84,17 +102,7 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 			if s.beaconDB.HasState(ctx, aRoot) {
 				// If you are migrating a state and its already part of the hot state cache saved to the db,
 				// you can just remove it from the hot state cache as it becomes redundant.
-				s.saveHotStateDB.lock.Lock()
-				roots := s.saveHotStateDB.blockRootsOfSavedStates
-				for i := 0; i < len(roots); i++ {
-					if aRoot == roots[i] {
-						s.saveHotStateDB.blockRootsOfSavedStates = append(roots[:i], roots[i+1:]...)
-						// There shouldn't be duplicated roots in `blockRootsOfSavedStates`.
-						// Break here is ok.
-						break
-					}
-				}
-				s.saveHotStateDB.lock.Unlock()
+				s.removeSavedHotStateRoot(aRoot)
 				continue
 			}
 
@@ -120,3 +128,20 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 
 	return nil
 }
+
+// removeSavedHotStateRoot removes the block root from the roots of the hot states saved to the DB, whose states are
+// deleted when exiting the mode to save hot states. It returns whether the root was there.
+func (s *State) removeSavedHotStateRoot(root [32]byte) bool {
+	s.saveHotStateDB.lock.Lock()
+	defer s.saveHotStateDB.lock.Unlock()
+	roots := s.saveHotStateDB.blockRootsOfSavedStates
+	for i := 0; i < len(roots); i++ {
+		if root == roots[i] {
+			s.saveHotStateDB.blockRootsOfSavedStates = append(roots[:i], roots[i+1:]...)
+			// There shouldn't be duplicated roots in `blockRootsOfSavedStates`.
+			// Return here is ok.
+			return true
+		}
+	}
+	return false
+}
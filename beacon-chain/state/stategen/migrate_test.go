@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
 	testDB "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
 	consensusblocks "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
@@ -69,6 +70,67 @@ func TestMigrateToCold_HappyPath(t *testing.T) {
 	require.LogsContain(t, hook, "Saved state in DB")
 }
 
+func TestMigrateToCold_HierarchicalStates(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t, kv.WithStateDiffExponents([]int{1}))
+
+	service := New(beaconDB, doublylinkedtree.New())
+	service.slotsPerArchivedPoint = 1
+	service.finalizedInfo.slot = 1
+	beaconState, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconState.SetSlot(2))
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 3
+	fRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, service.beaconDB, b)
+	require.NoError(t, service.epochBoundaryStateCache.put(fRoot, beaconState))
+	require.NoError(t, service.MigrateToCold(ctx, fRoot))
+
+	// The state is saved as a hierarchical state instead of an archived point.
+	assert.Equal(t, false, service.beaconDB.HasState(ctx, fRoot))
+	gotState, err := service.beaconDB.HierarchicalState(ctx, 3)
+	require.NoError(t, err)
+	require.NotNil(t, gotState)
+	assert.DeepSSZEqual(t, beaconState.ToProtoUnsafe(), gotState.ToProtoUnsafe(), "Did not save hierarchical state")
+	require.LogsContain(t, hook, "Saved hierarchical state in DB")
+}
+
+func TestMigrateToCold_HierarchicalStatesDeleteSavedHotState(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t, kv.WithStateDiffExponents([]int{1}))
+
+	service := New(beaconDB, doublylinkedtree.New())
+	service.finalizedInfo.slot = 1
+	beaconState, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconState.SetSlot(2))
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 2
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, service.beaconDB, b)
+	require.NoError(t, service.epochBoundaryStateCache.put(root, beaconState))
+	// The state of the block was saved to the DB while finalization was lagging.
+	require.NoError(t, service.beaconDB.SaveState(ctx, beaconState, root))
+	other := [32]byte{'a'}
+	service.saveHotStateDB.blockRootsOfSavedStates = [][32]byte{other, root}
+
+	f := util.NewBeaconBlock()
+	f.Block.Slot = 3
+	fRoot, err := f.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, service.beaconDB, f)
+	require.NoError(t, service.MigrateToCold(ctx, fRoot))
+
+	// The hot state is replaced by the hierarchical state, and is not deleted again when leaving the mode.
+	assert.Equal(t, false, service.beaconDB.HasState(ctx, root))
+	gotState, err := service.beaconDB.HierarchicalState(ctx, 2)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, beaconState.ToProtoUnsafe(), gotState.ToProtoUnsafe(), "Did not save hierarchical state")
+	assert.DeepEqual(t, [][32]byte{other}, service.saveHotStateDB.blockRootsOfSavedStates)
+}
+
 func TestMigrateToCold_RegeneratePath(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ctx := context.Background()
@@ -88,6 +88,8 @@ type mockHistory struct {
 	canonical                      map[[32]byte]bool
 	states                         map[[32]byte]state.BeaconState
 	hiddenStates                   map[[32]byte]state.BeaconState
+	hierarchicalStates             map[primitives.Slot]state.BeaconState
+	hierarchicalStateLookups       int
 	current                        primitives.Slot
 	overrideHighestSlotBlocksBelow func(context.Context, primitives.Slot) (primitives.Slot, [][32]byte, error)
 }
@@ -153,6 +155,31 @@ func (m *mockHistory) StateOrError(_ context.Context, blockRoot [32]byte) (state
 	return nil, db.ErrNotFoundState
 }
 
+func (m *mockHistory) HierarchicalStateSlot(_ context.Context, slot primitives.Slot) (primitives.Slot, bool, error) {
+	m.hierarchicalStateLookups++
+	highest, found := m.highestHierarchicalStateSlot(slot)
+	return highest, found, nil
+}
+
+func (m *mockHistory) highestHierarchicalStateSlot(slot primitives.Slot) (primitives.Slot, bool) {
+	var highest primitives.Slot
+	found := false
+	for s := range m.hierarchicalStates {
+		if s <= slot && (!found || s > highest) {
+			highest, found = s, true
+		}
+	}
+	return highest, found
+}
+
+func (m *mockHistory) HierarchicalState(_ context.Context, slot primitives.Slot) (state.BeaconState, error) {
+	s, ok := m.highestHierarchicalStateSlot(slot)
+	if !ok {
+		return nil, nil
+	}
+	return m.hierarchicalStates[s].Copy(), nil
+}
+
 func (m *mockHistory) IsCanonical(_ context.Context, blockRoot [32]byte) (bool, error) {
 	canon, ok := m.canonical[blockRoot]
 	return ok && canon, nil
@@ -30,6 +30,8 @@ type HistoryAccessor interface {
 	GenesisBlockRoot(ctx context.Context) ([32]byte, error)
 	Block(ctx context.Context, blockRoot [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error)
 	StateOrError(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
+	HierarchicalStateSlot(ctx context.Context, slot primitives.Slot) (primitives.Slot, bool, error)
+	HierarchicalState(ctx context.Context, slot primitives.Slot) (state.BeaconState, error)
 }
 
 // CanonicalChecker determines whether the given block root is canonical.
@@ -0,0 +1,157 @@
+package backfill
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/era"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+var errEraBlobsRequired = errors.New("block needs blobs, which are not held in era files")
+
+// WithEraDir sets a directory of era files, which are used to backfill the blocks before they are requested from
+// peers, and again once the blocks of the retention period are downloaded.
+func WithEraDir(dir string) ServiceOption {
+	return func(s *Service) error {
+		s.eraDir = dir
+		return nil
+	}
+}
+
+// ImportEras backfills the blocks below the lowest backfilled block from the era files of the directory, until
+// genesis or a block that needs blobs, which era files do not hold. The blocks go through the same verification as
+// the blocks downloaded from peers, in batches of batchSize slots. It returns the number of blocks imported.
+func ImportEras(ctx context.Context, su *Store, dir string, current primitives.Slot, batchSize uint64) (int, error) {
+	if su.isGenesisSync() {
+		return 0, nil
+	}
+	if batchSize == 0 {
+		return 0, errors.New("batch size must be positive")
+	}
+	files, err := era.ListFiles(dir)
+	if err != nil {
+		return 0, err
+	}
+	cps, err := su.originState(ctx)
+	if err != nil {
+		return 0, err
+	}
+	v, err := newOriginVerifier(cps)
+	if err != nil {
+		return 0, err
+	}
+
+	imported := 0
+	for i := len(files) - 1; i >= 0 && files[i].Era > 0; i-- {
+		// Slot 0 is the genesis block, which is never backfilled.
+		low := primitives.Slot(su.status().LowSlot)
+		if low <= 1 {
+			break
+		}
+		if start, _ := era.BlockSlots(files[i].Era); start >= low {
+			continue
+		}
+		if want := era.ForSlot(low - 1); files[i].Era < want {
+			return imported, errors.Errorf("era file %d, holding the blocks below slot %d, is missing", want, low)
+		}
+		n, err := importEra(ctx, su, v, files[i].Path, current, primitives.Slot(batchSize))
+		imported += n
+		if err != nil {
+			return imported, err
+		}
+	}
+	return imported, nil
+}
+
+// importEra backfills the blocks of an era file below the lowest backfilled block, from the highest batch down.
+func importEra(ctx context.Context, su *Store, v *verifier, path string, current, batchSize primitives.Slot) (int, error) {
+	r, err := era.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.WithError(err).Error("Could not close era file")
+		}
+	}()
+	start, _ := era.BlockSlots(r.Era())
+	if start == 0 {
+		start = 1
+	}
+	imported := 0
+	for end := primitives.Slot(su.status().LowSlot); end > start; {
+		if ctx.Err() != nil {
+			return imported, ctx.Err()
+		}
+		begin := start
+		if end-start > batchSize {
+			begin = end - batchSize
+		}
+		blks, err := r.Blocks(begin, end)
+		if err != nil {
+			return imported, errors.Wrapf(err, "could not read blocks of era %d", r.Era())
+		}
+		end = begin
+		if len(blks) == 0 {
+			continue
+		}
+		vb, err := v.verify(blks)
+		if err != nil {
+			return imported, errors.Wrapf(err, "invalid blocks in era %d", r.Era())
+		}
+		if _, err := su.fillBack(ctx, current, vb, eraAvailability{}); err != nil {
+			return imported, err
+		}
+		imported += len(vb)
+	}
+	return imported, nil
+}
+
+// importEras backfills from the era directory of the service, logging the outcome.
+func (s *Service) importEras(ctx context.Context) {
+	n, err := ImportEras(ctx, s.store, s.eraDir, s.clock.CurrentSlot(), s.batchSize)
+	fields := logrus.Fields{
+		"imported":           n,
+		"backfillLowestSlot": s.store.status().LowSlot,
+	}
+	if errors.Is(err, errEraBlobsRequired) {
+		log.WithFields(fields).WithError(err).Info("Stopped backfilling from era files at a block with blobs")
+		return
+	}
+	if err != nil {
+		log.WithFields(fields).WithError(err).Error("Could not backfill from era files")
+		return
+	}
+	log.WithFields(fields).Info("Backfilled blocks from era files")
+}
+
+// eraAvailability checks the availability of the blobs of blocks read from era files, which do not hold any. Blocks
+// whose blobs are outside the retention period do not need them.
+type eraAvailability struct{}
+
+func (eraAvailability) IsDataAvailable(_ context.Context, current primitives.Slot, b blocks.ROBlock) error {
+	if b.Version() < version.Deneb {
+		return nil
+	}
+	if !params.WithinDAPeriod(slots.ToEpoch(b.Block().Slot()), slots.ToEpoch(current)) {
+		return nil
+	}
+	c, err := b.Block().Body().BlobKzgCommitments()
+	if err != nil {
+		return errors.Wrapf(err, "could not get commitments of block %#x", b.Root())
+	}
+	if len(c) == 0 {
+		return nil
+	}
+	return errors.Wrapf(errEraBlobsRequired, "block at slot %d with %d commitments", b.Block().Slot(), len(c))
+}
+
+func (eraAvailability) Persist(primitives.Slot, ...blocks.ROBlob) error {
+	return errEraBlobsRequired
+}
@@ -0,0 +1,160 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/era"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/proto/dbval"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// writeTestEras writes the era files holding the given blocks, and returns their paths.
+func writeTestEras(t *testing.T, dir string, blks []blocks.ROBlock) []string {
+	var paths []string
+	for e := uint64(1); e <= era.ForSlot(blks[len(blks)-1].Block().Slot()); e++ {
+		path := filepath.Join(dir, fmt.Sprintf("mainnet-%05d-00000000.era", e))
+		f, err := os.Create(path)
+		require.NoError(t, err)
+		w, err := era.NewWriter(f, e)
+		require.NoError(t, err)
+		start, end := era.BlockSlots(e)
+		for _, b := range blks {
+			if b.Block().Slot() >= start && b.Block().Slot() < end {
+				require.NoError(t, w.WriteBlock(b))
+			}
+		}
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(era.StateSlot(e)))
+		require.NoError(t, w.Finish(st))
+		require.NoError(t, f.Close())
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+// testEraStore returns a Store whose origin is the last of the blocks, with the validators of the given keys.
+func testEraStore(t *testing.T, blks []blocks.ROBlock, pks []bls.PublicKey, vr []byte) (*Store, *mockBackfillDB) {
+	origin := blks[len(blks)-1]
+	vals := make([]*ethpb.Validator, len(pks))
+	for i := range pks {
+		vals[i] = &ethpb.Validator{PublicKey: pks[i].Marshal(), WithdrawalCredentials: make([]byte, fieldparams.RootLength)}
+	}
+	st, err := util.NewBeaconState(func(s *ethpb.BeaconState) error {
+		s.Validators = vals
+		s.GenesisValidatorsRoot = vr
+		return nil
+	})
+	require.NoError(t, err)
+	parent := origin.Block().ParentRoot()
+	mdb := &mockBackfillDB{
+		status: &dbval.BackfillStatus{
+			LowSlot:       uint64(origin.Block().Slot()),
+			LowRoot:       origin.RootSlice(),
+			LowParentRoot: parent[:],
+			OriginSlot:    uint64(origin.Block().Slot()),
+			OriginRoot:    origin.RootSlice(),
+		},
+		states: map[[32]byte]state.BeaconState{origin.Root(): st},
+	}
+	su, err := NewUpdater(context.Background(), mdb)
+	require.NoError(t, err)
+	return su, mdb
+}
+
+// setupEraTestConfig uses small eras, with the deneb blocks of the tests from genesis.
+func setupEraTestConfig(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.MainnetConfig().Copy()
+	cfg.SlotsPerHistoricalRoot = 16
+	cfg.AltairForkEpoch = 0
+	cfg.BellatrixForkEpoch = 0
+	cfg.CapellaForkEpoch = 0
+	cfg.DenebForkEpoch = 0
+	cfg.InitializeForkSchedule()
+	params.SetActiveTestCleanup(t, cfg)
+}
+
+func TestImportEras(t *testing.T) {
+	setupEraTestConfig(t)
+	ctx := context.Background()
+	vr := make([]byte, 32)
+	copy(vr, "yooooo")
+	blks, _, _, pks := testBlocksWithKeys(t, 40, 0, vr)
+	dir := t.TempDir()
+	writeTestEras(t, dir, blks)
+	su, mdb := testEraStore(t, blks, pks, vr)
+
+	n, err := ImportEras(ctx, su, dir, 40, 5)
+	require.NoError(t, err)
+	// The genesis block and the origin block are not imported.
+	require.Equal(t, 38, n)
+	require.Equal(t, uint64(1), su.status().LowSlot)
+	require.Equal(t, true, su.AvailableBlock(1))
+	for _, b := range blks[1:39] {
+		_, ok := mdb.blocks[b.Root()]
+		require.Equal(t, true, ok)
+	}
+
+	// Nothing is left to import.
+	n, err = ImportEras(ctx, su, dir, 40, 5)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+}
+
+func TestImportEras_MissingFile(t *testing.T) {
+	setupEraTestConfig(t)
+	ctx := context.Background()
+	vr := make([]byte, 32)
+	copy(vr, "yooooo")
+	blks, _, _, pks := testBlocksWithKeys(t, 40, 0, vr)
+	dir := t.TempDir()
+	paths := writeTestEras(t, dir, blks)
+	require.NoError(t, os.Remove(paths[1]))
+	su, _ := testEraStore(t, blks, pks, vr)
+
+	n, err := ImportEras(ctx, su, dir, 40, 64)
+	require.ErrorContains(t, "era file 2, holding the blocks below slot 32, is missing", err)
+	require.Equal(t, 7, n)
+	require.Equal(t, uint64(32), su.status().LowSlot)
+}
+
+func TestImportEras_InvalidSignature(t *testing.T) {
+	setupEraTestConfig(t)
+	vr := make([]byte, 32)
+	copy(vr, "yooooo")
+	blks, _, _, pks := testBlocksWithKeys(t, 20, 0, vr)
+	dir := t.TempDir()
+	writeTestEras(t, dir, blks)
+	// The verifier checks the signatures with the keys of another network.
+	su, _ := testEraStore(t, blks, pks, make([]byte, 32))
+
+	n, err := ImportEras(context.Background(), su, dir, 20, 64)
+	require.ErrorContains(t, "invalid blocks in era 2", err)
+	require.Equal(t, 0, n)
+	require.Equal(t, uint64(19), su.status().LowSlot)
+}
+
+func TestEraAvailability(t *testing.T) {
+	ctx := context.Background()
+	withBlobs, _ := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 10, 1)
+	withoutBlobs, _ := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 10, 0)
+	outside := slots.UnsafeEpochStart(params.BeaconConfig().MinEpochsForBlobsSidecarsRequest + 10)
+
+	require.ErrorIs(t, eraAvailability{}.IsDataAvailable(ctx, 20, withBlobs), errEraBlobsRequired)
+	require.NoError(t, eraAvailability{}.IsDataAvailable(ctx, 20, withoutBlobs))
+	require.NoError(t, eraAvailability{}.IsDataAvailable(ctx, outside, withBlobs))
+	require.ErrorIs(t, eraAvailability{}.Persist(20), errEraBlobsRequired)
+}
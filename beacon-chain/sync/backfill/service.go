@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
@@ -39,6 +40,7 @@ type Service struct {
 	batchImporter   batchImporter
 	blobStore       *filesystem.BlobStorage
 	initSyncWaiter  func() error
+	eraDir          string
 }
 
 var _ runtime.Service = (*Service)(nil)
@@ -164,19 +166,24 @@ func (s *Service) initVerifier(ctx context.Context) (*verifier, sync.ContextByte
 	if err != nil {
 		return nil, nil, err
 	}
-	keys, err := cps.PublicKeys()
-	if err != nil {
-		return nil, nil, errors.Wrap(err, "unable to retrieve public keys for all validators in the origin state")
-	}
 	vr := cps.GenesisValidatorsRoot()
 	ctxMap, err := sync.ContextByteVersionsForValRoot(bytesutil.ToBytes32(vr))
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "unable to initialize context version map using genesis validator root %#x", vr)
 	}
-	v, err := newBackfillVerifier(vr, keys)
+	v, err := newOriginVerifier(cps)
 	return v, ctxMap, err
 }
 
+// newOriginVerifier creates a verifier for the blocks below the checkpoint sync origin, using its state.
+func newOriginVerifier(cps state.BeaconState) (*verifier, error) {
+	keys, err := cps.PublicKeys()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to retrieve public keys for all validators in the origin state")
+	}
+	return newBackfillVerifier(cps.GenesisValidatorsRoot(), keys)
+}
+
 func (s *Service) updateComplete() bool {
 	b, err := s.pool.complete()
 	if err != nil {
@@ -248,7 +255,7 @@ func (s *Service) scheduleTodos() {
 
 // Start begins the runloop of backfill.Service in the current goroutine.
 func (s *Service) Start() {
-	if !s.enabled {
+	if !s.enabled && s.eraDir == "" {
 		log.Info("Backfill service not enabled")
 		return
 	}
@@ -275,6 +282,12 @@ func (s *Service) Start() {
 		log.Info("Backfill short-circuit; node synced from genesis")
 		return
 	}
+	if s.eraDir != "" {
+		s.importEras(ctx)
+	}
+	if !s.enabled {
+		return
+	}
 	status := s.store.status()
 	// Exit early if there aren't going to be any batches to backfill.
 	if primitives.Slot(status.LowSlot) <= s.ms(s.clock.CurrentSlot()) {
@@ -308,6 +321,10 @@ func (s *Service) Start() {
 			return
 		}
 		if s.updateComplete() {
+			// The blocks below the retention period, which may be behind blocks with blobs, can now be backfilled.
+			if s.eraDir != "" {
+				s.importEras(ctx)
+			}
 			return
 		}
 		s.importBatches(ctx)
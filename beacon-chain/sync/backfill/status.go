@@ -58,6 +58,20 @@ func (s *Store) AvailableBlock(sl primitives.Slot) bool {
 	return false
 }
 
+// Reload reads the backfill status from the database again, after something other than the Store changed it, like
+// the pruning of historical blocks.
+func (s *Store) Reload(ctx context.Context) error {
+	status, err := s.store.BackfillStatus(ctx)
+	if err != nil {
+		return errors.Wrap(err, "db error while reading backfill status")
+	}
+	s.Lock()
+	defer s.Unlock()
+	s.genesisSync = false
+	s.bs = status
+	return nil
+}
+
 // Status is a threadsafe method to access a copy of the BackfillStatus value.
 func (s *Store) status() *dbval.BackfillStatus {
 	s.RLock()
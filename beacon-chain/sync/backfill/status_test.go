@@ -254,3 +254,23 @@ func TestNewUpdater(t *testing.T) {
 	}
 
 }
+
+func TestStatusUpdater_Reload(t *testing.T) {
+	ctx := context.Background()
+	mdb := &mockBackfillDB{}
+	s := &Store{store: mdb, genesisSync: true}
+	require.Equal(t, true, s.AvailableBlock(10))
+
+	// The history of a node synced from genesis was pruned.
+	mdb.status = &dbval.BackfillStatus{LowSlot: 20, OriginSlot: 20}
+	require.NoError(t, s.Reload(ctx))
+	require.Equal(t, true, s.AvailableBlock(0))
+	require.Equal(t, false, s.AvailableBlock(10))
+	require.Equal(t, true, s.AvailableBlock(20))
+
+	mdb.backfillStatus = func(context.Context) (*dbval.BackfillStatus, error) {
+		return nil, errEmptyMockDBMethod
+	}
+	require.ErrorIs(t, s.Reload(ctx), errEmptyMockDBMethod)
+	require.Equal(t, false, s.AvailableBlock(10))
+}
@@ -2,6 +2,7 @@ package checkpoint
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
@@ -10,19 +11,37 @@ import (
 )
 
 // APIInitializer manages initializing the beacon node using checkpoint sync, retrieving the checkpoint state and root
-// from the remote beacon node api.
+// from the remote beacon node api. When several beacon nodes are given, a quorum of them must agree on the finalized
+// checkpoint.
 type APIInitializer struct {
-	c *beacon.Client
+	clients []*beacon.Client
+	quorum  int
 }
 
 // NewAPIInitializer creates an APIInitializer, handling the set up of a beacon node api client
 // using the provided host string.
 func NewAPIInitializer(beaconNodeHost string) (*APIInitializer, error) {
-	c, err := beacon.NewClient(beaconNodeHost)
-	if err != nil {
-		return nil, errors.Wrapf(err, "unable to parse beacon node url or hostname - %s", beaconNodeHost)
+	return NewQuorumAPIInitializer([]string{beaconNodeHost}, 1)
+}
+
+// NewQuorumAPIInitializer creates an APIInitializer which downloads the checkpoint from the given beacon nodes, once
+// at least quorum of them agree on the finalized checkpoint.
+func NewQuorumAPIInitializer(beaconNodeHosts []string, quorum int) (*APIInitializer, error) {
+	if len(beaconNodeHosts) == 0 {
+		return nil, errors.New("no beacon node urls provided for checkpoint sync")
+	}
+	if quorum < 1 || quorum > len(beaconNodeHosts) {
+		return nil, fmt.Errorf("checkpoint sync quorum must be between 1 and the number of beacon node urls (%d), got %d", len(beaconNodeHosts), quorum)
 	}
-	return &APIInitializer{c: c}, nil
+	clients := make([]*beacon.Client, len(beaconNodeHosts))
+	for i, host := range beaconNodeHosts {
+		c, err := beacon.NewClient(host)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to parse beacon node url or hostname - %s", host)
+		}
+		clients[i] = c
+	}
+	return &APIInitializer{clients: clients, quorum: quorum}, nil
 }
 
 // Initialize downloads origin state and block for checkpoint sync and initializes database records to
@@ -37,7 +56,12 @@ func (dl *APIInitializer) Initialize(ctx context.Context, d db.Database) error {
 			return errors.Wrap(err, "error while checking database for origin root")
 		}
 	}
-	od, err := beacon.DownloadFinalizedData(ctx, dl.c)
+	var od *beacon.OriginData
+	if len(dl.clients) == 1 {
+		od, err = beacon.DownloadFinalizedData(ctx, dl.clients[0])
+	} else {
+		od, err = beacon.DownloadFinalizedDataWithQuorum(ctx, dl.clients, dl.quorum)
+	}
 	if err != nil {
 		return errors.Wrap(err, "Error retrieving checkpoint origin state and block")
 	}
@@ -0,0 +1,205 @@
+package checkpoint
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/era"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// EraInitializer initializes a beacon-node database from the state of an era file, so that the node syncs from
+// that state and backfills the blocks below it from the other era files. Era files are not authenticated, so the
+// state is only used if the latest block applied to it matches a trusted weak subjectivity checkpoint.
+type EraInitializer struct {
+	dir     string
+	trusted *ethpb.Checkpoint
+}
+
+// NewEraInitializer creates an EraInitializer using the era files of the given directory, and the trusted
+// checkpoint of the era state to initialize the database from.
+func NewEraInitializer(dir string, trusted *ethpb.Checkpoint) (*EraInitializer, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error checking existence of era directory %s", dir)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+	if trusted == nil || len(trusted.Root) != fieldparams.RootLength {
+		return nil, errors.New("a trusted checkpoint is required to initialize from era files")
+	}
+	return &EraInitializer{dir: dir, trusted: trusted}, nil
+}
+
+// Initialize saves the era state of the trusted checkpoint as the origin of the database, along with the block it is
+// built upon. Era files do not hold blobs, so the state must be older than the blob retention period, and the blocks
+// that need blobs are synced from peers.
+func (ei *EraInitializer) Initialize(ctx context.Context, d db.Database) error {
+	origin, err := d.OriginCheckpointBlockRoot(ctx)
+	if err == nil && origin != params.BeaconConfig().ZeroHash {
+		log.Warnf("Origin checkpoint root %#x found in db, ignoring era directory for checkpoint sync", origin)
+		return nil
+	} else {
+		if !errors.Is(err, db.ErrNotFound) {
+			return errors.Wrap(err, "error while checking database for origin root")
+		}
+	}
+	cp, err := d.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error while checking database for finalized checkpoint")
+	}
+	if cp.Epoch > 0 {
+		log.WithField("finalizedEpoch", cp.Epoch).Info("Database synced from genesis, ignoring era directory for checkpoint sync")
+		return nil
+	}
+
+	files, err := era.ListFiles(ei.dir)
+	if err != nil {
+		return err
+	}
+	r, err := ei.originEra(files)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.WithError(err).Error("Could not close era file")
+		}
+	}()
+	serState, serBlock, err := originData(r, files, bytesutil.ToBytes32(ei.trusted.Root))
+	if err != nil {
+		return errors.Wrapf(err, "could not read origin data of era %d", r.Era())
+	}
+	log.WithFields(logrus.Fields{
+		"era":  r.Era(),
+		"slot": era.StateSlot(r.Era()),
+		"root": fmt.Sprintf("%#x", ei.trusted.Root),
+	}).Info("Initializing checkpoint sync from era file matching the weak subjectivity checkpoint")
+	return d.SaveOrigin(ctx, serState, serBlock)
+}
+
+// originEra opens the era file whose state is at the epoch of the trusted checkpoint, checking that the state is
+// older than the blob retention period.
+func (ei *EraInitializer) originEra(files []era.File) (*era.Reader, error) {
+	slot, err := slots.EpochStart(ei.trusted.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	perEra := params.BeaconConfig().SlotsPerHistoricalRoot
+	if slot == 0 || slot%perEra != 0 {
+		return nil, fmt.Errorf("checkpoint epoch %d is not the epoch of an era state, which is a multiple of %d",
+			ei.trusted.Epoch, perEra/params.BeaconConfig().SlotsPerEpoch)
+	}
+	number := uint64(slot / perEra)
+	path := ""
+	for _, f := range files {
+		if f.Era == number {
+			path = f.Path
+		}
+	}
+	if path == "" {
+		return nil, fmt.Errorf("no era file in %s holds the state of era %d, at checkpoint epoch %d", ei.dir, number, ei.trusted.Epoch)
+	}
+
+	r, err := era.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	serState, err := r.StateSSZ()
+	if err != nil {
+		_ = r.Close()
+		return nil, err
+	}
+	// The genesis time is the first field of the state.
+	if len(serState) < 8 {
+		_ = r.Close()
+		return nil, errors.New("era state is too short")
+	}
+	current := slots.ToEpoch(slots.CurrentSlot(binary.LittleEndian.Uint64(serState[:8])))
+	if ei.trusted.Epoch >= params.BeaconConfig().DenebForkEpoch && params.WithinDAPeriod(ei.trusted.Epoch, current) {
+		_ = r.Close()
+		return nil, fmt.Errorf("state of era %d is within the blob retention period, which era files do not cover, use an older checkpoint", number)
+	}
+	return r, nil
+}
+
+// originData returns the state of the era, and the latest block applied to it, which may be in an earlier era. The
+// root of the latest block must be the trusted root.
+func originData(r *era.Reader, files []era.File, trustedRoot [32]byte) ([]byte, []byte, error) {
+	serState, err := r.StateSSZ()
+	if err != nil {
+		return nil, nil, err
+	}
+	cf, err := detect.FromState(serState)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not detect the fork of the era state")
+	}
+	st, err := cf.UnmarshalBeaconState(serState)
+	if err != nil {
+		return nil, nil, err
+	}
+	header := st.LatestBlockHeader()
+	if bytesutil.ToBytes32(header.StateRoot) == params.BeaconConfig().ZeroHash {
+		return nil, nil, errors.New("era state is at the slot of its latest block")
+	}
+	blockRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return nil, nil, err
+	}
+	if blockRoot != trustedRoot {
+		return nil, nil, fmt.Errorf("latest block root %#x of the era state does not match the trusted checkpoint root %#x", blockRoot, trustedRoot)
+	}
+
+	br := r
+	if blockEra := era.ForSlot(header.Slot); blockEra != r.Era() {
+		path := ""
+		for _, f := range files {
+			if f.Era == blockEra {
+				path = f.Path
+			}
+		}
+		if path == "" {
+			return nil, nil, fmt.Errorf("era file %d, holding the latest block of the state, is missing", blockEra)
+		}
+		if br, err = era.Open(path); err != nil {
+			return nil, nil, err
+		}
+		defer func() {
+			if err := br.Close(); err != nil {
+				log.WithError(err).Error("Could not close era file")
+			}
+		}()
+	}
+	blk, err := br.Block(header.Slot)
+	if err != nil {
+		return nil, nil, err
+	}
+	if blk == nil {
+		return nil, nil, fmt.Errorf("latest block of the state, at slot %d, is missing", header.Slot)
+	}
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return nil, nil, err
+	}
+	if root != blockRoot {
+		return nil, nil, fmt.Errorf("block at slot %d has root %#x, not the root %#x of the latest block of the state", header.Slot, root, blockRoot)
+	}
+	serBlock, err := blk.MarshalSSZ()
+	if err != nil {
+		return nil, nil, err
+	}
+	return serState, serBlock, nil
+}
+
+var _ Initializer = &EraInitializer{}
@@ -0,0 +1,106 @@
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/era"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+// writeOriginEra writes the file of era 1, whose state is built upon a block of the era, and returns the root of
+// that block.
+func writeOriginEra(t *testing.T, dir string) [32]byte {
+	b := util.NewBeaconBlock()
+	b.Block.Slot = era.StateSlot(1) - 3
+	b.Block.StateRoot = bytesutil.PadTo([]byte{1}, 32)
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	header, err := blk.Header()
+	require.NoError(t, err)
+	root, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(era.StateSlot(1)))
+	require.NoError(t, st.SetLatestBlockHeader(header.Header))
+
+	f, err := os.Create(filepath.Join(dir, fmt.Sprintf("mainnet-%05d-00000000.era", 1)))
+	require.NoError(t, err)
+	w, err := era.NewWriter(f, 1)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteBlock(blk))
+	require.NoError(t, w.Finish(st))
+	require.NoError(t, f.Close())
+	return root
+}
+
+func TestEraInitializer_Initialize(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.MainnetConfig().Copy()
+	cfg.SlotsPerHistoricalRoot = 64
+	params.OverrideBeaconConfig(cfg)
+	eraEpoch := primitives.Epoch(params.BeaconConfig().SlotsPerHistoricalRoot / params.BeaconConfig().SlotsPerEpoch)
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	root := writeOriginEra(t, dir)
+
+	_, err := NewEraInitializer(dir, nil)
+	require.ErrorContains(t, "trusted checkpoint is required", err)
+
+	tests := []struct {
+		name    string
+		trusted *ethpb.Checkpoint
+		wantErr string
+	}{
+		{
+			name:    "not an era state epoch",
+			trusted: &ethpb.Checkpoint{Epoch: 1, Root: root[:]},
+			wantErr: "is not the epoch of an era state",
+		},
+		{
+			name:    "missing era file",
+			trusted: &ethpb.Checkpoint{Epoch: 2 * eraEpoch, Root: root[:]},
+			wantErr: "no era file",
+		},
+		{
+			name:    "untrusted state",
+			trusted: &ethpb.Checkpoint{Epoch: eraEpoch, Root: bytesutil.PadTo([]byte{2}, 32)},
+			wantErr: "does not match the trusted checkpoint root",
+		},
+		{
+			name:    "trusted state",
+			trusted: &ethpb.Checkpoint{Epoch: eraEpoch, Root: root[:]},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := dbtest.SetupDB(t)
+			ei, err := NewEraInitializer(dir, tt.trusted)
+			require.NoError(t, err)
+			err = ei.Initialize(ctx, d)
+			origin, originErr := d.OriginCheckpointBlockRoot(ctx)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				require.ErrorIs(t, originErr, db.ErrNotFound)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, originErr)
+			require.Equal(t, root, origin)
+		})
+	}
+}
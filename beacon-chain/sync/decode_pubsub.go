@@ -74,6 +74,18 @@ func (s *Service) decodePubsubMessage(msg *pubsub.Message) (ssz.Unmarshaler, err
 			return nil, err
 		}
 	}
+	if topic == p2p.LightClientFinalityUpdateTopicFormat {
+		m, err = extractLightClientType(fDigest[:], s.cfg.clock, types.LightClientFinalityUpdateMap)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if topic == p2p.LightClientOptimisticUpdateTopicFormat {
+		m, err = extractLightClientType(fDigest[:], s.cfg.clock, types.LightClientOptimisticUpdateMap)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if err := s.cfg.p2p.Encoding().DecodeGossip(msg.Data, m); err != nil {
 		return nil, err
@@ -141,6 +153,36 @@ func extractAggregateAndProofType(digest []byte, tor blockchain.TemporalOracle)
 	)
 }
 
+// extractLightClientType returns an empty light client object of the type that the given type map
+// assigns to the fork of the digest.
+func extractLightClientType(digest []byte, tor blockchain.TemporalOracle, typeMap map[[4]byte]func() proto.Message) (ssz.Unmarshaler, error) {
+	if len(digest) != forkDigestLength {
+		return nil, errors.Errorf("invalid digest returned, wanted a length of %d but received %d", forkDigestLength, len(digest))
+	}
+	vRoot := tor.GenesisValidatorsRoot()
+	for k, mFunc := range typeMap {
+		rDigest, err := signing.ComputeForkDigest(k[:], vRoot[:])
+		if err != nil {
+			return nil, err
+		}
+		if rDigest == bytesutil.ToBytes4(digest) {
+			msg := mFunc()
+			m, ok := msg.(ssz.Unmarshaler)
+			if !ok {
+				return nil, errors.Errorf("message of %T does not support marshaller interface", msg)
+			}
+			return m, nil
+		}
+	}
+	return nil, errors.Wrapf(
+		ErrNoValidDigest,
+		"could not extract light client data type, saw digest=%#x, genesis=%v, vr=%#x",
+		digest,
+		tor.GenesisTime(),
+		tor.GenesisValidatorsRoot(),
+	)
+}
+
 // Replaces our fork digest with the formatter.
 func (_ *Service) replaceForkDigest(topic string) (string, error) {
 	subStrings := strings.Split(topic, "/")
@@ -135,6 +135,11 @@ func validateRangeRequest(r *pb.BeaconBlocksByRangeRequest, current primitives.S
 
 func (s *Service) validateRangeAvailability(rp rangeParams) bool {
 	startBlock := rp.start
+	// The genesis block is always available, even once the blocks following it have been pruned, so a range starting
+	// at genesis is only available if the block after it is.
+	if startBlock == 0 && rp.end > 0 {
+		startBlock = 1
+	}
 	return s.availableBlocker.AvailableBlock(startBlock)
 }
 
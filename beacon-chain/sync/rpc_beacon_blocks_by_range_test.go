@@ -21,6 +21,7 @@ import (
 	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
 	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/backfill/coverage"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -1104,6 +1105,67 @@ func TestRPCBeaconBlocksByRange_FilterBlocks_PreviousRoot(t *testing.T) {
 	require.NotEqual(t, cf.prevRoot, [32]byte{})
 }
 
+func TestRPCBeaconBlocksByRange_validateRangeAvailability(t *testing.T) {
+	cases := []struct {
+		name    string
+		blocker coverage.AvailableBlocker
+		rp      rangeParams
+		avail   bool
+	}{
+		{name: "unpruned/genesis only", blocker: lowSlotBlocker{}, rp: rangeParams{start: 0, end: 0}, avail: true},
+		{name: "unpruned/from genesis", blocker: lowSlotBlocker{}, rp: rangeParams{start: 0, end: 200}, avail: true},
+		{name: "unpruned/after genesis", blocker: lowSlotBlocker{}, rp: rangeParams{start: 50, end: 200}, avail: true},
+		// Blocks from slot 100 are available on the pruned node, as well as the genesis block.
+		{name: "pruned/genesis only", blocker: lowSlotBlocker{low: 100}, rp: rangeParams{start: 0, end: 0}, avail: true},
+		{name: "pruned/from genesis", blocker: lowSlotBlocker{low: 100}, rp: rangeParams{start: 0, end: 200}, avail: false},
+		{name: "pruned/before pruned slot", blocker: lowSlotBlocker{low: 100}, rp: rangeParams{start: 99, end: 200}, avail: false},
+		{name: "pruned/from pruned slot", blocker: lowSlotBlocker{low: 100}, rp: rangeParams{start: 100, end: 200}, avail: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := &Service{availableBlocker: c.blocker}
+			require.Equal(t, c.avail, s.validateRangeAvailability(c.rp))
+		})
+	}
+}
+
+func TestRPCBeaconBlocksByRange_PrunedRange(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	d := db.SetupDB(t)
+	clock := startup.NewClock(time.Unix(0, 0), [32]byte{})
+	r := &Service{cfg: &config{p2p: p1, beaconDB: d, clock: clock, chain: &chainMock.ChainService{}}, availableBlocker: lowSlotBlocker{low: 100}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCBlocksByRangeTopicV1)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		code, errMsg, err := ReadStatusCode(stream, p1.Encoding())
+		require.NoError(t, err)
+		assert.Equal(t, responseCodeResourceUnavailable, code)
+		assert.Equal(t, p2ptypes.ErrResourceUnavailable.Error(), errMsg)
+	})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req := &ethpb.BeaconBlocksByRangeRequest{StartSlot: 0, Step: 1, Count: 64}
+	require.NoError(t, r.beaconBlocksByRangeRPCHandler(context.Background(), req, stream))
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+// lowSlotBlocker makes the blocks from its low slot available, as well as the genesis block, like the backfill status
+// of a pruned node. All blocks are available if the low slot is zero.
+type lowSlotBlocker struct {
+	low primitives.Slot
+}
+
+func (m lowSlotBlocker) AvailableBlock(s primitives.Slot) bool {
+	return s == 0 || s >= m.low
+}
+
 type mockBlocker struct {
 	avail bool
 }
@@ -0,0 +1,316 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+func testLightClientOptimisticUpdate(slot primitives.Slot) *pb.LightClientOptimisticUpdateAltair {
+	return &pb.LightClientOptimisticUpdateAltair{
+		AttestedHeader: &pb.LightClientHeaderAltair{
+			Beacon: &pb.BeaconBlockHeader{
+				Slot:       slot,
+				ParentRoot: bytesutil.PadTo([]byte{'a'}, 32),
+				StateRoot:  bytesutil.PadTo([]byte{'b'}, 32),
+				BodyRoot:   bytesutil.PadTo([]byte{'c'}, 32),
+			},
+		},
+		SyncAggregate: &pb.SyncAggregate{
+			SyncCommitteeBits:      make([]byte, params.BeaconConfig().SyncCommitteeSize/8),
+			SyncCommitteeSignature: make([]byte, 96),
+		},
+		SignatureSlot: slot + 1,
+	}
+}
+
+// setupLightClientForkConfig schedules Altair to Deneb one after the other, with Capella and Deneb starting the second
+// and third sync committee periods.
+func setupLightClientForkConfig(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	cfg.BellatrixForkEpoch = 1
+	cfg.CapellaForkEpoch = cfg.EpochsPerSyncCommitteePeriod
+	cfg.DenebForkEpoch = cfg.EpochsPerSyncCommitteePeriod * 2
+	cfg.InitializeForkSchedule()
+	params.OverrideBeaconConfig(cfg)
+}
+
+func TestLightClientOptimisticUpdateRPCHandler_ServesLatestUpdate(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+
+	chain := &mock.ChainService{Genesis: time.Now(), ValidatorsRoot: [32]byte{'A'}}
+	r := &Service{
+		cfg: &config{
+			p2p:   p1,
+			chain: chain,
+			clock: startup.NewClock(chain.Genesis, chain.ValidatorsRoot),
+		},
+		rateLimiter: newRateLimiter(p1),
+	}
+	update := testLightClientOptimisticUpdate(10)
+	r.setLightClientOptimisticUpdate(update)
+
+	pcl := protocol.ID(p2p.RPCLightClientOptimisticUpdateTopicV1 + r.cfg.p2p.Encoding().ProtocolSuffix())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectSuccess(t, stream)
+		ctxBytes, err := readContextFromStream(stream)
+		require.NoError(t, err)
+		digest, err := forks.ForkDigestFromEpoch(0, chain.ValidatorsRoot[:])
+		require.NoError(t, err)
+		assert.DeepEqual(t, digest[:], ctxBytes)
+		out := &pb.LightClientOptimisticUpdateAltair{}
+		require.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, out))
+		assert.DeepSSZEqual(t, update, out)
+	})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.lightClientOptimisticUpdateRPCHandler(context.Background(), new(interface{}), stream))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientFinalityUpdateRPCHandler_NoUpdate(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+
+	chain := &mock.ChainService{Genesis: time.Now(), ValidatorsRoot: [32]byte{'A'}}
+	r := &Service{
+		cfg: &config{
+			p2p:   p1,
+			chain: chain,
+			clock: startup.NewClock(chain.Genesis, chain.ValidatorsRoot),
+		},
+		rateLimiter: newRateLimiter(p1),
+	}
+
+	pcl := protocol.ID(p2p.RPCLightClientFinalityUpdateTopicV1 + r.cfg.p2p.Encoding().ProtocolSuffix())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+	})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.ErrorIs(t, r.lightClientFinalityUpdateRPCHandler(context.Background(), new(interface{}), stream), p2ptypes.ErrResourceUnavailable)
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientUpdatesByRangeRPCHandler_InvalidCount(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+
+	chain := &mock.ChainService{Genesis: time.Now(), ValidatorsRoot: [32]byte{'A'}}
+	r := &Service{
+		cfg: &config{
+			p2p:   p1,
+			chain: chain,
+			clock: startup.NewClock(chain.Genesis, chain.ValidatorsRoot),
+		},
+		rateLimiter: newRateLimiter(p1),
+	}
+
+	pcl := protocol.ID(p2p.RPCLightClientUpdatesByRangeTopicV1 + r.cfg.p2p.Encoding().ProtocolSuffix())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeInvalidRequest, p2ptypes.ErrInvalidRequest.Error(), stream)
+	})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req := &pb.LightClientUpdatesByRangeRequest{StartPeriod: 0, Count: params.BeaconConfig().MaxRequestLightClientUpdates + 1}
+	require.ErrorIs(t, r.lightClientUpdatesByRangeRPCHandler(context.Background(), req, stream), p2ptypes.ErrInvalidRequest)
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientUpdatesByRangeRPCHandler_ServesStoredUpdates(t *testing.T) {
+	setupLightClientForkConfig(t)
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+
+	cfg := params.BeaconConfig()
+	slotsPerPeriod := primitives.Slot(cfg.EpochsPerSyncCommitteePeriod) * cfg.SlotsPerEpoch
+	headState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, headState.SetSlot(slotsPerPeriod*5))
+
+	beaconDB := dbtest.SetupDB(t)
+	ctx := context.Background()
+	altair := util.NewLightClientUpdateAltair()
+	altair.AttestedHeader.Beacon.Slot = 10
+	capella := util.NewLightClientUpdateCapella()
+	capella.AttestedHeader.Beacon.Slot = slotsPerPeriod + 10
+	capella.AttestedHeader.Execution.BlockNumber = 1
+	deneb := util.NewLightClientUpdateDeneb()
+	deneb.AttestedHeader.Beacon.Slot = slotsPerPeriod*2 + 10
+	deneb.AttestedHeader.Execution.BlobGasUsed = 2
+	later := util.NewLightClientUpdateDeneb()
+	later.AttestedHeader.Beacon.Slot = slotsPerPeriod*4 + 10
+	updates := []pb.LightClientUpdate{altair, capella, deneb}
+	for period, update := range updates {
+		require.NoError(t, beaconDB.SaveLightClientUpdate(ctx, uint64(period), update))
+	}
+	// Period 3 is missing, so the update of period 4 cannot be served.
+	require.NoError(t, beaconDB.SaveLightClientUpdate(ctx, 4, later))
+
+	chain := &mock.ChainService{Genesis: time.Now(), ValidatorsRoot: [32]byte{'A'}, State: headState}
+	r := &Service{
+		cfg: &config{
+			p2p:      p1,
+			chain:    chain,
+			clock:    startup.NewClock(chain.Genesis, chain.ValidatorsRoot),
+			beaconDB: beaconDB,
+		},
+		rateLimiter: newRateLimiter(p1),
+	}
+
+	pcl := protocol.ID(p2p.RPCLightClientUpdatesByRangeTopicV1 + r.cfg.p2p.Encoding().ProtocolSuffix())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		for _, want := range updates {
+			expectSuccess(t, stream)
+			ctxBytes, err := readContextFromStream(stream)
+			require.NoError(t, err)
+			digest, err := forks.ForkDigestFromEpoch(slots.ToEpoch(want.GetAttestedHeaderVal().GetBeacon().Slot), chain.ValidatorsRoot[:])
+			require.NoError(t, err)
+			assert.DeepEqual(t, digest[:], ctxBytes)
+			out, ok := want.ProtoReflect().New().Interface().(pb.LightClientUpdate)
+			require.Equal(t, true, ok)
+			require.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, out))
+			assert.DeepSSZEqual(t, want, out)
+		}
+	})
+	stream, err := p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req := &pb.LightClientUpdatesByRangeRequest{StartPeriod: 0, Count: 5}
+	require.NoError(t, r.lightClientUpdatesByRangeRPCHandler(ctx, req, stream))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientBootstrapRPCHandler_ServesBootstrapOfFork(t *testing.T) {
+	setupLightClientForkConfig(t)
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+
+	ctx := context.Background()
+	slot, err := slots.EpochStart(params.BeaconConfig().DenebForkEpoch)
+	require.NoError(t, err)
+	st, err := util.NewBeaconStateDeneb()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	blk := util.NewBeaconBlockDeneb()
+	blk.Block.Slot = slot
+	blk.Block.Body.ExecutionPayload.BlockNumber = 3
+	signed, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	header, err := signed.Header()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(header.Header))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	signed, err = blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	blockRoot, err := signed.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	beaconDB := dbtest.SetupDB(t)
+	require.NoError(t, beaconDB.SaveBlock(ctx, signed))
+	require.NoError(t, beaconDB.SaveState(ctx, st, blockRoot))
+
+	chain := &mock.ChainService{Genesis: time.Now(), ValidatorsRoot: [32]byte{'A'}}
+	r := &Service{
+		cfg: &config{
+			p2p:      p1,
+			chain:    chain,
+			clock:    startup.NewClock(chain.Genesis, chain.ValidatorsRoot),
+			beaconDB: beaconDB,
+			stateGen: stategen.New(beaconDB, doublylinkedtree.New()),
+		},
+		rateLimiter: newRateLimiter(p1),
+	}
+
+	pcl := protocol.ID(p2p.RPCLightClientBootstrapTopicV1 + r.cfg.p2p.Encoding().ProtocolSuffix())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectSuccess(t, stream)
+		ctxBytes, err := readContextFromStream(stream)
+		require.NoError(t, err)
+		digest, err := forks.ForkDigestFromEpoch(params.BeaconConfig().DenebForkEpoch, chain.ValidatorsRoot[:])
+		require.NoError(t, err)
+		assert.DeepEqual(t, digest[:], ctxBytes)
+		out := &pb.LightClientBootstrapDeneb{}
+		require.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, out))
+		assert.Equal(t, slot, out.Header.Beacon.Slot)
+		assert.DeepEqual(t, stateRoot[:], out.Header.Beacon.StateRoot)
+		assert.Equal(t, uint64(3), out.Header.Execution.BlockNumber)
+		assert.Equal(t, fieldparams.NextSyncCommitteeBranchDepth, len(out.CurrentSyncCommitteeBranch))
+	})
+	stream, err := p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req := p2ptypes.LightClientBootstrapReq(blockRoot)
+	require.NoError(t, r.lightClientBootstrapRPCHandler(ctx, &req, stream))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestService_MarkLightClientUpdateForwarded(t *testing.T) {
+	r := &Service{}
+	assert.Equal(t, true, r.markLightClientOptimisticUpdateForwarded(testLightClientOptimisticUpdate(10)))
+	assert.Equal(t, false, r.markLightClientOptimisticUpdateForwarded(testLightClientOptimisticUpdate(10)))
+	assert.Equal(t, false, r.markLightClientOptimisticUpdateForwarded(testLightClientOptimisticUpdate(9)))
+	assert.Equal(t, true, r.markLightClientOptimisticUpdateForwarded(testLightClientOptimisticUpdate(11)))
+}
@@ -36,9 +36,11 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/backfill/coverage"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
 	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	leakybucket "github.com/prysmaticlabs/prysm/v5/container/leaky-bucket"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime"
@@ -158,6 +160,11 @@ type Service struct {
 	newBlobVerifier                  verification.NewBlobVerifier
 	availableBlocker                 coverage.AvailableBlocker
 	ctxMap                           ContextByteVersions
+	lightClientLock                  sync.RWMutex
+	lightClientFinalityUpdate        ethpb.LightClientFinalityUpdate
+	lightClientOptimisticUpdate      ethpb.LightClientOptimisticUpdate
+	lastForwardedFinalitySlot        primitives.Slot
+	lastForwardedOptimisticSlot      primitives.Slot
 }
 
 // NewService initializes new regular sync service.
@@ -225,6 +232,9 @@ func (s *Service) Start() {
 
 	go s.verifierRoutine()
 	go s.registerHandlers()
+	if features.Get().EnableLightClient {
+		go s.lightClientUpdateRoutine()
+	}
 
 	s.cfg.p2p.AddConnectionHandler(s.reValidatePeer, s.sendGoodbye)
 	s.cfg.p2p.AddDisconnectionHandler(func(_ context.Context, _ peer.ID) error {
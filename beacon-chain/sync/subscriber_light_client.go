@@ -0,0 +1,247 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"google.golang.org/protobuf/proto"
+)
+
+// lightClientFinalityUpdateSubscriber is a no-op, as gossiped finality updates are only forwarded when
+// they match the update this node produced itself.
+func (s *Service) lightClientFinalityUpdateSubscriber(_ context.Context, msg proto.Message) error {
+	switch msg.(type) {
+	case ethpb.LightClientFinalityUpdate:
+		return nil
+	default:
+		return errors.Errorf("incorrect type of message received, wanted a light client finality update but got %T", msg)
+	}
+}
+
+// lightClientOptimisticUpdateSubscriber is a no-op, as gossiped optimistic updates are only forwarded when
+// they match the update this node produced itself.
+func (s *Service) lightClientOptimisticUpdateSubscriber(_ context.Context, msg proto.Message) error {
+	switch msg.(type) {
+	case ethpb.LightClientOptimisticUpdate:
+		return nil
+	default:
+		return errors.Errorf("incorrect type of message received, wanted a light client optimistic update but got %T", msg)
+	}
+}
+
+// lightClientUpdateRoutine keeps track of the latest light client updates produced by the blockchain service,
+// so that they can be served over req/resp, and gossips them to the network once a third of their signature
+// slot has passed.
+func (s *Service) lightClientUpdateRoutine() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case ev := <-stateChannel:
+			switch ev.Type {
+			case statefeed.LightClientFinalityUpdate:
+				data, ok := ev.Data.(*ethpbv2.LightClientFinalityUpdateWithVersion)
+				if !ok || data.Data == nil {
+					log.Errorf("Received light client finality update event of the wrong type %T", ev.Data)
+					continue
+				}
+				update, err := s.newLightClientFinalityUpdate(s.ctx, data.Data)
+				if err != nil {
+					log.WithError(err).Error("Could not create light client finality update of the attested fork")
+					continue
+				}
+				s.setLightClientFinalityUpdate(update)
+				s.broadcastLightClientUpdateAt(update.GetSignatureSlot(), func() {
+					s.broadcastLightClientFinalityUpdate(s.ctx, update)
+				})
+			case statefeed.LightClientOptimisticUpdate:
+				data, ok := ev.Data.(*ethpbv2.LightClientOptimisticUpdateWithVersion)
+				if !ok || data.Data == nil {
+					log.Errorf("Received light client optimistic update event of the wrong type %T", ev.Data)
+					continue
+				}
+				update, err := s.newLightClientOptimisticUpdate(s.ctx, data.Data)
+				if err != nil {
+					log.WithError(err).Error("Could not create light client optimistic update of the attested fork")
+					continue
+				}
+				s.setLightClientOptimisticUpdate(update)
+				s.broadcastLightClientUpdateAt(update.GetSignatureSlot(), func() {
+					s.broadcastLightClientOptimisticUpdate(s.ctx, update)
+				})
+			}
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state notifier")
+			return
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting light client update routine")
+			return
+		}
+	}
+}
+
+// newLightClientFinalityUpdate returns the finality update in the type of the fork of its attested header. From
+// Capella on, the headers carry the execution payload headers of the attested and finalized blocks.
+func (s *Service) newLightClientFinalityUpdate(ctx context.Context, update *ethpbv2.LightClientFinalityUpdate) (ethpb.LightClientFinalityUpdate, error) {
+	attestedBlock, err := s.lightClientHeaderBlock(ctx, update.AttestedHeader)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attested block")
+	}
+	// A zero finalized header stands for a missing finalized block.
+	var finalizedBlock interfaces.ReadOnlySignedBeaconBlock
+	if attestedBlock.Version() >= version.Capella && update.FinalizedHeader.Slot != 0 {
+		finalizedBlock, err = s.lightClientHeaderBlock(ctx, update.FinalizedHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get finalized block")
+		}
+	}
+	return blockchain.NewLightClientFinalityUpdateForFork(update, attestedBlock, finalizedBlock)
+}
+
+// newLightClientOptimisticUpdate returns the optimistic update in the type of the fork of its attested header. From
+// Capella on, the header carries the execution payload header of the attested block.
+func (s *Service) newLightClientOptimisticUpdate(ctx context.Context, update *ethpbv2.LightClientOptimisticUpdate) (ethpb.LightClientOptimisticUpdate, error) {
+	attestedBlock, err := s.lightClientHeaderBlock(ctx, update.AttestedHeader)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attested block")
+	}
+	return blockchain.NewLightClientOptimisticUpdateForFork(update, attestedBlock)
+}
+
+// lightClientHeaderBlock returns the block of the given light client beacon header.
+func (s *Service) lightClientHeaderBlock(ctx context.Context, header *ethpbv1.BeaconBlockHeader) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	blk, err := s.cfg.beaconDB.Block(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	if blk == nil || blk.IsNil() {
+		return nil, errors.Errorf("block %#x not found", root)
+	}
+	return blk, nil
+}
+
+// broadcastLightClientUpdateAt calls broadcast once a third of the signature slot has passed, as peers ignore
+// updates received any earlier.
+func (s *Service) broadcastLightClientUpdateAt(signatureSlot primitives.Slot, broadcast func()) {
+	delay := s.lightClientForwardTime(signatureSlot).Sub(s.cfg.clock.Now())
+	if delay <= 0 {
+		broadcast()
+		return
+	}
+	go func() {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			broadcast()
+		case <-s.ctx.Done():
+		}
+	}()
+}
+
+// broadcastLightClientFinalityUpdate gossips the update unless an update with the same or a newer
+// finalized header was already forwarded.
+func (s *Service) broadcastLightClientFinalityUpdate(ctx context.Context, update ethpb.LightClientFinalityUpdate) {
+	if s.cfg.initialSync.Syncing() || !s.isLightClientUpdateOfCurrentFork(update.GetAttestedHeaderVal().GetBeacon().Slot) ||
+		!s.markLightClientFinalityUpdateForwarded(update) {
+		return
+	}
+	if err := s.cfg.p2p.Broadcast(ctx, update); err != nil {
+		log.WithError(err).Debug("Could not broadcast light client finality update")
+	}
+}
+
+// broadcastLightClientOptimisticUpdate gossips the update unless an update with the same or a newer
+// attested header was already forwarded.
+func (s *Service) broadcastLightClientOptimisticUpdate(ctx context.Context, update ethpb.LightClientOptimisticUpdate) {
+	if s.cfg.initialSync.Syncing() || !s.isLightClientUpdateOfCurrentFork(update.GetAttestedHeaderVal().GetBeacon().Slot) ||
+		!s.markLightClientOptimisticUpdateForwarded(update) {
+		return
+	}
+	if err := s.cfg.p2p.Broadcast(ctx, update); err != nil {
+		log.WithError(err).Debug("Could not broadcast light client optimistic update")
+	}
+}
+
+// isLightClientUpdateOfCurrentFork returns true if the attested header of an update is in the fork of the current
+// epoch. Updates take the type of the fork of their attested header, while they are broadcast on the topics of the
+// current fork, so updates attesting to a block of the previous fork are not gossiped across a fork boundary.
+func (s *Service) isLightClientUpdateOfCurrentFork(attestedSlot primitives.Slot) bool {
+	valRoot := s.cfg.clock.GenesisValidatorsRoot()
+	attestedDigest, err := forks.ForkDigestFromEpoch(slots.ToEpoch(attestedSlot), valRoot[:])
+	if err != nil {
+		return false
+	}
+	currentDigest, err := forks.ForkDigestFromEpoch(slots.ToEpoch(s.cfg.clock.CurrentSlot()), valRoot[:])
+	if err != nil {
+		return false
+	}
+	return attestedDigest == currentDigest
+}
+
+func (s *Service) latestLightClientFinalityUpdate() ethpb.LightClientFinalityUpdate {
+	s.lightClientLock.RLock()
+	defer s.lightClientLock.RUnlock()
+	return s.lightClientFinalityUpdate
+}
+
+func (s *Service) setLightClientFinalityUpdate(update ethpb.LightClientFinalityUpdate) {
+	s.lightClientLock.Lock()
+	defer s.lightClientLock.Unlock()
+	s.lightClientFinalityUpdate = update
+}
+
+func (s *Service) latestLightClientOptimisticUpdate() ethpb.LightClientOptimisticUpdate {
+	s.lightClientLock.RLock()
+	defer s.lightClientLock.RUnlock()
+	return s.lightClientOptimisticUpdate
+}
+
+func (s *Service) setLightClientOptimisticUpdate(update ethpb.LightClientOptimisticUpdate) {
+	s.lightClientLock.Lock()
+	defer s.lightClientLock.Unlock()
+	s.lightClientOptimisticUpdate = update
+}
+
+// markLightClientFinalityUpdateForwarded records the update as forwarded and returns true if its finalized
+// header is newer than that of any previously forwarded finality update.
+func (s *Service) markLightClientFinalityUpdateForwarded(update ethpb.LightClientFinalityUpdate) bool {
+	s.lightClientLock.Lock()
+	defer s.lightClientLock.Unlock()
+	finalizedHeader := update.GetFinalizedHeaderVal().GetBeacon()
+	if finalizedHeader == nil || finalizedHeader.Slot <= s.lastForwardedFinalitySlot {
+		return false
+	}
+	s.lastForwardedFinalitySlot = finalizedHeader.Slot
+	return true
+}
+
+// markLightClientOptimisticUpdateForwarded records the update as forwarded and returns true if its attested
+// header is newer than that of any previously forwarded optimistic update.
+func (s *Service) markLightClientOptimisticUpdateForwarded(update ethpb.LightClientOptimisticUpdate) bool {
+	s.lightClientLock.Lock()
+	defer s.lightClientLock.Unlock()
+	attestedHeader := update.GetAttestedHeaderVal().GetBeacon()
+	if attestedHeader == nil || attestedHeader.Slot <= s.lastForwardedOptimisticSlot {
+		return false
+	}
+	s.lastForwardedOptimisticSlot = attestedHeader.Slot
+	return true
+}
@@ -0,0 +1,137 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	mockSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v5/proto/migration"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// clockAt returns a clock whose current time is the given offset into the given slot.
+func clockAt(slot primitives.Slot, offset time.Duration) *startup.Clock {
+	now := time.Now()
+	genesis := now.Add(-time.Duration(uint64(slot)*params.BeaconConfig().SecondsPerSlot)*time.Second - offset)
+	return startup.NewClock(genesis, [32]byte{'A'}, startup.WithNower(func() time.Time { return now }))
+}
+
+func TestService_BroadcastLightClientUpdateAt(t *testing.T) {
+	third := time.Duration(params.BeaconConfig().SecondsPerSlot/params.BeaconConfig().IntervalsPerSlot) * time.Second
+
+	t.Run("after a third of the signature slot", func(t *testing.T) {
+		r := &Service{ctx: context.Background(), cfg: &config{clock: clockAt(10, third)}}
+		called := false
+		r.broadcastLightClientUpdateAt(10, func() { called = true })
+		assert.Equal(t, true, called)
+	})
+	t.Run("before a third of the signature slot", func(t *testing.T) {
+		r := &Service{ctx: context.Background(), cfg: &config{clock: clockAt(10, third-50*time.Millisecond)}}
+		called := make(chan struct{})
+		r.broadcastLightClientUpdateAt(10, func() { close(called) })
+		select {
+		case <-called:
+			t.Fatal("Broadcast before a third of the signature slot")
+		default:
+		}
+		select {
+		case <-called:
+		case <-time.After(time.Second):
+			t.Fatal("Did not broadcast a third into the signature slot")
+		}
+	})
+	t.Run("context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		r := &Service{ctx: ctx, cfg: &config{clock: clockAt(10, third-50*time.Millisecond)}}
+		called := make(chan struct{})
+		r.broadcastLightClientUpdateAt(10, func() { close(called) })
+		cancel()
+		select {
+		case <-called:
+			t.Fatal("Broadcast after the context was canceled")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+}
+
+func TestService_IsLightClientUpdateOfCurrentFork(t *testing.T) {
+	capellaSlot, err := slots.EpochStart(params.BeaconConfig().CapellaForkEpoch)
+	require.NoError(t, err)
+	r := &Service{cfg: &config{clock: clockAt(capellaSlot+1, 0)}}
+	assert.Equal(t, true, r.isLightClientUpdateOfCurrentFork(capellaSlot))
+	assert.Equal(t, false, r.isLightClientUpdateOfCurrentFork(capellaSlot-1))
+}
+
+func TestValidateLightClientOptimisticUpdate_Capella(t *testing.T) {
+	capellaSlot, err := slots.EpochStart(params.BeaconConfig().CapellaForkEpoch)
+	require.NoError(t, err)
+	p := p2ptest.NewTestP2P(t)
+	clock := clockAt(capellaSlot+10, 0)
+
+	update := testLightClientOptimisticUpdate(capellaSlot + 1)
+	blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+	require.NoError(t, err)
+	local, err := blockchain.NewLightClientOptimisticUpdateForFork(&ethpbv2.LightClientOptimisticUpdate{
+		AttestedHeader: migration.V1Alpha1HeaderToV1(update.AttestedHeader.Beacon),
+		SyncAggregate: &ethpbv1.SyncAggregate{
+			SyncCommitteeBits:      update.SyncAggregate.SyncCommitteeBits,
+			SyncCommitteeSignature: update.SyncAggregate.SyncCommitteeSignature,
+		},
+		SignatureSlot: update.SignatureSlot,
+	}, blk)
+	require.NoError(t, err)
+	_, ok := local.(*ethpb.LightClientOptimisticUpdateCapella)
+	require.Equal(t, true, ok)
+
+	valRoot := clock.GenesisValidatorsRoot()
+	digest, err := forks.ForkDigestFromEpoch(params.BeaconConfig().CapellaForkEpoch, valRoot[:])
+	require.NoError(t, err)
+	topic := fmt.Sprintf(p2p.LightClientOptimisticUpdateTopicFormat, digest) + p.Encoding().ProtocolSuffix()
+	buf := new(bytes.Buffer)
+	_, err = p.Encoding().EncodeGossip(buf, local)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		local ethpb.LightClientOptimisticUpdate
+		want  pubsub.ValidationResult
+	}{
+		{name: "no local update", want: pubsub.ValidationIgnore},
+		{name: "different local update", local: update, want: pubsub.ValidationIgnore},
+		{name: "matching local update", local: local, want: pubsub.ValidationAccept},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Service{cfg: &config{p2p: p, clock: clock, initialSync: &mockSync.Sync{IsSyncing: false}}}
+			if tt.local != nil {
+				r.setLightClientOptimisticUpdate(tt.local)
+			}
+			msg := &pubsub.Message{Message: &pubsubpb.Message{Data: buf.Bytes(), Topic: &topic}}
+			result, err := r.validateLightClientOptimisticUpdate(context.Background(), "foobar", msg)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+			if tt.want == pubsub.ValidationAccept {
+				assert.DeepSSZEqual(t, local, msg.ValidatorData)
+			}
+		})
+	}
+}
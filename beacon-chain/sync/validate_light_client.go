@@ -0,0 +1,126 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// validateLightClientFinalityUpdate implements the gossip validation rules of the light_client_finality_update topic.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/p2p-interface.md#light_client_finality_update
+func (s *Service) validateLightClientFinalityUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	// We cannot produce the matching update while syncing.
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	ctx, span := trace.StartSpan(ctx, "sync.validateLightClientFinalityUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+
+	received, ok := m.(ethpb.LightClientFinalityUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+
+	// [IGNORE] The update is received after the block at signature_slot was given enough time to propagate.
+	if !s.lightClientUpdateReceivedInTime(received.GetSignatureSlot()) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	// [IGNORE] The received update matches the locally computed one.
+	local := s.latestLightClientFinalityUpdate()
+	if local == nil || !proto.Equal(received, local) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	// [IGNORE] The finalized_header.slot is greater than that of all previously forwarded finality updates.
+	if !s.markLightClientFinalityUpdateForwarded(local) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	msg.ValidatorData = received // Used in downstream subscriber
+	return pubsub.ValidationAccept, nil
+}
+
+// validateLightClientOptimisticUpdate implements the gossip validation rules of the light_client_optimistic_update topic.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/p2p-interface.md#light_client_optimistic_update
+func (s *Service) validateLightClientOptimisticUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	// We cannot produce the matching update while syncing.
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	ctx, span := trace.StartSpan(ctx, "sync.validateLightClientOptimisticUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+
+	received, ok := m.(ethpb.LightClientOptimisticUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+
+	// [IGNORE] The update is received after the block at signature_slot was given enough time to propagate.
+	if !s.lightClientUpdateReceivedInTime(received.GetSignatureSlot()) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	// [IGNORE] The received update matches the locally computed one.
+	local := s.latestLightClientOptimisticUpdate()
+	if local == nil || !proto.Equal(received, local) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	// [IGNORE] The attested_header.slot is greater than that of all previously forwarded optimistic updates.
+	if !s.markLightClientOptimisticUpdateForwarded(local) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	msg.ValidatorData = received // Used in downstream subscriber
+	return pubsub.ValidationAccept, nil
+}
+
+// lightClientUpdateReceivedInTime returns true if at least a third of a slot has passed since the start of the
+// signature slot, allowing for the maximum gossip clock disparity.
+func (s *Service) lightClientUpdateReceivedInTime(signatureSlot primitives.Slot) bool {
+	earliest := s.lightClientForwardTime(signatureSlot).Add(-params.BeaconConfig().MaximumGossipClockDisparityDuration())
+	return !s.cfg.clock.Now().Before(earliest)
+}
+
+// lightClientForwardTime returns the time from which updates of the given signature slot may be forwarded, a third
+// of a slot after the start of the signature slot.
+func (s *Service) lightClientForwardTime(signatureSlot primitives.Slot) time.Time {
+	cfg := params.BeaconConfig()
+	propagationTime := time.Duration(cfg.SecondsPerSlot/cfg.IntervalsPerSlot) * time.Second
+	return s.cfg.clock.SlotStart(signatureSlot).Add(propagationTime)
+}
@@ -3,17 +3,32 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/urfave/cli/v2"
 )
 
 var (
-	// MevRelayEndpoint provides an HTTP access endpoint to a MEV builder network.
-	MevRelayEndpoint = &cli.StringFlag{
-		Name:  "http-mev-relay",
-		Usage: "A MEV builder relay string http endpoint, this will be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder",
-		Value: "",
+	// MevRelayEndpoint provides HTTP access endpoints to a MEV builder network.
+	MevRelayEndpoint = &cli.StringSliceFlag{
+		Name: "http-mev-relay",
+		Usage: "A MEV builder relay string http endpoint, this will be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder. " +
+			"Can be set multiple times (or comma separated) to request bids from several relays, in which case the highest valid bid is used",
+	}
+	// EnableBuilderSSZ makes the beacon node exchange SSZ encoded messages with the MEV builder relays.
+	EnableBuilderSSZ = &cli.BoolFlag{
+		Name: "enable-builder-ssz",
+		Usage: "Enables SSZ encoding of the requests to and responses from the MEV builder relays, which is faster to encode and decode than JSON. " +
+			"Relays that don't support SSZ are still talked to with JSON",
+	}
+	// MevRelayGetHeaderTimeout bounds how long the MEV builder relays are given to return a bid.
+	MevRelayGetHeaderTimeout = &cli.DurationFlag{
+		Name: "mev-relay-get-header-timeout",
+		Usage: "How long the MEV builder relays are given to return a bid, after which the best bid received so far is used. " +
+			"Should stay below 1s, the time the proposer waits for the builder",
+		Value: 900 * time.Millisecond,
 	}
 	MaxBuilderConsecutiveMissedSlots = &cli.IntFlag{
 		Name:  "max-builder-consecutive-missed-slots",
@@ -150,6 +165,28 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the beaconDB.",
 		Value: 2048,
 	}
+	// StateDiffExponents enables hierarchical state storage, with layers of states every 2^exponent slots.
+	StateDiffExponents = &cli.IntSliceFlag{
+		Name: "state-diff-exponents",
+		Usage: "Stores finalized states as hierarchical diffs instead of archived points. Each exponent e adds a layer " +
+			"of states every 2^e slots: the largest exponent gives full snapshots, and the others give diffs against " +
+			"the next larger layer. For instance, 21,18,16,13,11,9,5 lets an archive node rebuild any slot from a few " +
+			"diffs. The exponents of a database cannot be changed. Cannot be used with --slots-per-archive-point.",
+	}
+	// BeaconDBPruning enables the deletion of blocks and states older than the retention period.
+	BeaconDBPruning = &cli.BoolFlag{
+		Name: "beacon-db-pruning",
+		Usage: "Deletes blocks and states older than the retention period set with --pruner-retention-epochs, " +
+			"every epoch. Blocks-by-range requests below the earliest remaining block are refused. Cannot be " +
+			"used together with backfill or hierarchical states.",
+	}
+	// PrunerRetentionEpochs sets the number of epochs of blocks and states kept by the pruner.
+	PrunerRetentionEpochs = &cli.Uint64Flag{
+		Name: "pruner-retention-epochs",
+		Usage: "The number of epochs of blocks and states kept when --beacon-db-pruning is set. Defaults to the " +
+			"weak subjectivity period plus 256 epochs. Values below MIN_EPOCHS_FOR_BLOCK_REQUESTS (33024 epochs " +
+			"on mainnet) are raised to it.",
+	}
 	// BlockBatchLimit specifies the requested block batch size.
 	BlockBatchLimit = &cli.IntFlag{
 		Name:  "block-batch-limit",
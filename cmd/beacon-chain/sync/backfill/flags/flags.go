@@ -40,4 +40,10 @@ var (
 		Usage: "Specifies the oldest slot that backfill should download. " +
 			"If this value is greater than current_slot - MIN_EPOCHS_FOR_BLOCK_REQUESTS, it will be ignored with a warning log.",
 	}
+	// EraDir points the beacon node to a directory of era files, which it uses for checkpoint sync and backfill.
+	EraDir = &cli.PathFlag{
+		Name: "era-dir",
+		Usage: "Directory of era files to initialize the beacon node from, and backfill blocks from, instead of peers. " +
+			"With --checkpoint-sync-from-era, the node also starts from the era state of --weak-subjectivity-checkpoint.",
+	}
 )
@@ -22,6 +22,9 @@ func BeaconNodeOptions(c *cli.Context) ([]node.Option, error) {
 			uv := c.Uint64(flags.BackfillBatchSize.Name)
 			bno = append(bno, backfill.WithMinimumSlot(primitives.Slot(uv)))
 		}
+		if dir := c.Path(flags.EraDir.Name); dir != "" {
+			bno = append(bno, backfill.WithEraDir(dir))
+		}
 		node.BackfillOpts = bno
 		return nil
 	}
@@ -4,8 +4,11 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/node"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/checkpoint"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	bflags "github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/sync/backfill/flags"
 	"github.com/urfave/cli/v2"
 )
 
@@ -22,12 +25,27 @@ var (
 		Usage: "Rather than syncing from genesis, you can start processing from a ssz-serialized BeaconState+Block." +
 			" This flag allows you to specify a local file containing the checkpoint Block to load.",
 	}
-	RemoteURL = &cli.StringFlag{
+	// RemoteURL specifies the beacon nodes to download the checkpoint sync state and block from.
+	RemoteURL = &cli.StringSliceFlag{
 		Name: "checkpoint-sync-url",
 		Usage: "URL of a synced beacon node to trust in obtaining checkpoint sync data. " +
+			"The flag can be repeated to require several beacon nodes to agree on the finalized checkpoint, see --checkpoint-sync-quorum. " +
 			"As an additional safety measure, it is strongly recommended to only use this option in conjunction with " +
 			"--weak-subjectivity-checkpoint flag",
 	}
+	// EraSync initializes the beacon node from the era state of the weak subjectivity checkpoint.
+	EraSync = &cli.BoolFlag{
+		Name: "checkpoint-sync-from-era",
+		Usage: "Rather than syncing from genesis, start processing from the state of an era file in --era-dir. " +
+			"Era files are not authenticated, so --weak-subjectivity-checkpoint is required: its epoch must be the epoch of an era state, " +
+			"and the state is only used if its latest block root matches the checkpoint root.",
+	}
+	// Quorum is the number of checkpoint sync urls which must agree on the finalized checkpoint.
+	Quorum = &cli.IntFlag{
+		Name: "checkpoint-sync-quorum",
+		Usage: "Number of --checkpoint-sync-url beacon nodes which must report the same finalized checkpoint before it is downloaded. " +
+			"The state and block are verified against that checkpoint. Defaults to a majority of the beacon nodes.",
+	}
 )
 
 // BeaconNodeOptions is responsible for determining if the checkpoint sync options have been used, and if so,
@@ -36,11 +54,21 @@ var (
 func BeaconNodeOptions(c *cli.Context) ([]node.Option, error) {
 	blockPath := c.Path(BlockPath.Name)
 	statePath := c.Path(StatePath.Name)
-	remoteURL := c.String(RemoteURL.Name)
-	if remoteURL != "" {
+	remoteURLs := c.StringSlice(RemoteURL.Name)
+	if c.Bool(EraSync.Name) {
+		if len(remoteURLs) > 0 || blockPath != "" || statePath != "" {
+			return nil, fmt.Errorf("--%s can't be used with another checkpoint sync flag", EraSync.Name)
+		}
+		return eraOptions(c)
+	}
+	if len(remoteURLs) > 0 {
+		quorum := len(remoteURLs)/2 + 1
+		if c.IsSet(Quorum.Name) {
+			quorum = c.Int(Quorum.Name)
+		}
 		opt := func(node *node.BeaconNode) error {
 			var err error
-			node.CheckpointInitializer, err = checkpoint.NewAPIInitializer(remoteURL)
+			node.CheckpointInitializer, err = checkpoint.NewQuorumAPIInitializer(remoteURLs, quorum)
 			if err != nil {
 				return errors.Wrap(err, "error while constructing beacon node api client for checkpoint sync")
 			}
@@ -68,3 +96,26 @@ func BeaconNodeOptions(c *cli.Context) ([]node.Option, error) {
 	}
 	return []node.Option{opt}, nil
 }
+
+// eraOptions prepares a checkpoint.Initializer using the era state of the weak subjectivity checkpoint.
+func eraOptions(c *cli.Context) ([]node.Option, error) {
+	eraDir := c.Path(bflags.EraDir.Name)
+	if eraDir == "" {
+		return nil, fmt.Errorf("--%s requires --%s", EraSync.Name, bflags.EraDir.Name)
+	}
+	trusted, err := helpers.ParseWeakSubjectivityInputString(c.String(flags.WeakSubjectivityCheckpoint.Name))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse weak subjectivity checkpoint")
+	}
+	if trusted == nil {
+		return nil, fmt.Errorf("--%s requires --%s to verify the era state", EraSync.Name, flags.WeakSubjectivityCheckpoint.Name)
+	}
+	opt := func(node *node.BeaconNode) (err error) {
+		node.CheckpointInitializer, err = checkpoint.NewEraInitializer(eraDir, trusted)
+		if err != nil {
+			return errors.Wrap(err, "error preparing to initialize checkpoint from era files")
+		}
+		return nil
+	}
+	return []node.Option{opt}, nil
+}
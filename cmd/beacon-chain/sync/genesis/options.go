@@ -30,9 +30,9 @@ var (
 func BeaconNodeOptions(c *cli.Context) ([]node.Option, error) {
 	statePath := c.Path(StatePath.Name)
 	remoteURL := c.String(BeaconAPIURL.Name)
-	if remoteURL == "" && c.String(checkpoint.RemoteURL.Name) != "" {
-		log.Infof("using checkpoint sync url %s for value in --%s flag", c.String(checkpoint.RemoteURL.Name), BeaconAPIURL.Name)
-		remoteURL = c.String(checkpoint.RemoteURL.Name)
+	if checkpointURLs := c.StringSlice(checkpoint.RemoteURL.Name); remoteURL == "" && len(checkpointURLs) > 0 {
+		log.Infof("using checkpoint sync url %s for value in --%s flag", checkpointURLs[0], BeaconAPIURL.Name)
+		remoteURL = checkpointURLs[0]
 	}
 	if remoteURL != "" {
 		opt := func(node *node.BeaconNode) error {
@@ -62,6 +62,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.EnableTracingFlag,
 			cmd.TracingProcessNameFlag,
 			cmd.TracingEndpointFlag,
+			cmd.TracingProtocolFlag,
 			cmd.TraceSampleFractionFlag,
 			cmd.MonitoringHostFlag,
 			flags.MonitoringPortFlag,
@@ -112,6 +113,9 @@ var appHelpFlagGroups = []flagGroup{
 			flags.ExecutionJWTSecretFlag,
 			flags.SetGCPercent,
 			flags.SlotsPerArchivedPoint,
+			flags.StateDiffExponents,
+			flags.BeaconDBPruning,
+			flags.PrunerRetentionEpochs,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
 			flags.BlobBatchLimit,
@@ -125,6 +129,8 @@ var appHelpFlagGroups = []flagGroup{
 			flags.Eth1HeaderReqLimit,
 			flags.MinPeersPerSubnet,
 			flags.MevRelayEndpoint,
+			flags.EnableBuilderSSZ,
+			flags.MevRelayGetHeaderTimeout,
 			flags.MaxBuilderEpochMissedSlots,
 			flags.MaxBuilderConsecutiveMissedSlots,
 			flags.EngineEndpointTimeoutSeconds,
@@ -134,6 +140,8 @@ var appHelpFlagGroups = []flagGroup{
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
 			checkpoint.RemoteURL,
+			checkpoint.Quorum,
+			checkpoint.EraSync,
 			genesis.StatePath,
 			genesis.BeaconAPIURL,
 			storage.BlobStoragePathFlag,
@@ -142,6 +150,7 @@ var appHelpFlagGroups = []flagGroup{
 			backfill.BackfillWorkerCount,
 			backfill.BackfillBatchSize,
 			backfill.BackfillOldestSlot,
+			backfill.EraDir,
 		},
 	},
 	{
@@ -166,6 +175,9 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PAllowList,
 			cmd.P2PDenyList,
 			cmd.PubsubQueueSize,
+			cmd.GossipTraceFile,
+			cmd.GossipTraceFormat,
+			cmd.GossipTraceMaxSize,
 			cmd.StaticPeers,
 			cmd.EnableUPnPFlag,
 			flags.MinSyncPeers,
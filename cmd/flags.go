@@ -62,11 +62,20 @@ var (
 		Name:  "tracing-process-name",
 		Usage: "Name to apply to tracing tag `process_name`.",
 	}
-	// TracingEndpointFlag flag defines the http endpoint for serving traces to Jaeger.
+	// TracingEndpointFlag flag defines the endpoint of the OTLP collector traces are exported to.
+	// The --tracing-endpoint flag, which pointed at a Jaeger collector, is kept as a deprecated alias.
 	TracingEndpointFlag = &cli.StringFlag{
-		Name:  "tracing-endpoint",
-		Usage: "Tracing endpoint defines where beacon chain traces are exposed to Jaeger.",
-		Value: "http://127.0.0.1:14268/api/traces",
+		Name:    "tracing-otlp-endpoint",
+		Aliases: []string{"tracing-endpoint"},
+		Usage: `Tracing endpoint defines the URL of the OTLP collector traces are exported to, such as http://127.0.0.1:4318.
+		Defaults to the local collector for the tracing protocol, or to the OTEL_EXPORTER_OTLP_ENDPOINT environment variable if set.
+		--tracing-endpoint is a deprecated alias of this flag.`,
+	}
+	// TracingProtocolFlag defines the protocol over which traces are exported to the OTLP collector.
+	TracingProtocolFlag = &cli.StringFlag{
+		Name:  "tracing-protocol",
+		Usage: "Protocol over which traces are exported to the OTLP collector, either http or grpc.",
+		Value: "http",
 	}
 	// TraceSampleFractionFlag defines a flag to indicate what fraction of p2p
 	// messages are sampled for tracing.
@@ -190,6 +199,25 @@ var (
 		Usage: "The size of the pubsub validation and outbound queue for the node.",
 		Value: 1000,
 	}
+	// GossipTraceFile defines a flag to specify the file gossipsub events are traced to.
+	GossipTraceFile = &cli.StringFlag{
+		Name: "gossip-trace-file",
+		Usage: "The file to write a trace of gossipsub events to, for offline analysis with prysmctl p2p trace-summary. " +
+			"Peers joining and leaving, mesh changes, and delivered, rejected and duplicate messages are traced. " +
+			"Tracing is disabled by default.",
+	}
+	// GossipTraceFormat defines a flag to specify the format of the gossip trace.
+	GossipTraceFormat = &cli.StringFlag{
+		Name:  "gossip-trace-format",
+		Usage: "The format of the gossip trace, either json for JSON lines or pb for length delimited protobuf, as written by the libp2p pubsub tracers.",
+		Value: "json",
+	}
+	// GossipTraceMaxSize defines a flag to specify the size at which the gossip trace file is rotated.
+	GossipTraceMaxSize = &cli.IntFlag{
+		Name:  "gossip-trace-max-size",
+		Usage: "The size in megabytes past which the gossip trace file is rotated. The 5 most recent rotated files are kept.",
+		Value: 256,
+	}
 	// ForceClearDB removes any previously stored data at the data directory.
 	ForceClearDB = &cli.BoolFlag{
 		Name:  "force-clear-db",
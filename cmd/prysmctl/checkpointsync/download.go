@@ -2,6 +2,7 @@ package checkpointsync
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"time"
 
@@ -12,8 +13,8 @@ import (
 )
 
 var downloadFlags = struct {
-	BeaconNodeHost string
-	Timeout        time.Duration
+	Quorum  int
+	Timeout time.Duration
 }{}
 
 var downloadCmd = &cli.Command{
@@ -27,11 +28,17 @@ var downloadCmd = &cli.Command{
 		return nil
 	},
 	Flags: []cli.Flag{
-		&cli.StringFlag{
-			Name:        "beacon-node-host",
-			Usage:       "host:port for beacon node connection",
-			Destination: &downloadFlags.BeaconNodeHost,
-			Value:       "localhost:3500",
+		&cli.StringSliceFlag{
+			Name: "beacon-node-host",
+			Usage: "host:port for beacon node connection. Repeat the flag to require several beacon nodes to agree " +
+				"on the finalized checkpoint, see --quorum.",
+			Value: cli.NewStringSlice("localhost:3500"),
+		},
+		&cli.IntFlag{
+			Name: "quorum",
+			Usage: "number of beacon nodes which must report the same finalized checkpoint before it is downloaded. " +
+				"default: a majority of the beacon nodes",
+			Destination: &downloadFlags.Quorum,
 		},
 		&cli.DurationFlag{
 			Name:        "http-timeout",
@@ -42,22 +49,36 @@ var downloadCmd = &cli.Command{
 	},
 }
 
-func cliActionDownload(_ *cli.Context) error {
+func cliActionDownload(cliCtx *cli.Context) error {
 	ctx := context.Background()
 	f := downloadFlags
 
-	opts := []client.ClientOpt{client.WithTimeout(f.Timeout)}
-	client, err := beacon.NewClient(downloadFlags.BeaconNodeHost, opts...)
+	hosts := cliCtx.StringSlice("beacon-node-host")
+	quorum, err := downloadQuorum(hosts, f.Quorum, cliCtx.IsSet("quorum"))
 	if err != nil {
 		return err
 	}
+	opts := []client.ClientOpt{client.WithTimeout(f.Timeout)}
+	clients := make([]*beacon.Client, len(hosts))
+	for i, host := range hosts {
+		c, err := beacon.NewClient(host, opts...)
+		if err != nil {
+			return err
+		}
+		clients[i] = c
+	}
 
 	cwd, err := os.Getwd()
 	if err != nil {
 		return err
 	}
 
-	od, err := beacon.DownloadFinalizedData(ctx, client)
+	var od *beacon.OriginData
+	if len(clients) == 1 {
+		od, err = beacon.DownloadFinalizedData(ctx, clients[0])
+	} else {
+		od, err = beacon.DownloadFinalizedDataWithQuorum(ctx, clients, quorum)
+	}
 	if err != nil {
 		return err
 	}
@@ -76,3 +97,15 @@ func cliActionDownload(_ *cli.Context) error {
 
 	return nil
 }
+
+// downloadQuorum returns the number of beacon nodes which must agree on the finalized checkpoint, which defaults to a
+// majority of them.
+func downloadQuorum(hosts []string, quorum int, isSet bool) (int, error) {
+	if !isSet {
+		return len(hosts)/2 + 1, nil
+	}
+	if quorum < 1 || quorum > len(hosts) {
+		return 0, fmt.Errorf("--quorum must be between 1 and the number of beacon nodes (%d), got %d", len(hosts), quorum)
+	}
+	return quorum, nil
+}
@@ -0,0 +1,29 @@
+package checkpointsync
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestDownloadQuorum(t *testing.T) {
+	hosts := []string{"localhost:3500", "localhost:3501", "localhost:3502"}
+
+	quorum, err := downloadQuorum(hosts, 0, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, quorum, "Default quorum is not a majority")
+	quorum, err = downloadQuorum(hosts[:1], 0, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, quorum)
+	quorum, err = downloadQuorum(hosts, 3, true)
+	require.NoError(t, err)
+	assert.Equal(t, 3, quorum)
+
+	_, err = downloadQuorum(hosts, 4, true)
+	assert.ErrorContains(t, "--quorum must be between 1 and the number of beacon nodes (3), got 4", err)
+	_, err = downloadQuorum(hosts[:1], 2, true)
+	assert.ErrorContains(t, "got 2", err)
+	_, err = downloadQuorum(hosts, 0, true)
+	assert.ErrorContains(t, "got 0", err)
+}
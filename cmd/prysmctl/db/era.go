@@ -0,0 +1,268 @@
+package db
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/era"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/backfill"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/checkpoint"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var eraFlags = struct {
+	Path       string
+	Dir        string
+	StartEra   uint64
+	EndEra     uint64
+	BatchSize  uint64
+	Checkpoint string
+}{}
+
+var exportEraCmd = &cli.Command{
+	Name:  "export-era",
+	Usage: "write the finalized blocks and states of a beacon db as era files",
+	Action: func(cliCtx *cli.Context) error {
+		if err := exportEraAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not export era files")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db",
+			Destination: &eraFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "output-dir",
+			Usage:       "directory to write the era files to",
+			Destination: &eraFlags.Dir,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "start-era",
+			Usage:       "first era to export",
+			Destination: &eraFlags.StartEra,
+		},
+		&cli.Uint64Flag{
+			Name:        "end-era",
+			Usage:       "last era to export, defaults to the last finalized era",
+			Destination: &eraFlags.EndEra,
+		},
+	},
+}
+
+var importEraCmd = &cli.Command{
+	Name:  "import-era",
+	Usage: "initialize a beacon db from a directory of era files, or backfill it with their blocks",
+	Action: func(cliCtx *cli.Context) error {
+		if err := importEraAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not import era files")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db",
+			Destination: &eraFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "era-dir",
+			Usage:       "directory of the era files to import",
+			Destination: &eraFlags.Dir,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "batch-size",
+			Usage:       "number of slots of blocks verified and saved at once",
+			Destination: &eraFlags.BatchSize,
+			Value:       64,
+		},
+		&cli.StringFlag{
+			Name: "weak-subjectivity-checkpoint",
+			Usage: "block_root:epoch_number checkpoint of the era state to initialize the db from. " +
+				"Era files are not authenticated, so without it the db is only backfilled from its existing origin",
+			Destination: &eraFlags.Checkpoint,
+		},
+	},
+}
+
+func exportEraAction(cliCtx *cli.Context) error {
+	ctx := cliCtx.Context
+	d, err := kv.NewKVStore(ctx, eraFlags.Path)
+	if err != nil {
+		return errors.Wrapf(err, "could not open db at %s", eraFlags.Path)
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+	if err := setConfigFromDB(ctx, d); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(eraFlags.Dir, 0700); err != nil {
+		return err
+	}
+
+	e, err := era.NewExporter(ctx, d)
+	if err != nil {
+		return err
+	}
+	end := e.LastEra()
+	if cliCtx.IsSet("end-era") {
+		end = eraFlags.EndEra
+	}
+	if eraFlags.StartEra > end {
+		return errors.Errorf("start era %d is above end era %d", eraFlags.StartEra, end)
+	}
+	for i := eraFlags.StartEra; i <= end; i++ {
+		path, err := e.Export(ctx, i, eraFlags.Dir)
+		if err != nil {
+			return errors.Wrapf(err, "could not export era %d", i)
+		}
+		log.WithField("era", i).WithField("path", path).Info("Exported era file")
+	}
+	return nil
+}
+
+// setConfigFromDB activates the config of the network of the genesis state of the db.
+func setConfigFromDB(ctx context.Context, d *kv.Store) error {
+	st, err := d.GenesisState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis state")
+	}
+	if st == nil || st.IsNil() {
+		return errors.New("genesis state not found in db")
+	}
+	cfg, err := params.ByVersion(bytesutil.ToBytes4(st.Fork().CurrentVersion))
+	if err != nil {
+		return errors.Wrap(err, "could not find the config of the genesis state")
+	}
+	return params.SetActive(cfg.Copy())
+}
+
+func importEraAction(cliCtx *cli.Context) error {
+	ctx := cliCtx.Context
+	files, err := era.ListFiles(eraFlags.Dir)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return errors.Errorf("no era files in %s", eraFlags.Dir)
+	}
+	if err := setConfigFromEra(files[len(files)-1]); err != nil {
+		return err
+	}
+
+	d, err := kv.NewKVStore(ctx, eraFlags.Path)
+	if err != nil {
+		return errors.Wrapf(err, "could not open db at %s", eraFlags.Path)
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+	if files[0].Era == 0 {
+		if err := saveEraGenesis(ctx, d, files[0]); err != nil {
+			return err
+		}
+	}
+	trusted, err := helpers.ParseWeakSubjectivityInputString(eraFlags.Checkpoint)
+	if err != nil {
+		return errors.Wrap(err, "could not parse weak subjectivity checkpoint")
+	}
+	if trusted != nil {
+		ei, err := checkpoint.NewEraInitializer(eraFlags.Dir, trusted)
+		if err != nil {
+			return err
+		}
+		if err := ei.Initialize(ctx, d); err != nil {
+			return err
+		}
+	}
+
+	su, err := backfill.NewUpdater(ctx, d)
+	if err != nil {
+		return err
+	}
+	origin, err := d.OriginCheckpointBlockRoot(ctx)
+	if errors.Is(err, kv.ErrNotFoundOriginBlockRoot) {
+		log.Info("Database synced from genesis, there are no blocks to backfill")
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not get origin checkpoint root")
+	}
+	st, err := d.StateOrError(ctx, origin)
+	if err != nil {
+		return err
+	}
+	n, err := backfill.ImportEras(ctx, su, eraFlags.Dir, slots.CurrentSlot(st.GenesisTime()), eraFlags.BatchSize)
+	log.WithField("imported", n).Info("Backfilled blocks from era files")
+	return err
+}
+
+// setConfigFromEra activates the config of the network of the state of the era file.
+func setConfigFromEra(f era.File) error {
+	r, err := era.Open(f.Path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.WithError(err).Error("Could not close era file")
+		}
+	}()
+	enc, err := r.StateSSZ()
+	if err != nil {
+		return err
+	}
+	cf, err := detect.FromState(enc)
+	if err != nil {
+		return errors.Wrapf(err, "could not find the config of the state of era %d", f.Era)
+	}
+	return params.SetActive(cf.Config.Copy())
+}
+
+// saveEraGenesis saves the genesis state of the era 0 file, unless the db already has one.
+func saveEraGenesis(ctx context.Context, d *kv.Store, f era.File) error {
+	existing, err := d.GenesisState(ctx)
+	if err != nil {
+		return err
+	}
+	if existing != nil && !existing.IsNil() {
+		return nil
+	}
+	r, err := era.Open(f.Path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.WithError(err).Error("Could not close era file")
+		}
+	}()
+	st, err := r.State()
+	if err != nil {
+		return err
+	}
+	if err := d.SaveGenesisData(ctx, st); err != nil {
+		return errors.Wrap(err, "could not save genesis data")
+	}
+	log.Info("Saved genesis state from era file")
+	return nil
+}
@@ -0,0 +1,112 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var forkChoiceFlags = struct {
+	BeaconNodeHost string
+	Timeout        time.Duration
+	Output         string
+	Format         string
+}{}
+
+var forkChoiceCmd = &cli.Command{
+	Name:    "fork-choice",
+	Aliases: []string{"fc"},
+	Usage: "Render the current fork choice tree of a beacon node as a Graphviz graph, annotated with weights, " +
+		"checkpoints, optimistic status, proposer boost and head viability.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionForkChoice(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not render fork choice tree")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "beacon-node-host",
+			Usage:       "host:port for beacon node to query",
+			Destination: &forkChoiceFlags.BeaconNodeHost,
+			Value:       "http://localhost:3500",
+		},
+		&cli.DurationFlag{
+			Name:        "http-timeout",
+			Usage:       "timeout for http requests made to beacon-node-url (uses duration format, ex: 2m31s). default: 2m",
+			Destination: &forkChoiceFlags.Timeout,
+			Value:       time.Minute * 2,
+		},
+		&cli.StringFlag{
+			Name:        "output",
+			Usage:       "file to write the graph to, instead of standard output",
+			Destination: &forkChoiceFlags.Output,
+		},
+		&cli.StringFlag{
+			Name:        "format",
+			Usage:       "format of the graph: dot, or svg which requires the dot command of Graphviz",
+			Destination: &forkChoiceFlags.Format,
+			Value:       "dot",
+		},
+	},
+}
+
+func cliActionForkChoice(_ *cli.Context) error {
+	ctx := context.Background()
+	f := forkChoiceFlags
+	if f.Format != "dot" && f.Format != "svg" {
+		return fmt.Errorf("unsupported format %s, use dot or svg", f.Format)
+	}
+
+	opts := []client.ClientOpt{client.WithTimeout(f.Timeout)}
+	c, err := beacon.NewClient(f.BeaconNodeHost, opts...)
+	if err != nil {
+		return err
+	}
+	graph, err := c.GetForkChoiceDOT(ctx)
+	if err != nil {
+		return err
+	}
+	if f.Format == "svg" {
+		if graph, err = renderSVG(ctx, graph); err != nil {
+			return err
+		}
+	}
+
+	if f.Output == "" {
+		_, err := os.Stdout.Write(graph)
+		return err
+	}
+	if err := file.WriteFile(f.Output, graph); err != nil {
+		return errors.Wrapf(err, "could not write fork choice graph to %s", f.Output)
+	}
+	log.WithField("path", f.Output).Info("Wrote fork choice graph")
+	return nil
+}
+
+// renderSVG converts a graph in the DOT language to SVG with the dot command of Graphviz.
+func renderSVG(ctx context.Context, graph []byte) ([]byte, error) {
+	dotPath, err := exec.LookPath("dot")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not find the dot command of Graphviz, which is needed to render svg")
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, dotPath, "-Tsvg") // #nosec G204 -- The path comes from looking up the dot command.
+	cmd.Stdin = bytes.NewReader(graph)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "could not render svg: %s", stderr.String())
+	}
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,367 @@
+package p2p
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/urfave/cli/v2"
+)
+
+var traceSummaryFlags = struct {
+	Format      string
+	Top         int
+	GenesisTime uint64
+}{}
+
+var traceSummaryCmd = &cli.Command{
+	Name:      "trace-summary",
+	Usage:     "Summarize gossip trace files written by beacon nodes run with --gossip-trace-file",
+	ArgsUsage: "<trace file>...",
+	Description: `Reports per topic how many messages were delivered, rejected, ignored and duplicated, the mesh churn, and
+how long messages took to propagate, along with the peers whose messages were rejected the most.
+
+Propagation latency is measured from the publication of a message when one of the traced nodes published it, and
+otherwise from the first time it was seen in any of the traces, so with the traces of several nodes it is the delay with
+which each node received the message. Messages which were seen by a single node, and not published by it, have no
+latency then. With --genesis-time, latency is instead measured from the start of the slot in which the message was
+first seen, which gives the latency of every message of a single trace. As the traces do not hold the messages, a
+message first seen after the end of its slot counts from the start of the next one. Duplicate delay is measured from the first
+time a node received a message to each duplicate it received afterwards. The rotated files of a trace can be passed
+along with the current one.`,
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionTraceSummary(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not summarize gossip trace")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "format",
+			Usage:       "format of the trace files, json or pb",
+			Destination: &traceSummaryFlags.Format,
+			Value:       p2p.GossipTraceFormatJSON,
+		},
+		&cli.IntFlag{
+			Name:        "top",
+			Usage:       "number of rejecting peers to report",
+			Destination: &traceSummaryFlags.Top,
+			Value:       10,
+		},
+		&cli.Uint64Flag{
+			Name:        "genesis-time",
+			Usage:       "genesis time of the network in unix seconds, to measure propagation latency from the start of the slot",
+			Destination: &traceSummaryFlags.GenesisTime,
+		},
+	},
+}
+
+// localRejectReasons are the rejection reasons which come from the load of the node rather than the message, and
+// which therefore do not count against the peer the message was received from.
+var localRejectReasons = map[string]bool{
+	pubsub.RejectValidationQueueFull: true,
+	pubsub.RejectValidationThrottled: true,
+	pubsub.RejectSelfOrigin:          true,
+}
+
+type traceTopicSummary struct {
+	delivered        int
+	rejected         int
+	ignored          int
+	duplicates       int
+	grafts           int
+	prunes           int
+	propagationDelay []time.Duration
+	duplicateDelay   []time.Duration
+}
+
+type tracePeerSummary struct {
+	rejected int
+	reasons  map[string]int
+}
+
+type traceMessage struct {
+	topic       string
+	firstSeen   int64
+	firstSeenBy peer.ID
+	// published is when the message was published, if one of the traced nodes published it.
+	published   int64
+	publishedBy peer.ID
+	// arrivals is when each traced node first saw the message.
+	arrivals map[peer.ID]int64
+	// duplicates is when each traced node received a duplicate of the message.
+	duplicates map[peer.ID][]int64
+}
+
+// traceSummary aggregates the events of gossip traces.
+type traceSummary struct {
+	events       int
+	nodes        map[peer.ID]bool
+	start, end   int64
+	peersAdded   int
+	peersRemoved int
+	topics       map[string]*traceTopicSummary
+	peers        map[peer.ID]*tracePeerSummary
+	messages     map[string]*traceMessage
+	// genesis is the genesis time of the network, if known, to measure latency from the start of the slot.
+	genesis time.Time
+}
+
+func newTraceSummary() *traceSummary {
+	return &traceSummary{
+		nodes:    make(map[peer.ID]bool),
+		topics:   make(map[string]*traceTopicSummary),
+		peers:    make(map[peer.ID]*tracePeerSummary),
+		messages: make(map[string]*traceMessage),
+	}
+}
+
+func cliActionTraceSummary(cliCtx *cli.Context) error {
+	if cliCtx.NArg() == 0 {
+		return errors.New("no trace file given")
+	}
+	s := newTraceSummary()
+	if traceSummaryFlags.GenesisTime != 0 {
+		s.genesis = time.Unix(int64(traceSummaryFlags.GenesisTime), 0) // lint:ignore uintcast -- Genesis time will not exceed int64 in your lifetime.
+	}
+	for _, path := range cliCtx.Args().Slice() {
+		if err := s.addFile(path, traceSummaryFlags.Format); err != nil {
+			return err
+		}
+	}
+	return s.print(os.Stdout, traceSummaryFlags.Top)
+}
+
+func (s *traceSummary) addFile(path, format string) error {
+	f, err := os.Open(path) // #nosec G304 -- The path is given by the user.
+	if err != nil {
+		return errors.Wrapf(err, "could not open %s", path)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Errorf("Could not close %s", path)
+		}
+	}()
+	if err := p2p.ReadGossipTrace(f, format, func(evt *pubsubpb.TraceEvent) error {
+		s.add(evt)
+		return nil
+	}); err != nil {
+		return errors.Wrapf(err, "could not read %s", path)
+	}
+	return nil
+}
+
+func (s *traceSummary) topic(name string) *traceTopicSummary {
+	t, ok := s.topics[name]
+	if !ok {
+		t = &traceTopicSummary{}
+		s.topics[name] = t
+	}
+	return t
+}
+
+// add adds an event to the summary. Message events are first collected per message, and turned into delays in
+// finish, as the traces of several nodes, or the rotated files of a trace, are read one after the other.
+func (s *traceSummary) add(evt *pubsubpb.TraceEvent) {
+	s.events++
+	node := peer.ID(evt.PeerID)
+	s.nodes[node] = true
+	ts := evt.GetTimestamp()
+	if s.start == 0 || ts < s.start {
+		s.start = ts
+	}
+	if ts > s.end {
+		s.end = ts
+	}
+
+	switch evt.GetType() {
+	case pubsubpb.TraceEvent_ADD_PEER:
+		s.peersAdded++
+	case pubsubpb.TraceEvent_REMOVE_PEER:
+		s.peersRemoved++
+	case pubsubpb.TraceEvent_GRAFT:
+		s.topic(evt.GetGraft().GetTopic()).grafts++
+	case pubsubpb.TraceEvent_PRUNE:
+		s.topic(evt.GetPrune().GetTopic()).prunes++
+	case pubsubpb.TraceEvent_PUBLISH_MESSAGE:
+		m := evt.GetPublishMessage()
+		msg := s.sawMessage(node, m.GetMessageID(), m.GetTopic(), ts)
+		if msg.publishedBy == "" || ts < msg.published {
+			msg.published, msg.publishedBy = ts, node
+		}
+	case pubsubpb.TraceEvent_DELIVER_MESSAGE:
+		m := evt.GetDeliverMessage()
+		s.topic(m.GetTopic()).delivered++
+		s.sawMessage(node, m.GetMessageID(), m.GetTopic(), ts)
+	case pubsubpb.TraceEvent_REJECT_MESSAGE:
+		m := evt.GetRejectMessage()
+		t := s.topic(m.GetTopic())
+		reason := m.GetReason()
+		if reason == pubsub.RejectValidationIgnored {
+			t.ignored++
+		} else {
+			t.rejected++
+		}
+		if reason != pubsub.RejectValidationIgnored && !localRejectReasons[reason] && len(m.GetReceivedFrom()) > 0 {
+			from := peer.ID(m.GetReceivedFrom())
+			p, ok := s.peers[from]
+			if !ok {
+				p = &tracePeerSummary{reasons: make(map[string]int)}
+				s.peers[from] = p
+			}
+			p.rejected++
+			p.reasons[reason]++
+		}
+		s.sawMessage(node, m.GetMessageID(), m.GetTopic(), ts)
+	case pubsubpb.TraceEvent_DUPLICATE_MESSAGE:
+		m := evt.GetDuplicateMessage()
+		s.topic(m.GetTopic()).duplicates++
+		msg := s.sawMessage(node, m.GetMessageID(), m.GetTopic(), ts)
+		msg.duplicates[node] = append(msg.duplicates[node], ts)
+	}
+}
+
+// sawMessage records that the node saw the message at the given time.
+func (s *traceSummary) sawMessage(node peer.ID, id []byte, topic string, ts int64) *traceMessage {
+	m, ok := s.messages[string(id)]
+	if !ok {
+		m = &traceMessage{
+			topic:       topic,
+			firstSeen:   ts,
+			firstSeenBy: node,
+			arrivals:    make(map[peer.ID]int64),
+			duplicates:  make(map[peer.ID][]int64),
+		}
+		s.messages[string(id)] = m
+	}
+	if ts < m.firstSeen {
+		m.firstSeen, m.firstSeenBy = ts, node
+	}
+	if arrival, ok := m.arrivals[node]; !ok || ts < arrival {
+		m.arrivals[node] = ts
+	}
+	return m
+}
+
+// finish computes the delays of the messages, once the arrivals of all traces are known.
+func (s *traceSummary) finish() {
+	for _, m := range s.messages {
+		t := s.topic(m.topic)
+		origin, originNode := s.origin(m)
+		for node, arrival := range m.arrivals {
+			if node != originNode {
+				t.propagationDelay = append(t.propagationDelay, time.Duration(arrival-origin))
+			}
+			for _, ts := range m.duplicates[node] {
+				t.duplicateDelay = append(t.duplicateDelay, time.Duration(ts-arrival))
+			}
+		}
+	}
+	for _, t := range s.topics {
+		sort.Slice(t.propagationDelay, func(i, j int) bool { return t.propagationDelay[i] < t.propagationDelay[j] })
+		sort.Slice(t.duplicateDelay, func(i, j int) bool { return t.duplicateDelay[i] < t.duplicateDelay[j] })
+	}
+}
+
+// origin returns the time from which the propagation latency of the message is measured, and the node whose arrival
+// is that time, if any, which therefore has no latency.
+func (s *traceSummary) origin(m *traceMessage) (int64, peer.ID) {
+	if !s.genesis.IsZero() {
+		firstSeen := time.Unix(0, m.firstSeen)
+		if !firstSeen.Before(s.genesis) {
+			return slots.BeginsAt(slots.Duration(s.genesis, firstSeen), s.genesis).UnixNano(), ""
+		}
+	}
+	if m.publishedBy != "" {
+		return m.published, m.publishedBy
+	}
+	// The node which saw the message first is where it entered the traced nodes.
+	return m.firstSeen, m.firstSeenBy
+}
+
+func (s *traceSummary) print(out io.Writer, top int) error {
+	s.finish()
+	fmt.Fprintf(out, "Events: %d from %d node(s) over %s\n", s.events, len(s.nodes), time.Duration(s.end-s.start).Round(time.Millisecond))
+	fmt.Fprintf(out, "Peers added: %d, removed: %d\n\n", s.peersAdded, s.peersRemoved)
+
+	names := make([]string, 0, len(s.topics))
+	for name := range s.topics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TOPIC\tDELIVERED\tREJECTED\tIGNORED\tDUPLICATES\tGRAFTS\tPRUNES\tPROPAGATION P50/P90/P99\tDUPLICATE DELAY P50/P90/P99")
+	for _, name := range names {
+		t := s.topics[name]
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\t%d\t%s\t%s\n", name, t.delivered, t.rejected, t.ignored, t.duplicates,
+			t.grafts, t.prunes, percentiles(t.propagationDelay), percentiles(t.duplicateDelay))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	ids := make([]peer.ID, 0, len(s.peers))
+	for id := range s.peers {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if s.peers[ids[i]].rejected != s.peers[ids[j]].rejected {
+			return s.peers[ids[i]].rejected > s.peers[ids[j]].rejected
+		}
+		return ids[i] < ids[j]
+	})
+	if len(ids) > top {
+		ids = ids[:top]
+	}
+	fmt.Fprintf(out, "\nTop rejecting peers:\n")
+	if len(ids) == 0 {
+		fmt.Fprintln(out, "none")
+		return nil
+	}
+	w = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PEER\tREJECTED\tREASONS")
+	for _, id := range ids {
+		p := s.peers[id]
+		reasons := make([]string, 0, len(p.reasons))
+		for reason := range p.reasons {
+			reasons = append(reasons, reason)
+		}
+		sort.Slice(reasons, func(i, j int) bool {
+			if p.reasons[reasons[i]] != p.reasons[reasons[j]] {
+				return p.reasons[reasons[i]] > p.reasons[reasons[j]]
+			}
+			return reasons[i] < reasons[j]
+		})
+		for i, reason := range reasons {
+			reasons[i] = fmt.Sprintf("%s (%d)", reason, p.reasons[reason])
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\n", id, p.rejected, strings.Join(reasons, ", "))
+	}
+	return w.Flush()
+}
+
+// percentiles formats the 50th, 90th and 99th percentiles of sorted durations.
+func percentiles(sorted []time.Duration) string {
+	if len(sorted) == 0 {
+		return "-"
+	}
+	at := func(p float64) string {
+		i := int(math.Ceil(p*float64(len(sorted)))) - 1
+		if i < 0 {
+			i = 0
+		}
+		return sorted[i].Round(time.Millisecond).String()
+	}
+	return at(0.5) + "/" + at(0.9) + "/" + at(0.99)
+}
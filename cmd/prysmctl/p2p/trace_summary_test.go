@@ -0,0 +1,153 @@
+package p2p
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// writeTrace writes the events to a trace file in the given format, as the gossip event tracer does.
+func writeTrace(t *testing.T, format string, events []*pubsubpb.TraceEvent) string {
+	var buf bytes.Buffer
+	for _, evt := range events {
+		switch format {
+		case p2p.GossipTraceFormatJSON:
+			enc, err := json.Marshal(evt)
+			require.NoError(t, err)
+			buf.Write(append(enc, '\n'))
+		case p2p.GossipTraceFormatPB:
+			enc, err := evt.Marshal()
+			require.NoError(t, err)
+			buf.Write(binary.AppendUvarint(nil, uint64(len(enc))))
+			buf.Write(enc)
+		}
+	}
+	path := filepath.Join(t.TempDir(), "trace")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0600))
+	return path
+}
+
+// testTraceEvents returns the events of the traces of two nodes, where the first one publishes a message which the
+// second one receives 300ms later, and the second one receives messages which the first one does not see.
+func testTraceEvents(slotStart time.Time, topic string) []*pubsubpb.TraceEvent {
+	nodeA, nodeB, from := []byte("node-a"), []byte("node-b"), []byte("peer")
+	invalid, ignored := "validation failed", pubsub.RejectValidationIgnored
+	event := func(typ pubsubpb.TraceEvent_Type, node []byte, offset time.Duration) *pubsubpb.TraceEvent {
+		ts := slotStart.Add(offset).UnixNano()
+		return &pubsubpb.TraceEvent{Type: typ.Enum(), PeerID: node, Timestamp: &ts}
+	}
+
+	addPeer := event(pubsubpb.TraceEvent_ADD_PEER, nodeA, 0)
+	addPeer.AddPeer = &pubsubpb.TraceEvent_AddPeer{PeerID: from}
+	graft := event(pubsubpb.TraceEvent_GRAFT, nodeA, 0)
+	graft.Graft = &pubsubpb.TraceEvent_Graft{PeerID: from, Topic: &topic}
+	publish := event(pubsubpb.TraceEvent_PUBLISH_MESSAGE, nodeA, time.Second)
+	publish.PublishMessage = &pubsubpb.TraceEvent_PublishMessage{MessageID: []byte("m1"), Topic: &topic}
+	deliverA := event(pubsubpb.TraceEvent_DELIVER_MESSAGE, nodeA, time.Second)
+	deliverA.DeliverMessage = &pubsubpb.TraceEvent_DeliverMessage{MessageID: []byte("m1"), Topic: &topic}
+	deliverB := event(pubsubpb.TraceEvent_DELIVER_MESSAGE, nodeB, 1300*time.Millisecond)
+	deliverB.DeliverMessage = &pubsubpb.TraceEvent_DeliverMessage{MessageID: []byte("m1"), Topic: &topic, ReceivedFrom: nodeA}
+	duplicate := event(pubsubpb.TraceEvent_DUPLICATE_MESSAGE, nodeB, 1500*time.Millisecond)
+	duplicate.DuplicateMessage = &pubsubpb.TraceEvent_DuplicateMessage{MessageID: []byte("m1"), Topic: &topic, ReceivedFrom: from}
+	deliverOther := event(pubsubpb.TraceEvent_DELIVER_MESSAGE, nodeB, 2*time.Second)
+	deliverOther.DeliverMessage = &pubsubpb.TraceEvent_DeliverMessage{MessageID: []byte("m2"), Topic: &topic, ReceivedFrom: from}
+	reject := event(pubsubpb.TraceEvent_REJECT_MESSAGE, nodeB, 3*time.Second)
+	reject.RejectMessage = &pubsubpb.TraceEvent_RejectMessage{MessageID: []byte("m3"), Topic: &topic, ReceivedFrom: from, Reason: &invalid}
+	ignore := event(pubsubpb.TraceEvent_REJECT_MESSAGE, nodeB, 4*time.Second)
+	ignore.RejectMessage = &pubsubpb.TraceEvent_RejectMessage{MessageID: []byte("m4"), Topic: &topic, ReceivedFrom: from, Reason: &ignored}
+	prune := event(pubsubpb.TraceEvent_PRUNE, nodeB, 5*time.Second)
+	prune.Prune = &pubsubpb.TraceEvent_Prune{PeerID: from, Topic: &topic}
+	removePeer := event(pubsubpb.TraceEvent_REMOVE_PEER, nodeA, 5*time.Second)
+	removePeer.RemovePeer = &pubsubpb.TraceEvent_RemovePeer{PeerID: from}
+
+	return []*pubsubpb.TraceEvent{addPeer, graft, publish, deliverA, deliverB, duplicate, deliverOther, reject, ignore, prune, removePeer}
+}
+
+func TestTraceSummary(t *testing.T) {
+	genesis := time.Unix(1606824023, 0)
+	slotStart := slots.BeginsAt(10, genesis)
+	topic := "/eth2/00000000/beacon_block/ssz_snappy"
+
+	tests := []struct {
+		name            string
+		format          string
+		genesis         time.Time
+		wantPropagation []time.Duration
+		wantPercentiles string
+		wantDuplicate   []time.Duration
+	}{
+		{
+			name:            "json",
+			format:          p2p.GossipTraceFormatJSON,
+			wantPropagation: []time.Duration{300 * time.Millisecond},
+			wantPercentiles: "300ms/300ms/300ms",
+			wantDuplicate:   []time.Duration{200 * time.Millisecond},
+		},
+		{
+			name:            "pb",
+			format:          p2p.GossipTraceFormatPB,
+			wantPropagation: []time.Duration{300 * time.Millisecond},
+			wantPercentiles: "300ms/300ms/300ms",
+			wantDuplicate:   []time.Duration{200 * time.Millisecond},
+		},
+		{
+			name:            "json from the slot start",
+			format:          p2p.GossipTraceFormatJSON,
+			genesis:         genesis,
+			wantPropagation: []time.Duration{time.Second, 1300 * time.Millisecond, 2 * time.Second, 3 * time.Second, 4 * time.Second},
+			wantPercentiles: "2s/4s/4s",
+			wantDuplicate:   []time.Duration{200 * time.Millisecond},
+		},
+		{
+			name:            "pb from the slot start",
+			format:          p2p.GossipTraceFormatPB,
+			genesis:         genesis,
+			wantPropagation: []time.Duration{time.Second, 1300 * time.Millisecond, 2 * time.Second, 3 * time.Second, 4 * time.Second},
+			wantPercentiles: "2s/4s/4s",
+			wantDuplicate:   []time.Duration{200 * time.Millisecond},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeTrace(t, tt.format, testTraceEvents(slotStart, topic))
+			s := newTraceSummary()
+			s.genesis = tt.genesis
+			require.NoError(t, s.addFile(path, tt.format))
+			var out bytes.Buffer
+			require.NoError(t, s.print(&out, 10))
+
+			assert.Equal(t, 11, s.events)
+			assert.Equal(t, 2, len(s.nodes))
+			assert.Equal(t, 1, s.peersAdded)
+			assert.Equal(t, 1, s.peersRemoved)
+			summary, ok := s.topics[topic]
+			require.Equal(t, true, ok)
+			assert.Equal(t, 3, summary.delivered)
+			assert.Equal(t, 1, summary.rejected)
+			assert.Equal(t, 1, summary.ignored)
+			assert.Equal(t, 1, summary.duplicates)
+			assert.Equal(t, 1, summary.grafts)
+			assert.Equal(t, 1, summary.prunes)
+			assert.DeepEqual(t, tt.wantPropagation, summary.propagationDelay)
+			assert.DeepEqual(t, tt.wantDuplicate, summary.duplicateDelay)
+			// Only the invalid message counts against the peer.
+			rejecting, ok := s.peers[peer.ID("peer")]
+			require.Equal(t, true, ok)
+			assert.Equal(t, 1, rejecting.rejected)
+			assert.DeepEqual(t, map[string]int{"validation failed": 1}, rejecting.reasons)
+			assert.StringContains(t, tt.wantPercentiles, out.String())
+		})
+	}
+}
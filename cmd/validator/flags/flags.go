@@ -43,8 +43,10 @@ var (
 	}
 	// BeaconRESTApiProviderFlag defines a beacon node REST API endpoint.
 	BeaconRESTApiProviderFlag = &cli.StringFlag{
-		Name:  "beacon-rest-api-provider",
-		Usage: "Beacon node REST API provider endpoint.",
+		Name: "beacon-rest-api-provider",
+		Usage: "Beacon node REST API provider endpoint. A comma separated list of endpoints can be provided, in order of preference, " +
+			"in which case the validator client fails over to the next healthy and synced beacon node when the current one fails, " +
+			"and returns to the first one once it recovers.",
 		Value: "http://127.0.0.1:3500",
 	}
 	// CertFlag defines a flag for the node's TLS certificate.
@@ -304,6 +306,13 @@ var (
 		Usage: "Comma separated list of public keys OR an external url endpoint for the validator to retrieve public keys from for usage with web3signer.",
 	}
 
+	// Web3SignerKeysSyncIntervalFlag defines the interval at which the public keys are synced from web3signer.
+	// example: --validators-external-signer-keys-sync-interval=1m
+	Web3SignerKeysSyncIntervalFlag = &cli.DurationFlag{
+		Name:  "validators-external-signer-keys-sync-interval",
+		Usage: "Interval at which the validator syncs its public keys from web3signer's public keys endpoint, instead of using --validators-external-signer-public-keys.",
+	}
+
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
@@ -348,6 +357,13 @@ var (
 		fee recipient and gas limit. File format found in docs`,
 		Value: "",
 	}
+	// ProposerSettingsURLRefreshIntervalFlag defines the interval at which the proposer settings are fetched again from the URL.
+	ProposerSettingsURLRefreshIntervalFlag = &cli.DurationFlag{
+		Name: "proposer-settings-url-refresh-interval",
+		Usage: `Interval at which the proposer settings are fetched again from --proposer-settings-url. Changes take effect
+		from the next epoch. Set to 0 to only fetch them at startup.`,
+		Value: 5 * time.Minute,
+	}
 
 	// SuggestedFeeRecipientFlag defines the address of the fee recipient.
 	SuggestedFeeRecipientFlag = &cli.StringFlag{
@@ -389,6 +405,24 @@ var (
 		Usage: "To enable the use of prysm validator client in Distributed Validator Cluster",
 		Value: false,
 	}
+
+	// SharedSlashingProtectionDBFlag defines a Postgres database storing slashing protection data shared by several validator clients.
+	SharedSlashingProtectionDBFlag = &cli.StringFlag{
+		Name: "shared-slashing-protection-db",
+		Usage: "Postgres connection string of a database storing slashing protection data shared by several validator clients, for active/passive setups. " +
+			"Slashing protection follows the EIP-3076 minimal rules, and other data stays in the local minimal database. " +
+			"The validator client must be built with a database/sql driver registered as \"postgres\".",
+	}
+
+	// BroadcastToAllBeaconNodesFlag submits signed duties to all beacon nodes given in --beacon-rest-api-provider.
+	// It is only supported with the beacon REST API.
+	BroadcastToAllBeaconNodesFlag = &cli.BoolFlag{
+		Name: "broadcast-to-all-beacon-nodes",
+		Usage: "Submits attestations, aggregates, sync committee messages and blocks to all healthy beacon nodes given in --beacon-rest-api-provider in parallel, " +
+			"instead of only to the current one. Duties and the data to sign are still requested from a single beacon node. " +
+			"Requires --enable-beacon-rest-api, as broadcasting is not supported over gRPC.",
+		Value: false,
+	}
 )
 
 // DefaultValidatorDir returns OS-specific default validator directory.
@@ -75,12 +75,16 @@ var appFlags = []cli.Flag{
 	flags.EnableWebFlag,
 	flags.GraffitiFileFlag,
 	flags.EnableDistributed,
+	flags.BroadcastToAllBeaconNodesFlag,
+	flags.SharedSlashingProtectionDBFlag,
 	flags.AuthTokenPathFlag,
 	// Consensys' Web3Signer flags
 	flags.Web3SignerURLFlag,
 	flags.Web3SignerPublicValidatorKeysFlag,
+	flags.Web3SignerKeysSyncIntervalFlag,
 	flags.SuggestedFeeRecipientFlag,
 	flags.ProposerSettingsURLFlag,
+	flags.ProposerSettingsURLRefreshIntervalFlag,
 	flags.ProposerSettingsFlag,
 	flags.EnableBuilderFlag,
 	flags.BuilderGasLimitFlag,
@@ -99,6 +103,7 @@ var appFlags = []cli.Flag{
 	cmd.EnableTracingFlag,
 	cmd.TracingProcessNameFlag,
 	cmd.TracingEndpointFlag,
+	cmd.TracingProtocolFlag,
 	cmd.TraceSampleFractionFlag,
 	cmd.LogFormat,
 	cmd.LogFileName,
@@ -135,6 +135,11 @@ var (
 		Name:  "enable-beacon-rest-api",
 		Usage: "(Experimental): Enables of the beacon REST API when querying a beacon node.",
 	}
+	EnableBeaconRESTApiSSZ = &cli.BoolFlag{
+		Name: "enable-beacon-rest-api-ssz",
+		Usage: "(Experimental): Requests and publishes blocks SSZ encoded instead of JSON encoded when using the beacon REST API. " +
+			"Falls back to JSON for beacon nodes that don't support SSZ.",
+	}
 	disableVerboseSigVerification = &cli.BoolFlag{
 		Name:  "disable-verbose-sig-verification",
 		Usage: "Disables identifying invalid signatures if batch verification fails when processing block.",
@@ -192,6 +197,7 @@ var ValidatorFlags = append(deprecatedFlags, []cli.Flag{
 	EnableMinimalSlashingProtection,
 	enableDoppelGangerProtection,
 	EnableBeaconRESTApi,
+	EnableBeaconRESTApiSSZ,
 }...)
 
 // E2EValidatorFlags contains a list of the validator feature flags to be tested in E2E.
@@ -33,6 +33,10 @@ const (
 	BlobSize                              = 131072        // defined to match blob.size in bazel ssz codegen
 	KzgCommitmentInclusionProofDepth      = 17            // Merkle proof depth for blob_kzg_commitments list item
 	NextSyncCommitteeBranchDepth          = 5             // NextSyncCommitteeBranchDepth defines the depth of the next sync committee branch.
+	NextSyncCommitteeBranchDepthElectra   = 6             // NextSyncCommitteeBranchDepthElectra defines the depth of the next sync committee branch from Electra on.
+	FinalityBranchDepth                   = 6             // FinalityBranchDepth defines the depth of the finalized root branch.
+	FinalityBranchDepthElectra            = 7             // FinalityBranchDepthElectra defines the depth of the finalized root branch from Electra on.
+	ExecutionBranchDepth                  = 4             // ExecutionBranchDepth defines the depth of the execution payload branch of a block body.
 	PendingBalanceDepositsLimit           = 134217728     // Maximum number of pending balance deposits in the beacon state.
 	PendingPartialWithdrawalsLimit        = 134217728     // Maximum number of pending partial withdrawals in the beacon state.
 	PendingConsolidationsLimit            = 262144        // Maximum number of pending consolidations in the beacon state.
@@ -114,6 +114,18 @@ func NewProposerSettingsLoader(cliCtx *cli.Context, db iface.ValidatorDB, opts .
 
 // Load saves the proposer settings to the database
 func (psl *settingsLoader) Load(cliCtx *cli.Context) (*proposer.Settings, error) {
+	ps, err := psl.settings(cliCtx)
+	if err != nil || ps == nil {
+		return nil, err
+	}
+	if err := psl.db.SaveProposerSettings(cliCtx.Context, ps); err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+// settings processes the proposer settings of every load method, without saving them.
+func (psl *settingsLoader) settings(cliCtx *cli.Context) (*proposer.Settings, error) {
 	loadConfig := &validatorpb.ProposerSettingsPayload{}
 
 	// override settings based on other options
@@ -187,14 +199,7 @@ func (psl *settingsLoader) Load(cliCtx *cli.Context) (*proposer.Settings, error)
 		log.Warn("No proposer settings were provided")
 		return nil, nil
 	}
-	ps, err := proposer.SettingFromConsensus(loadConfig)
-	if err != nil {
-		return nil, err
-	}
-	if err := psl.db.SaveProposerSettings(cliCtx.Context, ps); err != nil {
-		return nil, err
-	}
-	return ps, nil
+	return proposer.SettingFromConsensus(loadConfig)
 }
 
 func (psl *settingsLoader) processProposerSettings(loadedSettings, dbSettings *validatorpb.ProposerSettingsPayload) *validatorpb.ProposerSettingsPayload {
@@ -0,0 +1,244 @@
+package loader
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/fsnotify/fsnotify"
+	"github.com/prysmaticlabs/prysm/v5/async"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// reloadDebounceInterval groups the file system events fired by a single write of the proposer settings file.
+const reloadDebounceInterval = time.Second
+
+// SettingsSetter holds the proposer settings in use by the validator client.
+type SettingsSetter interface {
+	ProposerSettings() *proposer.Settings
+	SetProposerSettings(context.Context, *proposer.Settings) error
+}
+
+// Reloader is a service which reloads the proposer settings whenever the file of --proposer-settings-file changes,
+// and at an interval from --proposer-settings-url. The settings are processed as at startup, so they are validated
+// and merged with the settings of the database before they replace the settings in use. The validator client pushes
+// them to the beacon node at the start of the next epoch.
+type Reloader struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	cliCtx   *cli.Context
+	loader   *settingsLoader
+	setter   SettingsSetter
+	path     string
+	interval time.Duration
+}
+
+// NewReloader creates a Reloader of the settings of the loader, or returns nil if they are read from neither a file
+// nor a URL refreshed at an interval.
+func NewReloader(cliCtx *cli.Context, l *settingsLoader, setter SettingsSetter) *Reloader {
+	r := &Reloader{
+		cliCtx: cliCtx,
+		loader: l,
+		setter: setter,
+	}
+	switch {
+	case cliCtx.IsSet(flags.ProposerSettingsFlag.Name):
+		r.path = filepath.Clean(cliCtx.String(flags.ProposerSettingsFlag.Name))
+	case cliCtx.IsSet(flags.ProposerSettingsURLFlag.Name) && cliCtx.Duration(flags.ProposerSettingsURLRefreshIntervalFlag.Name) > 0:
+		r.interval = cliCtx.Duration(flags.ProposerSettingsURLRefreshIntervalFlag.Name)
+	default:
+		return nil
+	}
+	r.ctx, r.cancel = context.WithCancel(cliCtx.Context)
+	return r
+}
+
+// Start watches the proposer settings file, or polls the proposer settings URL.
+func (r *Reloader) Start() {
+	if r.path != "" {
+		go r.watchFile()
+		return
+	}
+	go r.pollURL()
+}
+
+// Stop the reloader.
+func (r *Reloader) Stop() error {
+	r.cancel()
+	return nil
+}
+
+// Status of the reloader.
+func (*Reloader) Status() error {
+	return nil
+}
+
+func (r *Reloader) watchFile() {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.WithError(err).Error("Could not initialize file watcher")
+		return
+	}
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			log.WithError(err).Error("Could not close file watcher")
+		}
+	}()
+	// The directory is watched rather than the file, as editors often replace a file instead of writing to it, which
+	// would end a watch of the file itself.
+	dir := filepath.Dir(r.path)
+	if err := watcher.Add(dir); err != nil {
+		log.WithError(err).Errorf("Could not add directory %s to file watcher", dir)
+		return
+	}
+	fileChangesChan := make(chan interface{}, 100)
+	defer close(fileChangesChan)
+	go async.Debounce(r.ctx, reloadDebounceInterval, fileChangesChan, func(interface{}) {
+		r.reload()
+	})
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			// The channels of the watcher are closed once it stops.
+			if !ok {
+				return
+			}
+			if filepath.Clean(event.Name) == r.path && event.Op&(fsnotify.Write|fsnotify.Create) != 0 {
+				fileChangesChan <- event
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			log.WithError(err).Errorf("Could not watch for changes of file %s", r.path)
+		case <-r.ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Reloader) pollURL() {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.reload()
+		case <-r.ctx.Done():
+			return
+		}
+	}
+}
+
+// reload processes the proposer settings again, and applies them if they changed.
+func (r *Reloader) reload() {
+	exists, err := r.loader.db.ProposerSettingsExists(r.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not check for proposer settings in db")
+		return
+	}
+	r.loader.existsInDB = exists
+	ps, err := r.loader.settings(r.cliCtx)
+	if err != nil {
+		log.WithError(err).Error("Could not reload proposer settings, keeping the current settings")
+		return
+	}
+	if ps == nil {
+		log.Warn("Reloaded proposer settings are empty, keeping the current settings")
+		return
+	}
+	changes := settingsDiff(r.setter.ProposerSettings(), ps)
+	if len(changes) == 0 {
+		log.Debug("Reloaded proposer settings are unchanged")
+		return
+	}
+	if err := r.setter.SetProposerSettings(r.ctx, ps); err != nil {
+		log.WithError(err).Error("Could not apply reloaded proposer settings")
+		return
+	}
+	for _, c := range changes {
+		log.WithFields(log.Fields{
+			"proposer": c.proposer,
+			"old":      c.old,
+			"new":      c.new,
+		}).Info("Proposer settings changed")
+	}
+	log.WithField("changes", len(changes)).Info("Reloaded proposer settings, which take effect from the next epoch")
+}
+
+// settingsChange is the change of the options of a proposer, or of the default options.
+type settingsChange struct {
+	proposer string
+	old      string
+	new      string
+}
+
+// settingsDiff lists the options which differ between two proposer settings, with the default options first and
+// then the proposers in the order of their public keys.
+func settingsDiff(oldSettings, newSettings *proposer.Settings) []settingsChange {
+	if oldSettings == nil {
+		oldSettings = &proposer.Settings{}
+	}
+	if newSettings == nil {
+		newSettings = &proposer.Settings{}
+	}
+	var changes []settingsChange
+	if o, n := describeOption(oldSettings.DefaultConfig), describeOption(newSettings.DefaultConfig); o != n {
+		changes = append(changes, settingsChange{proposer: "default", old: o, new: n})
+	}
+
+	keys := make(map[string]struct{})
+	oldOptions := make(map[string]*proposer.Option)
+	for k, o := range oldSettings.ProposeConfig {
+		key := hexutil.Encode(k[:])
+		oldOptions[key] = o
+		keys[key] = struct{}{}
+	}
+	newOptions := make(map[string]*proposer.Option)
+	for k, o := range newSettings.ProposeConfig {
+		key := hexutil.Encode(k[:])
+		newOptions[key] = o
+		keys[key] = struct{}{}
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+	for _, k := range sorted {
+		if o, n := describeOption(oldOptions[k]), describeOption(newOptions[k]); o != n {
+			changes = append(changes, settingsChange{proposer: k, old: o, new: n})
+		}
+	}
+	return changes
+}
+
+func describeOption(o *proposer.Option) string {
+	if o == nil {
+		return "none"
+	}
+	var parts []string
+	if o.FeeRecipientConfig != nil {
+		parts = append(parts, "feeRecipient="+o.FeeRecipientConfig.FeeRecipient.Hex())
+	}
+	if b := o.BuilderConfig; b != nil {
+		parts = append(parts, fmt.Sprintf("builder=%t", b.Enabled), fmt.Sprintf("gasLimit=%d", b.GasLimit))
+		if len(b.Relays) > 0 {
+			parts = append(parts, "relays="+strings.Join(b.Relays, ","))
+		}
+	}
+	if o.GraffitiConfig != nil {
+		parts = append(parts, fmt.Sprintf("graffiti=%q", o.GraffitiConfig.Graffiti))
+	}
+	if len(parts) == 0 {
+		return "empty"
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,206 @@
+package loader
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	dbTest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	feeRecipientA = "0xAe967917c465db8578ca9024c205720b1a3651A9"
+	feeRecipientB = "0x8E21b8E6B4A52FC2e6D1a6F7e1D8d9D6Ae3C8B41"
+)
+
+type mockSetter struct {
+	sync.Mutex
+	settings *proposer.Settings
+	calls    int
+}
+
+func (m *mockSetter) ProposerSettings() *proposer.Settings {
+	m.Lock()
+	defer m.Unlock()
+	return m.settings
+}
+
+func (m *mockSetter) SetProposerSettings(_ context.Context, settings *proposer.Settings) error {
+	m.Lock()
+	defer m.Unlock()
+	m.settings = settings
+	m.calls++
+	return nil
+}
+
+func (m *mockSetter) feeRecipient() common.Address {
+	m.Lock()
+	defer m.Unlock()
+	return m.settings.DefaultConfig.FeeRecipientConfig.FeeRecipient
+}
+
+func settingsJSON(feeRecipient string) string {
+	return fmt.Sprintf(`{"default_config": {"fee_recipient": "%s"}}`, feeRecipient)
+}
+
+func waitForFeeRecipient(t *testing.T, setter *mockSetter, feeRecipient string) {
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		if setter.feeRecipient() == common.HexToAddress(feeRecipient) {
+			return
+		}
+	}
+	t.Fatalf("Fee recipient was not reloaded to %s", feeRecipient)
+}
+
+// newTestReloader loads the settings of the flag like at startup, and creates their reloader.
+func newTestReloader(t *testing.T, set *flag.FlagSet) (*Reloader, *mockSetter) {
+	cliCtx := cli.NewContext(&cli.App{}, set, nil)
+	cliCtx.Context = context.Background()
+	validatorDB := dbTest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}, false)
+	l, err := NewProposerSettingsLoader(cliCtx, validatorDB, WithBuilderConfig(), WithGasLimit())
+	require.NoError(t, err)
+	ps, err := l.Load(cliCtx)
+	require.NoError(t, err)
+	setter := &mockSetter{settings: ps}
+	return NewReloader(cliCtx, l, setter), setter
+}
+
+func TestReloader_File(t *testing.T) {
+	hook := logtest.NewGlobal()
+	path := filepath.Join(t.TempDir(), "proposer-settings.json")
+	require.NoError(t, os.WriteFile(path, []byte(settingsJSON(feeRecipientA)), 0600))
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.ProposerSettingsFlag.Name, "", "")
+	require.NoError(t, set.Set(flags.ProposerSettingsFlag.Name, path))
+
+	r, setter := newTestReloader(t, set)
+	require.NotNil(t, r)
+	r.Start()
+	defer func() {
+		require.NoError(t, r.Stop())
+	}()
+	// Gives the watcher time to start.
+	time.Sleep(100 * time.Millisecond)
+
+	require.NoError(t, os.WriteFile(path, []byte(settingsJSON(feeRecipientB)), 0600))
+	waitForFeeRecipient(t, setter, feeRecipientB)
+	assert.LogsContain(t, hook, "Proposer settings changed")
+
+	// Invalid settings are not applied.
+	require.NoError(t, os.WriteFile(path, []byte(`{"default_config": {"fee_recipient": "0x1"}}`), 0600))
+	r.reload()
+	assert.LogsContain(t, hook, "Could not reload proposer settings, keeping the current settings")
+	require.Equal(t, common.HexToAddress(feeRecipientB), setter.feeRecipient())
+}
+
+func TestReloader_URL(t *testing.T) {
+	var lock sync.Mutex
+	content := settingsJSON(feeRecipientA)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, err := fmt.Fprint(w, content)
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.ProposerSettingsURLFlag.Name, "", "")
+	require.NoError(t, set.Set(flags.ProposerSettingsURLFlag.Name, srv.URL))
+	set.Duration(flags.ProposerSettingsURLRefreshIntervalFlag.Name, 0, "")
+	require.NoError(t, set.Set(flags.ProposerSettingsURLRefreshIntervalFlag.Name, "10ms"))
+
+	r, setter := newTestReloader(t, set)
+	require.NotNil(t, r)
+	r.Start()
+	defer func() {
+		require.NoError(t, r.Stop())
+	}()
+
+	lock.Lock()
+	content = settingsJSON(feeRecipientB)
+	lock.Unlock()
+	waitForFeeRecipient(t, setter, feeRecipientB)
+
+	// Unchanged settings are not applied again.
+	setter.Lock()
+	calls := setter.calls
+	setter.Unlock()
+	r.reload()
+	setter.Lock()
+	defer setter.Unlock()
+	require.Equal(t, calls, setter.calls)
+}
+
+func TestNewReloader_URLWithoutInterval(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := fmt.Fprint(w, settingsJSON(feeRecipientA))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.ProposerSettingsURLFlag.Name, "", "")
+	require.NoError(t, set.Set(flags.ProposerSettingsURLFlag.Name, srv.URL))
+	set.Duration(flags.ProposerSettingsURLRefreshIntervalFlag.Name, 0, "")
+
+	r, _ := newTestReloader(t, set)
+	require.Equal(t, true, r == nil)
+}
+
+func TestSettingsDiff(t *testing.T) {
+	key := func(b byte) [fieldparams.BLSPubkeyLength]byte {
+		var k [fieldparams.BLSPubkeyLength]byte
+		k[0] = b
+		return k
+	}
+	option := func(feeRecipient string) *proposer.Option {
+		return &proposer.Option{
+			FeeRecipientConfig: &proposer.FeeRecipientConfig{FeeRecipient: common.HexToAddress(feeRecipient)},
+		}
+	}
+	oldSettings := &proposer.Settings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+			key(1): option(feeRecipientA),
+			key(2): option(feeRecipientA),
+		},
+		DefaultConfig: option(feeRecipientA),
+	}
+	newSettings := &proposer.Settings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+			key(2): option(feeRecipientA),
+			key(3): {
+				FeeRecipientConfig: &proposer.FeeRecipientConfig{FeeRecipient: common.HexToAddress(feeRecipientB)},
+				BuilderConfig:      &proposer.BuilderConfig{Enabled: true, GasLimit: 30000000},
+			},
+		},
+		DefaultConfig: option(feeRecipientB),
+	}
+
+	changes := settingsDiff(oldSettings, newSettings)
+	require.Equal(t, 3, len(changes))
+	require.Equal(t, "default", changes[0].proposer)
+	require.Equal(t, "feeRecipient="+common.HexToAddress(feeRecipientA).Hex(), changes[0].old)
+	require.Equal(t, "feeRecipient="+common.HexToAddress(feeRecipientB).Hex(), changes[0].new)
+	require.Equal(t, "none", changes[1].new)
+	require.Equal(t, "none", changes[2].old)
+	require.Equal(t, "feeRecipient="+common.HexToAddress(feeRecipientB).Hex()+" builder=true gasLimit=30000000", changes[2].new)
+
+	require.Equal(t, 0, len(settingsDiff(newSettings, newSettings.Clone())))
+	require.Equal(t, 3, len(settingsDiff(nil, oldSettings)))
+}
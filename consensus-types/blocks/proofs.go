@@ -0,0 +1,129 @@
+package blocks
+
+import (
+	field_params "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/container/trie"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+const executionPayloadPosition = 9 // The index of the execution payload in the Body
+
+// MerkleProofExecutionPayload constructs a Merkle proof of inclusion of the
+// execution payload into the Beacon Block with the given `body`, as used for
+// the execution branch of light client headers.
+func MerkleProofExecutionPayload(body interfaces.ReadOnlyBeaconBlockBody) ([][]byte, error) {
+	if body.Version() < version.Capella {
+		return nil, errUnsupportedBeaconBlockBody
+	}
+	fieldRoots, err := bodyFieldRoots(body)
+	if err != nil {
+		return nil, err
+	}
+	sparse, err := trie.GenerateTrieFromItems(fieldRoots, logBodyLength)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := sparse.MerkleProof(executionPayloadPosition)
+	if err != nil {
+		return nil, err
+	}
+	// sparse.MerkleProof always includes the length of the slice this is
+	// why we remove the last element that is not needed in the proof
+	return proof[:len(proof)-1], nil
+}
+
+// bodyFieldRoots computes the roots of all the fields of a post-Capella
+// BeaconBlockBody.
+func bodyFieldRoots(body interfaces.ReadOnlyBeaconBlockBody) ([][]byte, error) {
+	cfg := params.BeaconConfig()
+	maxAttesterSlashings, maxAttestations := cfg.MaxAttesterSlashings, cfg.MaxAttestations
+	if body.Version() >= version.Electra {
+		maxAttesterSlashings, maxAttestations = cfg.MaxAttesterSlashingsElectra, cfg.MaxAttestationsElectra
+	}
+
+	roots := make([][32]byte, 0, bodyLength+1)
+	randao := body.RandaoReveal()
+	root, err := ssz.MerkleizeByteSliceSSZ(randao[:])
+	if err != nil {
+		return nil, err
+	}
+	roots = append(roots, root)
+	if root, err = body.Eth1Data().HashTreeRoot(); err != nil {
+		return nil, err
+	}
+	roots = append(roots, root, body.Graffiti())
+	if root, err = ssz.MerkleizeListSSZ(body.ProposerSlashings(), cfg.MaxProposerSlashings); err != nil {
+		return nil, err
+	}
+	roots = append(roots, root)
+	if root, err = ssz.MerkleizeListSSZ(body.AttesterSlashings(), maxAttesterSlashings); err != nil {
+		return nil, err
+	}
+	roots = append(roots, root)
+	if root, err = ssz.MerkleizeListSSZ(body.Attestations(), maxAttestations); err != nil {
+		return nil, err
+	}
+	roots = append(roots, root)
+	if root, err = ssz.MerkleizeListSSZ(body.Deposits(), cfg.MaxDeposits); err != nil {
+		return nil, err
+	}
+	roots = append(roots, root)
+	if root, err = ssz.MerkleizeListSSZ(body.VoluntaryExits(), cfg.MaxVoluntaryExits); err != nil {
+		return nil, err
+	}
+	roots = append(roots, root)
+	sa, err := body.SyncAggregate()
+	if err != nil {
+		return nil, err
+	}
+	if root, err = sa.HashTreeRoot(); err != nil {
+		return nil, err
+	}
+	roots = append(roots, root)
+	ep, err := body.Execution()
+	if err != nil {
+		return nil, err
+	}
+	if root, err = ep.HashTreeRoot(); err != nil {
+		return nil, err
+	}
+	roots = append(roots, root)
+	bls, err := body.BLSToExecutionChanges()
+	if err != nil {
+		return nil, err
+	}
+	if root, err = ssz.MerkleizeListSSZ(bls, cfg.MaxBlsToExecutionChanges); err != nil {
+		return nil, err
+	}
+	roots = append(roots, root)
+
+	if body.Version() >= version.Deneb {
+		commitments, err := body.BlobKzgCommitments()
+		if err != nil {
+			return nil, err
+		}
+		if root, err = ssz.ByteArrayRootWithLimit(leavesFromCommitments(commitments), field_params.MaxBlobCommitmentsPerBlock); err != nil {
+			return nil, err
+		}
+		roots = append(roots, root)
+	}
+	if body.Version() >= version.Electra {
+		electraBody, ok := body.(interfaces.ROBlockBodyElectra)
+		if !ok {
+			return nil, errUnsupportedBeaconBlockBody
+		}
+		if root, err = ssz.MerkleizeListSSZ(electraBody.Consolidations(), cfg.MaxConsolidations); err != nil {
+			return nil, err
+		}
+		roots = append(roots, root)
+	}
+
+	fieldRoots := make([][]byte, len(roots))
+	for i := range roots {
+		fieldRoots[i] = roots[i][:]
+	}
+	return fieldRoots, nil
+}
@@ -0,0 +1,60 @@
+package blocks_test
+
+import (
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/container/trie"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestMerkleProofExecutionPayload(t *testing.T) {
+	capella := util.NewBeaconBlockCapella()
+	capella.Block.Body.Graffiti = bytesutil.PadTo([]byte("capella"), fieldparams.RootLength)
+	capella.Block.Body.ExecutionPayload.BlockNumber = 1
+
+	deneb := util.NewBeaconBlockDeneb()
+	deneb.Block.Body.ExecutionPayload.BlockNumber = 2
+	deneb.Block.Body.BlobKzgCommitments = [][]byte{bytesutil.PadTo([]byte{1}, 48), bytesutil.PadTo([]byte{2}, 48)}
+
+	electra := util.NewBeaconBlockElectra()
+	electra.Block.Body.ExecutionPayload.BlockNumber = 3
+	electra.Block.Body.BlobKzgCommitments = [][]byte{bytesutil.PadTo([]byte{3}, 48)}
+	electra.Block.Body.Consolidations = []*ethpb.SignedConsolidation{{
+		Message:   &ethpb.Consolidation{SourceIndex: 1, TargetIndex: 2, Epoch: 3},
+		Signature: make([]byte, fieldparams.BLSSignatureLength),
+	}}
+
+	for _, pb := range []interface{}{capella, deneb, electra} {
+		blk, err := blocks.NewSignedBeaconBlock(pb)
+		require.NoError(t, err)
+		body := blk.Block().Body()
+		t.Run(version.String(blk.Version()), func(t *testing.T) {
+			verifyExecutionPayloadProof(t, body)
+		})
+	}
+
+	altair, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockAltair())
+	require.NoError(t, err)
+	_, err = blocks.MerkleProofExecutionPayload(altair.Block().Body())
+	require.ErrorContains(t, "unsupported beacon block body", err)
+}
+
+func verifyExecutionPayloadProof(t *testing.T, body interfaces.ReadOnlyBeaconBlockBody) {
+	proof, err := blocks.MerkleProofExecutionPayload(body)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(proof))
+	bodyRoot, err := body.HashTreeRoot()
+	require.NoError(t, err)
+	execution, err := body.Execution()
+	require.NoError(t, err)
+	executionRoot, err := execution.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, true, trie.VerifyMerkleProof(bodyRoot[:], executionRoot[:], 9, proof))
+}
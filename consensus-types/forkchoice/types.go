@@ -48,6 +48,7 @@ type Node struct {
 	Balance                  uint64
 	Weight                   uint64
 	Timestamp                uint64
+	ViableForHead            bool
 	BlockRoot                []byte
 	ParentRoot               []byte
 	ExecutionBlockHash       []byte
@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/grpc"
+)
+
+// HttpMiddleware continues the trace of incoming HTTP requests which carry W3C trace context, or starts a new one,
+// in a server span named after the matched route.
+func HttpMiddleware(next http.Handler) http.Handler {
+	return otelhttp.NewHandler(next, "http.server", otelhttp.WithSpanNameFormatter(httpSpanName))
+}
+
+// NewTransport wraps the given transport, or the default one if nil, so that outgoing HTTP requests are traced in a
+// client span whose W3C trace context is sent along with the request.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(base)
+}
+
+// UnaryServerInterceptor continues the trace of incoming gRPC calls which carry W3C trace context, or starts a new
+// one, in a server span.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return otelgrpc.UnaryServerInterceptor()
+}
+
+// StreamServerInterceptor is the streaming counterpart of UnaryServerInterceptor.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return otelgrpc.StreamServerInterceptor()
+}
+
+// UnaryClientInterceptor traces outgoing gRPC calls in a client span whose W3C trace context is sent along with the
+// call.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return otelgrpc.UnaryClientInterceptor()
+}
+
+// StreamClientInterceptor is the streaming counterpart of UnaryClientInterceptor.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return otelgrpc.StreamClientInterceptor()
+}
+
+func httpSpanName(_ string, r *http.Request) string {
+	// Routes are matched before middlewares run, so the route template is known and keeps span names bounded.
+	if route := mux.CurrentRoute(r); route != nil {
+		if tmpl, err := route.GetPathTemplate(); err == nil {
+			return r.Method + " " + tmpl
+		}
+	}
+	return r.Method
+}
@@ -0,0 +1,284 @@
+// Package testing provides an in-process stand-in for an OTLP collector, so that the spans exported by a service can
+// be checked in tests.
+package testing
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	_ "google.golang.org/grpc/encoding/gzip" // Register the gzip compressor of gRPC.
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// tracesPath is the path under which the collector receives spans over HTTP.
+const tracesPath = "/v1/traces"
+
+// Collector receives spans over OTLP, both over HTTP and gRPC, and keeps them in memory.
+type Collector struct {
+	coltracepb.UnimplementedTraceServiceServer
+	lock          sync.Mutex
+	resourceSpans []*tracepb.ResourceSpans
+	exports       int
+	failures      int
+	headers       map[string]string
+	compression   string
+	httpServer    *httptest.Server
+	grpcServer    *grpc.Server
+	grpcAddr      string
+	tls           bool
+}
+
+// Option configures a collector.
+type Option func(c *Collector)
+
+// WithTLS serves both HTTP and gRPC over TLS, with the certificate returned by Certificate.
+func WithTLS() Option {
+	return func(c *Collector) {
+		c.tls = true
+	}
+}
+
+// NewCollector starts a collector listening on local ports.
+func NewCollector(opts ...Option) (*Collector, error) {
+	c := &Collector{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.httpServer = httptest.NewUnstartedServer(http.HandlerFunc(c.serveHTTP))
+	var serverOpts []grpc.ServerOption
+	if c.tls {
+		c.httpServer.StartTLS()
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewServerTLSFromCert(&c.httpServer.TLS.Certificates[0])))
+	} else {
+		c.httpServer.Start()
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		c.httpServer.Close()
+		return nil, errors.Wrap(err, "could not listen for gRPC")
+	}
+	c.grpcAddr = lis.Addr().String()
+	c.grpcServer = grpc.NewServer(append(serverOpts, grpc.StatsHandler(c))...)
+	coltracepb.RegisterTraceServiceServer(c.grpcServer, c)
+	go func() {
+		if err := c.grpcServer.Serve(lis); err != nil {
+			logrus.WithError(err).Error("Could not serve gRPC")
+		}
+	}()
+	return c, nil
+}
+
+// HTTPEndpoint is the endpoint to export spans to over HTTP.
+func (c *Collector) HTTPEndpoint() string {
+	return c.httpServer.URL
+}
+
+// GRPCEndpoint is the endpoint to export spans to over gRPC.
+func (c *Collector) GRPCEndpoint() string {
+	if c.tls {
+		return "https://" + c.grpcAddr
+	}
+	return "http://" + c.grpcAddr
+}
+
+// Certificate returns the PEM encoded certificate of a collector serving over TLS, or nil otherwise.
+func (c *Collector) Certificate() []byte {
+	if !c.tls {
+		return nil
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.httpServer.Certificate().Raw})
+}
+
+// FailExports makes the next n exports fail with an error after which the exporter is expected to retry.
+func (c *Collector) FailExports(n int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.failures = n
+}
+
+// Exports returns the number of exports received so far, including the failed ones.
+func (c *Collector) Exports() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.exports
+}
+
+// Compression returns the compression of the last export, which is empty if it was not compressed.
+func (c *Collector) Compression() string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.compression
+}
+
+// Header returns the value of the header, or of the gRPC metadata, with the given name in the last export.
+func (c *Collector) Header(name string) string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.headers[strings.ToLower(name)]
+}
+
+// Close stops the collector.
+func (c *Collector) Close() {
+	c.httpServer.Close()
+	c.grpcServer.Stop()
+}
+
+// ResourceSpans returns the spans received so far, grouped by resource.
+func (c *Collector) ResourceSpans() []*tracepb.ResourceSpans {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	rs := make([]*tracepb.ResourceSpans, len(c.resourceSpans))
+	copy(rs, c.resourceSpans)
+	return rs
+}
+
+// Spans returns the spans received so far.
+func (c *Collector) Spans() []*tracepb.Span {
+	var spans []*tracepb.Span
+	for _, rs := range c.ResourceSpans() {
+		for _, ss := range rs.ScopeSpans {
+			spans = append(spans, ss.Spans...)
+		}
+	}
+	return spans
+}
+
+// SpanNames returns the names of the spans received so far.
+func (c *Collector) SpanNames() []string {
+	spans := c.Spans()
+	names := make([]string, len(spans))
+	for i, span := range spans {
+		names[i] = span.Name
+	}
+	return names
+}
+
+func (c *Collector) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Path != tracesPath {
+		http.NotFound(w, r)
+		return
+	}
+	headers := make(map[string]string, len(r.Header))
+	for name := range r.Header {
+		headers[strings.ToLower(name)] = r.Header.Get(name)
+	}
+	if c.exported(headers, r.Header.Get("Content-Encoding")) {
+		http.Error(w, "export failed", http.StatusServiceUnavailable)
+		return
+	}
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		zr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		body = zr
+	}
+	b, err := io.ReadAll(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req := &coltracepb.ExportTraceServiceRequest{}
+	if err := proto.Unmarshal(b, req); err != nil {
+		http.Error(w, errors.Wrap(err, "could not unmarshal spans").Error(), http.StatusBadRequest)
+		return
+	}
+	c.receive(req)
+	resp, err := proto.Marshal(&coltracepb.ExportTraceServiceResponse{})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(resp); err != nil {
+		logrus.WithError(err).Error("Could not write export response")
+	}
+}
+
+// Export receives spans over gRPC.
+func (c *Collector) Export(ctx context.Context, req *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	headers := make(map[string]string, len(md))
+	for name, values := range md {
+		if len(values) > 0 {
+			headers[name] = values[0]
+		}
+	}
+	var compression string
+	if v, ok := ctx.Value(compressionKey{}).(*string); ok {
+		compression = *v
+	}
+	if c.exported(headers, compression) {
+		return nil, status.Error(codes.Unavailable, "export failed")
+	}
+	c.receive(req)
+	return &coltracepb.ExportTraceServiceResponse{}, nil
+}
+
+// exported records an export with the given headers and compression, and returns whether it should fail.
+func (c *Collector) exported(headers map[string]string, compression string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.exports++
+	c.headers = headers
+	c.compression = compression
+	if c.failures > 0 {
+		c.failures--
+		return true
+	}
+	return false
+}
+
+// receive keeps the spans of an export.
+func (c *Collector) receive(req *coltracepb.ExportTraceServiceRequest) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.resourceSpans = append(c.resourceSpans, req.ResourceSpans...)
+}
+
+// compressionKey is the context key of the compression of a gRPC export, which gRPC does not pass on in the metadata.
+type compressionKey struct{}
+
+// TagRPC keeps the compression of the export in the context, which is that of the headers handled in HandleRPC.
+func (*Collector) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	var compression string
+	return context.WithValue(ctx, compressionKey{}, &compression)
+}
+
+// HandleRPC records the compression of the export.
+func (*Collector) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	if h, ok := s.(*stats.InHeader); ok {
+		if compression, ok := ctx.Value(compressionKey{}).(*string); ok {
+			*compression = h.Compression
+		}
+	}
+}
+
+// TagConn does nothing.
+func (*Collector) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+// HandleConn does nothing.
+func (*Collector) HandleConn(context.Context, stats.ConnStats) {}
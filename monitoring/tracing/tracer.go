@@ -1,22 +1,70 @@
-// Package tracing sets up jaeger as an opentracing tool
+// Package tracing sets up OpenTelemetry tracing, exporting spans to an OTLP collector,
 // for services in Prysm.
 package tracing
 
 import (
-	"errors"
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
 
-	"contrib.go.opencensus.io/exporter/jaeger"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/sirupsen/logrus"
-	"go.opencensus.io/trace"
+	octrace "go.opencensus.io/trace"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/bridge/opencensus"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
 )
 
 var log = logrus.WithField("prefix", "tracing")
 
-// Setup creates and initializes a new tracing configuration..
-func Setup(serviceName, processName, endpoint string, sampleFraction float64, enable bool) error {
+// Protocols over which spans can be exported to an OTLP collector.
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http"
+)
+
+// Exports which fail temporarily are retried for up to maxRetryElapsedTime, rather than the minute the exporters wait
+// by default, so that an unreachable collector does not hold up the batches of spans which follow for long.
+const (
+	initialRetryInterval = 100 * time.Millisecond
+	maxRetryInterval     = 2 * time.Second
+	maxRetryElapsedTime  = 10 * time.Second
+)
+
+// shutdownTimeout is how long to wait for the remaining spans to be exported when shutting down.
+const shutdownTimeout = 5 * time.Second
+
+// instrumentationName names the tracer through which the spans of the OpenCensus API are created.
+const instrumentationName = "github.com/prysmaticlabs/prysm/v5"
+
+var (
+	providerLock sync.Mutex
+	provider     *sdktrace.TracerProvider
+)
+
+func init() {
+	// Trace context is propagated in the W3C format even when tracing is disabled, so that a service which does not
+	// export spans does not break the traces of the services it talks to.
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+}
+
+// Setup creates and initializes a new tracing configuration, exporting spans to the OTLP collector at the given
+// endpoint over the given protocol. The collector defaults to the one set in the standard OTEL_EXPORTER_OTLP_ENDPOINT
+// environment variable, or to the local one, if the endpoint is empty.
+func Setup(serviceName, processName, endpoint, protocol string, sampleFraction float64, enable bool) error {
 	if !enable {
-		trace.ApplyConfig(trace.Config{DefaultSampler: trace.NeverSample()})
+		octrace.ApplyConfig(octrace.Config{DefaultSampler: octrace.NeverSample()})
 		return nil
 	}
 
@@ -24,30 +72,107 @@ func Setup(serviceName, processName, endpoint string, sampleFraction float64, en
 		return errors.New("tracing service name cannot be empty")
 	}
 
-	trace.ApplyConfig(trace.Config{
-		DefaultSampler:          trace.ProbabilitySampler(sampleFraction),
-		MaxMessageEventsPerSpan: 500,
-	})
-
-	log.Infof("Starting Jaeger exporter endpoint at address = %s", endpoint)
-	exporter, err := jaeger.NewExporter(jaeger.Options{
-		CollectorEndpoint: endpoint,
-		Process: jaeger.Process{
-			ServiceName: serviceName,
-			Tags: []jaeger.Tag{
-				jaeger.StringTag("process_name", processName),
-				jaeger.StringTag("version", version.Version()),
-			},
-		},
-		BufferMaxCount: 10000,
-		OnError: func(err error) {
-			log.WithError(err).Error("Could not process span")
-		},
-	})
+	exporter, err := newExporter(endpoint, protocol)
+	if err != nil {
+		return errors.Wrap(err, "could not create span exporter")
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(version.Version()),
+		attribute.String("process_name", processName),
+	))
 	if err != nil {
-		return err
+		return errors.Wrap(err, "could not create tracing resource")
 	}
-	trace.RegisterExporter(exporter)
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter, sdktrace.WithMaxQueueSize(10000)),
+		// Spans continue the sampling decision of their parent, so that a trace started in another service is
+		// followed end to end.
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleFraction))),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		log.WithError(err).Error("Could not process span")
+	}))
+	// Spans created with the OpenCensus API are turned into OpenTelemetry spans.
+	octrace.DefaultTracer = opencensus.NewTracer(tp.Tracer(instrumentationName))
+
+	providerLock.Lock()
+	provider = tp
+	providerLock.Unlock()
 
+	log.WithFields(logrus.Fields{
+		"endpoint": endpoint,
+		"protocol": protocol,
+	}).Info("Exporting traces to OTLP collector")
 	return nil
 }
+
+// Shutdown exports the spans which have not been exported yet and stops tracing, giving up on the export after a
+// timeout so that an unreachable collector does not block the shutdown of the service. It does nothing if tracing has
+// not been set up.
+func Shutdown() error {
+	providerLock.Lock()
+	tp := provider
+	provider = nil
+	providerLock.Unlock()
+	if tp == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return tp.Shutdown(ctx)
+}
+
+func newExporter(endpoint, protocol string) (*otlptrace.Exporter, error) {
+	var httpOpts []otlptracehttp.Option
+	var grpcOpts []otlptracegrpc.Option
+	// Without an endpoint, the exporters read it from the standard environment variables, or else export to the local
+	// collector.
+	if endpoint != "" {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse tracing endpoint %s", endpoint)
+		}
+		if u.Host == "" {
+			return nil, fmt.Errorf("tracing endpoint %s has no host, expected a URL such as http://127.0.0.1:4318", endpoint)
+		}
+		urlPath := strings.TrimSuffix(u.Path, "/")
+		if urlPath == "/api/traces" {
+			log.Warnf("Tracing endpoint %s looks like a Jaeger collector endpoint, which is not supported anymore. "+
+				"Jaeger accepts OTLP on port 4318 for HTTP and 4317 for gRPC", endpoint)
+		}
+		httpOpts = append(httpOpts, otlptracehttp.WithEndpoint(u.Host))
+		grpcOpts = append(grpcOpts, otlptracegrpc.WithEndpoint(u.Host))
+		if urlPath != "" {
+			httpOpts = append(httpOpts, otlptracehttp.WithURLPath(urlPath))
+		}
+		if u.Scheme == "http" {
+			httpOpts = append(httpOpts, otlptracehttp.WithInsecure())
+			grpcOpts = append(grpcOpts, otlptracegrpc.WithInsecure())
+		}
+	}
+
+	switch protocol {
+	case ProtocolHTTP:
+		httpOpts = append(httpOpts, otlptracehttp.WithRetry(otlptracehttp.RetryConfig{
+			Enabled:         true,
+			InitialInterval: initialRetryInterval,
+			MaxInterval:     maxRetryInterval,
+			MaxElapsedTime:  maxRetryElapsedTime,
+		}))
+		return otlptracehttp.New(context.Background(), httpOpts...)
+	case ProtocolGRPC:
+		grpcOpts = append(grpcOpts, otlptracegrpc.WithRetry(otlptracegrpc.RetryConfig{
+			Enabled:         true,
+			InitialInterval: initialRetryInterval,
+			MaxInterval:     maxRetryInterval,
+			MaxElapsedTime:  maxRetryElapsedTime,
+		}))
+		return otlptracegrpc.New(context.Background(), grpcOpts...)
+	default:
+		return nil, fmt.Errorf("unknown tracing protocol %q, expected %q or %q", protocol, ProtocolHTTP, ProtocolGRPC)
+	}
+}
@@ -0,0 +1,202 @@
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
+	tracingtest "github.com/prysmaticlabs/prysm/v5/monitoring/tracing/testing"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	octrace "go.opencensus.io/trace"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSetup_ExportsToCollector(t *testing.T) {
+	collector, err := tracingtest.NewCollector()
+	require.NoError(t, err)
+	defer collector.Close()
+
+	tests := []struct {
+		protocol string
+		endpoint string
+	}{
+		{protocol: tracing.ProtocolHTTP, endpoint: collector.HTTPEndpoint()},
+		{protocol: tracing.ProtocolGRPC, endpoint: collector.GRPCEndpoint()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.protocol, func(t *testing.T) {
+			require.NoError(t, tracing.Setup("beacon-chain", "node-0", tt.endpoint, tt.protocol, 1, true))
+			// Spans created with the OpenCensus API are exported as OpenTelemetry spans.
+			ctx, parent := octrace.StartSpan(context.Background(), "test."+tt.protocol)
+			_, child := octrace.StartSpan(ctx, "test."+tt.protocol+".child")
+			child.End()
+			parent.End()
+			require.NoError(t, tracing.Shutdown())
+
+			var spans int
+			for _, rs := range collector.ResourceSpans() {
+				var service string
+				for _, attr := range rs.Resource.Attributes {
+					if attr.Key == "service.name" {
+						service = attr.Value.GetStringValue()
+					}
+				}
+				assert.Equal(t, "beacon-chain", service)
+				for _, ss := range rs.ScopeSpans {
+					for _, span := range ss.Spans {
+						if span.Name == "test."+tt.protocol+".child" {
+							spans++
+							assert.Equal(t, len(span.ParentSpanId) > 0, true, "Child span has no parent")
+						}
+						if span.Name == "test."+tt.protocol {
+							spans++
+						}
+					}
+				}
+			}
+			assert.Equal(t, 2, spans, "Spans were not exported")
+		})
+	}
+}
+
+func TestSetup_ExportOptions(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []tracingtest.Option
+		env       map[string]string
+		failures  int
+		wantSpans bool
+		check     func(t *testing.T, collector *tracingtest.Collector)
+	}{
+		{
+			name:      "retries failed exports",
+			failures:  2,
+			wantSpans: true,
+			check: func(t *testing.T, collector *tracingtest.Collector) {
+				assert.Equal(t, 3, collector.Exports())
+			},
+		},
+		{
+			name:      "headers",
+			env:       map[string]string{"OTEL_EXPORTER_OTLP_HEADERS": "authorization=Bearer%20secret, x-scope-orgid=prysm"},
+			wantSpans: true,
+			check: func(t *testing.T, collector *tracingtest.Collector) {
+				assert.Equal(t, "Bearer secret", collector.Header("authorization"))
+				assert.Equal(t, "prysm", collector.Header("x-scope-orgid"))
+			},
+		},
+		{
+			name: "trace headers override headers",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_HEADERS":        "authorization=foo",
+				"OTEL_EXPORTER_OTLP_TRACES_HEADERS": "authorization=bar",
+			},
+			wantSpans: true,
+			check: func(t *testing.T, collector *tracingtest.Collector) {
+				assert.Equal(t, "bar", collector.Header("authorization"))
+			},
+		},
+		{
+			name:      "gzip",
+			env:       map[string]string{"OTEL_EXPORTER_OTLP_COMPRESSION": "gzip"},
+			wantSpans: true,
+			check: func(t *testing.T, collector *tracingtest.Collector) {
+				assert.Equal(t, "gzip", collector.Compression())
+			},
+		},
+		{
+			name:      "TLS with the collector certificate",
+			opts:      []tracingtest.Option{tracingtest.WithTLS()},
+			env:       map[string]string{"OTEL_EXPORTER_OTLP_CERTIFICATE": "collector.pem"},
+			wantSpans: true,
+		},
+		{
+			name: "TLS with an unknown certificate",
+			opts: []tracingtest.Option{tracingtest.WithTLS()},
+		},
+	}
+	for _, tt := range tests {
+		for _, protocol := range []string{tracing.ProtocolHTTP, tracing.ProtocolGRPC} {
+			t.Run(tt.name+"/"+protocol, func(t *testing.T) {
+				collector, err := tracingtest.NewCollector(tt.opts...)
+				require.NoError(t, err)
+				defer collector.Close()
+				collector.FailExports(tt.failures)
+				for name, value := range tt.env {
+					if value == "collector.pem" {
+						value = filepath.Join(t.TempDir(), value)
+						require.NoError(t, os.WriteFile(value, collector.Certificate(), 0600))
+					}
+					t.Setenv(name, value)
+				}
+				endpoint := collector.HTTPEndpoint()
+				if protocol == tracing.ProtocolGRPC {
+					endpoint = collector.GRPCEndpoint()
+				}
+
+				require.NoError(t, tracing.Setup("beacon-chain", "", endpoint, protocol, 1, true))
+				_, span := octrace.StartSpan(context.Background(), "test.options")
+				span.End()
+				// Spans which cannot be exported are given up on when shutting down.
+				if err := tracing.Shutdown(); tt.wantSpans {
+					require.NoError(t, err)
+				}
+
+				assert.DeepEqual(t, tt.wantSpans, len(collector.SpanNames()) > 0)
+				if tt.check != nil {
+					tt.check(t, collector)
+				}
+			})
+		}
+	}
+}
+
+func TestSetup_Errors(t *testing.T) {
+	assert.ErrorContains(t, "unknown tracing protocol", tracing.Setup("beacon-chain", "", "http://127.0.0.1:4318", "thrift", 1, true))
+	assert.ErrorContains(t, "has no host", tracing.Setup("beacon-chain", "", "127.0.0.1", tracing.ProtocolHTTP, 1, true))
+	assert.ErrorContains(t, "service name cannot be empty", tracing.Setup("", "", "", tracing.ProtocolHTTP, 1, true))
+	require.NoError(t, tracing.Setup("", "", "", "", 1, false))
+	require.NoError(t, tracing.Shutdown())
+}
+
+func TestPropagation_HTTP(t *testing.T) {
+	collector, err := tracingtest.NewCollector()
+	require.NoError(t, err)
+	defer collector.Close()
+	require.NoError(t, tracing.Setup("validator", "", collector.HTTPEndpoint(), tracing.ProtocolHTTP, 1, true))
+
+	var received trace.SpanContext
+	r := mux.NewRouter()
+	r.HandleFunc("/eth/v1/validator/duties/attester/{epoch}", func(_ http.ResponseWriter, r *http.Request) {
+		received = trace.SpanContextFromContext(r.Context())
+	})
+	r.Use(tracing.HttpMiddleware)
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	ctx, span := otel.Tracer("test").Start(context.Background(), "duty")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/eth/v1/validator/duties/attester/1", nil)
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: tracing.NewTransport(nil)}).Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	span.End()
+	require.NoError(t, tracing.Shutdown())
+
+	require.Equal(t, true, received.IsValid(), "Trace context was not propagated")
+	assert.Equal(t, span.SpanContext().TraceID(), received.TraceID(), "Trace context was not propagated")
+	// The duty, the client request and the server request are all part of the same trace.
+	var names []string
+	for _, s := range collector.Spans() {
+		assert.DeepEqual(t, span.SpanContext().TraceID(), trace.TraceID(s.TraceId))
+		names = append(names, s.Name)
+	}
+	assert.DeepEqual(t, []string{"POST /eth/v1/validator/duties/attester/{epoch}", "HTTP POST", "duty"}, names)
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
 	"github.com/prysmaticlabs/prysm/v5/network/authorization"
 	log "github.com/sirupsen/logrus"
 )
@@ -134,7 +135,10 @@ func NewExecutionRPCClient(ctx context.Context, endpoint Endpoint, headers http.
 	}
 	switch u.Scheme {
 	case "http", "https":
-		client, err = gethRPC.DialOptions(ctx, endpoint.Url, gethRPC.WithHTTPClient(endpoint.HttpClient()), gethRPC.WithHeaders(headers))
+		// The client may be shared, so the traced transport is set on a copy.
+		httpClient := *endpoint.HttpClient()
+		httpClient.Transport = tracing.NewTransport(httpClient.Transport)
+		client, err = gethRPC.DialOptions(ctx, endpoint.Url, gethRPC.WithHTTPClient(&httpClient), gethRPC.WithHeaders(headers))
 		if err != nil {
 			return nil, err
 		}
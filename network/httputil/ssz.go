@@ -0,0 +1,166 @@
+package httputil
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// bytesPerOffset is the size of the offsets that precede the elements of an SSZ list of variable size elements.
+const bytesPerOffset = 4
+
+// SszMarshaler is implemented by objects that have an SSZ encoding.
+type SszMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+// SszUnmarshaler is implemented by objects that can be decoded from their SSZ encoding.
+type SszUnmarshaler interface {
+	UnmarshalSSZ(buf []byte) error
+}
+
+// WriteResponse writes the response in SSZ format if the request prefers SSZ, and in JSON format otherwise.
+// Handlers of endpoints that return consensus objects should write their response with WriteResponse, so that the
+// encoding is negotiated the same way for all of them. sszResp is only encoded if it is used, and a nil sszResp means
+// the response has no SSZ encoding, in which case the response is always written in JSON format.
+func WriteResponse(w http.ResponseWriter, r *http.Request, jsonResp any, sszResp SszMarshaler, fileName string) {
+	if sszResp == nil || !RespondWithSsz(r) {
+		WriteJson(w, jsonResp)
+		return
+	}
+	respSsz, err := sszResp.MarshalSSZ()
+	if err != nil {
+		HandleError(w, "Could not marshal response into SSZ: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	WriteSsz(w, respSsz, fileName)
+}
+
+// ReadSszRequest decodes the SSZ encoded request body into obj. If the body can't be decoded, it writes an error
+// response and returns false.
+func ReadSszRequest(w http.ResponseWriter, r *http.Request, obj SszUnmarshaler) bool {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		HandleError(w, "Could not read request body: "+err.Error(), http.StatusInternalServerError)
+		return false
+	}
+	if len(body) == 0 {
+		HandleError(w, "No data submitted", http.StatusBadRequest)
+		return false
+	}
+	if err = obj.UnmarshalSSZ(body); err != nil {
+		HandleError(w, "Could not decode request body into SSZ: "+err.Error(), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+// ReadSszListRequest decodes the SSZ encoded request body into a list of objects, as UnmarshalSszList does. If the body
+// can't be decoded or the list is empty, it writes an error response and returns false.
+func ReadSszListRequest[T SszUnmarshaler](w http.ResponseWriter, r *http.Request, itemSize int, newItem func() T) ([]T, bool) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		HandleError(w, "Could not read request body: "+err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	items, err := UnmarshalSszList(body, itemSize, newItem)
+	if err != nil {
+		HandleError(w, "Could not decode request body into SSZ: "+err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+	if len(items) == 0 {
+		HandleError(w, "No data submitted", http.StatusBadRequest)
+		return nil, false
+	}
+	return items, true
+}
+
+type sszList[T SszMarshaler] struct {
+	items        []T
+	variableSize bool
+}
+
+// SszList returns the SSZ encoding of a list of objects. variableSize must be true if the SSZ encoding of the objects
+// does not have a fixed size, in which case the encoded objects are preceded by their offsets.
+func SszList[T SszMarshaler](items []T, variableSize bool) SszMarshaler {
+	return &sszList[T]{items: items, variableSize: variableSize}
+}
+
+// MarshalSSZ returns the SSZ encoding of the list.
+func (l *sszList[T]) MarshalSSZ() ([]byte, error) {
+	encoded := make([][]byte, len(l.items))
+	size := 0
+	for i, item := range l.items {
+		b, err := item.MarshalSSZ()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not marshal list item %d", i)
+		}
+		encoded[i] = b
+		size += len(b)
+	}
+	if !l.variableSize {
+		buf := make([]byte, 0, size)
+		for _, b := range encoded {
+			buf = append(buf, b...)
+		}
+		return buf, nil
+	}
+	buf := make([]byte, 0, size+len(encoded)*bytesPerOffset)
+	offset := len(encoded) * bytesPerOffset
+	for _, b := range encoded {
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(offset))
+		offset += len(b)
+	}
+	for _, b := range encoded {
+		buf = append(buf, b...)
+	}
+	return buf, nil
+}
+
+// UnmarshalSszList decodes an SSZ encoded list of objects, creating each object with newItem. itemSize is the size of
+// the SSZ encoding of the objects, or 0 if it is not fixed.
+func UnmarshalSszList[T SszUnmarshaler](buf []byte, itemSize int, newItem func() T) ([]T, error) {
+	if itemSize > 0 {
+		if len(buf)%itemSize != 0 {
+			return nil, fmt.Errorf("list size %d is not a multiple of the item size %d", len(buf), itemSize)
+		}
+		items := make([]T, len(buf)/itemSize)
+		for i := range items {
+			items[i] = newItem()
+			if err := items[i].UnmarshalSSZ(buf[i*itemSize : (i+1)*itemSize]); err != nil {
+				return nil, errors.Wrapf(err, "could not unmarshal list item %d", i)
+			}
+		}
+		return items, nil
+	}
+
+	if len(buf) == 0 {
+		return []T{}, nil
+	}
+	if len(buf) < bytesPerOffset {
+		return nil, fmt.Errorf("list size %d is smaller than an offset", len(buf))
+	}
+	firstOffset := int(binary.LittleEndian.Uint32(buf))
+	if firstOffset == 0 || firstOffset%bytesPerOffset != 0 || firstOffset > len(buf) {
+		return nil, fmt.Errorf("invalid first offset %d", firstOffset)
+	}
+	items := make([]T, firstOffset/bytesPerOffset)
+	for i := range items {
+		start := int(binary.LittleEndian.Uint32(buf[i*bytesPerOffset:]))
+		end := len(buf)
+		if i+1 < len(items) {
+			end = int(binary.LittleEndian.Uint32(buf[(i+1)*bytesPerOffset:]))
+		}
+		if start < firstOffset || start > end || end > len(buf) {
+			return nil, fmt.Errorf("invalid offsets %d and %d for list item %d", start, end, i)
+		}
+		items[i] = newItem()
+		if err := items[i].UnmarshalSSZ(buf[start:end]); err != nil {
+			return nil, errors.Wrapf(err, "could not unmarshal list item %d", i)
+		}
+	}
+	return items, nil
+}
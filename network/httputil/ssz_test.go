@@ -0,0 +1,95 @@
+package httputil
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestWriteResponse(t *testing.T) {
+	checkpoint := &ethpb.Checkpoint{Epoch: 1, Root: bytes.Repeat([]byte{1}, 32)}
+	sszCheckpoint, err := checkpoint.MarshalSSZ()
+	require.NoError(t, err)
+
+	t.Run("json", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		w := httptest.NewRecorder()
+		WriteResponse(w, r, map[string]string{"epoch": "1"}, checkpoint, "checkpoint.ssz")
+		assert.Equal(t, api.JsonMediaType, w.Header().Get("Content-Type"))
+		assert.Equal(t, "{\"epoch\":\"1\"}\n", w.Body.String())
+	})
+	t.Run("ssz", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		r.Header.Set("Accept", api.OctetStreamMediaType)
+		w := httptest.NewRecorder()
+		WriteResponse(w, r, map[string]string{"epoch": "1"}, checkpoint, "checkpoint.ssz")
+		assert.Equal(t, api.OctetStreamMediaType, w.Header().Get("Content-Type"))
+		assert.DeepEqual(t, sszCheckpoint, w.Body.Bytes())
+	})
+	t.Run("no ssz encoding", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		r.Header.Set("Accept", api.OctetStreamMediaType)
+		w := httptest.NewRecorder()
+		WriteResponse(w, r, map[string]string{"epoch": "1"}, nil, "checkpoint.ssz")
+		assert.Equal(t, api.JsonMediaType, w.Header().Get("Content-Type"))
+	})
+}
+
+func TestSszList(t *testing.T) {
+	t.Run("fixed size", func(t *testing.T) {
+		checkpoints := []*ethpb.Checkpoint{
+			{Epoch: 1, Root: bytes.Repeat([]byte{1}, 32)},
+			{Epoch: 2, Root: bytes.Repeat([]byte{2}, 32)},
+		}
+		b, err := SszList(checkpoints, false).MarshalSSZ()
+		require.NoError(t, err)
+		assert.Equal(t, 2*checkpoints[0].SizeSSZ(), len(b))
+
+		decoded, err := UnmarshalSszList(b, checkpoints[0].SizeSSZ(), func() *ethpb.Checkpoint { return &ethpb.Checkpoint{} })
+		require.NoError(t, err)
+		assert.DeepEqual(t, checkpoints, decoded)
+
+		_, err = UnmarshalSszList(b[1:], checkpoints[0].SizeSSZ(), func() *ethpb.Checkpoint { return &ethpb.Checkpoint{} })
+		assert.ErrorContains(t, "is not a multiple of the item size", err)
+	})
+	t.Run("variable size", func(t *testing.T) {
+		attestations := []*ethpb.Attestation{newAttestation(1, 3), newAttestation(2, 10)}
+		b, err := SszList(attestations, true).MarshalSSZ()
+		require.NoError(t, err)
+		assert.Equal(t, attestations[0].SizeSSZ()+attestations[1].SizeSSZ()+2*bytesPerOffset, len(b))
+
+		decoded, err := UnmarshalSszList(b, 0, func() *ethpb.Attestation { return &ethpb.Attestation{} })
+		require.NoError(t, err)
+		assert.DeepEqual(t, attestations, decoded)
+
+		_, err = UnmarshalSszList([]byte{1, 0, 0, 0}, 0, func() *ethpb.Attestation { return &ethpb.Attestation{} })
+		assert.ErrorContains(t, "invalid first offset", err)
+	})
+	t.Run("empty", func(t *testing.T) {
+		b, err := SszList([]*ethpb.Attestation{}, true).MarshalSSZ()
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(b))
+		decoded, err := UnmarshalSszList(b, 0, func() *ethpb.Attestation { return &ethpb.Attestation{} })
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(decoded))
+	})
+}
+
+func newAttestation(slot uint64, bitsLen int) *ethpb.Attestation {
+	return &ethpb.Attestation{
+		AggregationBits: bytes.Repeat([]byte{1}, bitsLen),
+		Data: &ethpb.AttestationData{
+			Slot:            1,
+			BeaconBlockRoot: bytes.Repeat([]byte{byte(slot)}, 32),
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		},
+		Signature: make([]byte, 96),
+	}
+}
@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 6fed60156f1e57926b40b972ee25b48a82b18726a9c64fb0e974e4f638784049
+// Hash: 145d7a0855888360ef8d63dbfd8eb9c40a1ef9bfba7d08fb355134a8f61d5358
 package v1
 
 import (
@@ -2571,3 +2571,287 @@ func (v *Validator) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	}
 	return
 }
+
+// MarshalSSZ ssz marshals the ValidatorContainer object
+func (v *ValidatorContainer) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the ValidatorContainer object to a target array
+func (v *ValidatorContainer) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalUint64(dst, uint64(v.Index))
+
+	// Field (1) 'Balance'
+	dst = ssz.MarshalUint64(dst, v.Balance)
+
+	// Field (2) 'Status'
+	dst = ssz.MarshalUint64(dst, v.Status)
+
+	// Field (3) 'Validator'
+	if v.Validator == nil {
+		v.Validator = new(Validator)
+	}
+	if dst, err = v.Validator.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ValidatorContainer object
+func (v *ValidatorContainer) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 145 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Index'
+	v.Index = github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Balance'
+	v.Balance = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'Status'
+	v.Status = ssz.UnmarshallUint64(buf[16:24])
+
+	// Field (3) 'Validator'
+	if v.Validator == nil {
+		v.Validator = new(Validator)
+	}
+	if err = v.Validator.UnmarshalSSZ(buf[24:145]); err != nil {
+		return err
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ValidatorContainer object
+func (v *ValidatorContainer) SizeSSZ() (size int) {
+	size = 145
+	return
+}
+
+// HashTreeRoot ssz hashes the ValidatorContainer object
+func (v *ValidatorContainer) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the ValidatorContainer object with a hasher
+func (v *ValidatorContainer) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(uint64(v.Index))
+
+	// Field (1) 'Balance'
+	hh.PutUint64(v.Balance)
+
+	// Field (2) 'Status'
+	hh.PutUint64(v.Status)
+
+	// Field (3) 'Validator'
+	if err = v.Validator.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the ValidatorBalance object
+func (v *ValidatorBalance) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the ValidatorBalance object to a target array
+func (v *ValidatorBalance) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalUint64(dst, uint64(v.Index))
+
+	// Field (1) 'Balance'
+	dst = ssz.MarshalUint64(dst, v.Balance)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ValidatorBalance object
+func (v *ValidatorBalance) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Index'
+	v.Index = github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.ValidatorIndex(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Balance'
+	v.Balance = ssz.UnmarshallUint64(buf[8:16])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ValidatorBalance object
+func (v *ValidatorBalance) SizeSSZ() (size int) {
+	size = 16
+	return
+}
+
+// HashTreeRoot ssz hashes the ValidatorBalance object
+func (v *ValidatorBalance) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the ValidatorBalance object with a hasher
+func (v *ValidatorBalance) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(uint64(v.Index))
+
+	// Field (1) 'Balance'
+	hh.PutUint64(v.Balance)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the Committee object
+func (c *Committee) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the Committee object to a target array
+func (c *Committee) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(20)
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalUint64(dst, uint64(c.Index))
+
+	// Field (1) 'Slot'
+	dst = ssz.MarshalUint64(dst, uint64(c.Slot))
+
+	// Offset (2) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Validators) * 8
+
+	// Field (2) 'Validators'
+	if size := len(c.Validators); size > 2048 {
+		err = ssz.ErrListTooBigFn("--.Validators", size, 2048)
+		return
+	}
+	for ii := 0; ii < len(c.Validators); ii++ {
+		dst = ssz.MarshalUint64(dst, c.Validators[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Committee object
+func (c *Committee) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 20 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o2 uint64
+
+	// Field (0) 'Index'
+	c.Index = github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.CommitteeIndex(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Slot'
+	c.Slot = github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[8:16]))
+
+	// Offset (2) 'Validators'
+	if o2 = ssz.ReadOffset(buf[16:20]); o2 > size {
+		return ssz.ErrOffset
+	}
+
+	if o2 < 20 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (2) 'Validators'
+	{
+		buf = tail[o2:]
+		num, err := ssz.DivideInt2(len(buf), 8, 2048)
+		if err != nil {
+			return err
+		}
+		c.Validators = ssz.ExtendUint64(c.Validators, num)
+		for ii := 0; ii < num; ii++ {
+			c.Validators[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Committee object
+func (c *Committee) SizeSSZ() (size int) {
+	size = 20
+
+	// Field (2) 'Validators'
+	size += len(c.Validators) * 8
+
+	return
+}
+
+// HashTreeRoot ssz hashes the Committee object
+func (c *Committee) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the Committee object with a hasher
+func (c *Committee) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(uint64(c.Index))
+
+	// Field (1) 'Slot'
+	hh.PutUint64(uint64(c.Slot))
+
+	// Field (2) 'Validators'
+	{
+		if size := len(c.Validators); size > 2048 {
+			err = ssz.ErrListTooBigFn("--.Validators", size, 2048)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range c.Validators {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(c.Validators))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, ssz.CalculateLimit(2048, numItems, 8))
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(2048, numItems, 8))
+		}
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
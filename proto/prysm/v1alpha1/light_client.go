@@ -0,0 +1,243 @@
+package eth
+
+import (
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"google.golang.org/protobuf/proto"
+)
+
+type LightClientHeader interface {
+	proto.Message
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+	Version() int
+	GetBeacon() *BeaconBlockHeader
+}
+
+type LightClientBootstrap interface {
+	proto.Message
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+	Version() int
+	GetHeaderVal() LightClientHeader
+	GetCurrentSyncCommittee() *SyncCommittee
+	GetCurrentSyncCommitteeBranch() [][]byte
+}
+
+type LightClientUpdate interface {
+	proto.Message
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+	Version() int
+	GetAttestedHeaderVal() LightClientHeader
+	GetNextSyncCommittee() *SyncCommittee
+	GetNextSyncCommitteeBranch() [][]byte
+	GetFinalizedHeaderVal() LightClientHeader
+	GetFinalityBranch() [][]byte
+	GetSyncAggregate() *SyncAggregate
+	GetSignatureSlot() primitives.Slot
+}
+
+type LightClientFinalityUpdate interface {
+	proto.Message
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+	Version() int
+	GetAttestedHeaderVal() LightClientHeader
+	GetFinalizedHeaderVal() LightClientHeader
+	GetFinalityBranch() [][]byte
+	GetSyncAggregate() *SyncAggregate
+	GetSignatureSlot() primitives.Slot
+}
+
+type LightClientOptimisticUpdate interface {
+	proto.Message
+	ssz.Marshaler
+	ssz.Unmarshaler
+	ssz.HashRoot
+	Version() int
+	GetAttestedHeaderVal() LightClientHeader
+	GetSyncAggregate() *SyncAggregate
+	GetSignatureSlot() primitives.Slot
+}
+
+func (h *LightClientHeaderAltair) Version() int {
+	return version.Altair
+}
+
+func (h *LightClientHeaderCapella) Version() int {
+	return version.Capella
+}
+
+func (h *LightClientHeaderDeneb) Version() int {
+	return version.Deneb
+}
+
+func (h *LightClientHeaderElectra) Version() int {
+	return version.Electra
+}
+
+func (b *LightClientBootstrapAltair) Version() int {
+	return version.Altair
+}
+
+func (b *LightClientBootstrapAltair) GetHeaderVal() LightClientHeader {
+	return b.Header
+}
+
+func (b *LightClientBootstrapCapella) Version() int {
+	return version.Capella
+}
+
+func (b *LightClientBootstrapCapella) GetHeaderVal() LightClientHeader {
+	return b.Header
+}
+
+func (b *LightClientBootstrapDeneb) Version() int {
+	return version.Deneb
+}
+
+func (b *LightClientBootstrapDeneb) GetHeaderVal() LightClientHeader {
+	return b.Header
+}
+
+func (b *LightClientBootstrapElectra) Version() int {
+	return version.Electra
+}
+
+func (b *LightClientBootstrapElectra) GetHeaderVal() LightClientHeader {
+	return b.Header
+}
+
+func (u *LightClientUpdateAltair) Version() int {
+	return version.Altair
+}
+
+func (u *LightClientUpdateAltair) GetAttestedHeaderVal() LightClientHeader {
+	return u.AttestedHeader
+}
+
+func (u *LightClientUpdateAltair) GetFinalizedHeaderVal() LightClientHeader {
+	return u.FinalizedHeader
+}
+
+func (u *LightClientUpdateCapella) Version() int {
+	return version.Capella
+}
+
+func (u *LightClientUpdateCapella) GetAttestedHeaderVal() LightClientHeader {
+	return u.AttestedHeader
+}
+
+func (u *LightClientUpdateCapella) GetFinalizedHeaderVal() LightClientHeader {
+	return u.FinalizedHeader
+}
+
+func (u *LightClientUpdateDeneb) Version() int {
+	return version.Deneb
+}
+
+func (u *LightClientUpdateDeneb) GetAttestedHeaderVal() LightClientHeader {
+	return u.AttestedHeader
+}
+
+func (u *LightClientUpdateDeneb) GetFinalizedHeaderVal() LightClientHeader {
+	return u.FinalizedHeader
+}
+
+func (u *LightClientUpdateElectra) Version() int {
+	return version.Electra
+}
+
+func (u *LightClientUpdateElectra) GetAttestedHeaderVal() LightClientHeader {
+	return u.AttestedHeader
+}
+
+func (u *LightClientUpdateElectra) GetFinalizedHeaderVal() LightClientHeader {
+	return u.FinalizedHeader
+}
+
+func (u *LightClientFinalityUpdateAltair) Version() int {
+	return version.Altair
+}
+
+func (u *LightClientFinalityUpdateAltair) GetAttestedHeaderVal() LightClientHeader {
+	return u.AttestedHeader
+}
+
+func (u *LightClientFinalityUpdateAltair) GetFinalizedHeaderVal() LightClientHeader {
+	return u.FinalizedHeader
+}
+
+func (u *LightClientFinalityUpdateCapella) Version() int {
+	return version.Capella
+}
+
+func (u *LightClientFinalityUpdateCapella) GetAttestedHeaderVal() LightClientHeader {
+	return u.AttestedHeader
+}
+
+func (u *LightClientFinalityUpdateCapella) GetFinalizedHeaderVal() LightClientHeader {
+	return u.FinalizedHeader
+}
+
+func (u *LightClientFinalityUpdateDeneb) Version() int {
+	return version.Deneb
+}
+
+func (u *LightClientFinalityUpdateDeneb) GetAttestedHeaderVal() LightClientHeader {
+	return u.AttestedHeader
+}
+
+func (u *LightClientFinalityUpdateDeneb) GetFinalizedHeaderVal() LightClientHeader {
+	return u.FinalizedHeader
+}
+
+func (u *LightClientFinalityUpdateElectra) Version() int {
+	return version.Electra
+}
+
+func (u *LightClientFinalityUpdateElectra) GetAttestedHeaderVal() LightClientHeader {
+	return u.AttestedHeader
+}
+
+func (u *LightClientFinalityUpdateElectra) GetFinalizedHeaderVal() LightClientHeader {
+	return u.FinalizedHeader
+}
+
+func (u *LightClientOptimisticUpdateAltair) Version() int {
+	return version.Altair
+}
+
+func (u *LightClientOptimisticUpdateAltair) GetAttestedHeaderVal() LightClientHeader {
+	return u.AttestedHeader
+}
+
+func (u *LightClientOptimisticUpdateCapella) Version() int {
+	return version.Capella
+}
+
+func (u *LightClientOptimisticUpdateCapella) GetAttestedHeaderVal() LightClientHeader {
+	return u.AttestedHeader
+}
+
+func (u *LightClientOptimisticUpdateDeneb) Version() int {
+	return version.Deneb
+}
+
+func (u *LightClientOptimisticUpdateDeneb) GetAttestedHeaderVal() LightClientHeader {
+	return u.AttestedHeader
+}
+
+func (u *LightClientOptimisticUpdateElectra) Version() int {
+	return version.Electra
+}
+
+func (u *LightClientOptimisticUpdateElectra) GetAttestedHeaderVal() LightClientHeader {
+	return u.AttestedHeader
+}
@@ -22,6 +22,8 @@ import (
 	gethRPC "github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/trie"
 	gMux "github.com/gorilla/mux"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	builderAPI "github.com/prysmaticlabs/prysm/v5/api/client/builder"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
@@ -34,6 +36,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/network"
 	"github.com/prysmaticlabs/prysm/v5/network/authorization"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/sirupsen/logrus"
@@ -283,7 +286,44 @@ func (*Builder) isBuilderCall(req *http.Request) bool {
 	return strings.Contains(req.URL.Path, "/eth/v1/builder/")
 }
 
+// respondWithSsz reports whether the response to the request should be SSZ encoded.
+func (p *Builder) respondWithSsz(req *http.Request) bool {
+	return !p.cfg.sszDisabled && httputil.RespondWithSsz(req)
+}
+
+// rejectSszRequest responds with 415 to SSZ encoded requests when SSZ support is disabled, the way a relay
+// that only speaks JSON does. It returns true if the request was rejected.
+func (p *Builder) rejectSszRequest(w http.ResponseWriter, req *http.Request) bool {
+	if !p.cfg.sszDisabled || !httputil.IsRequestSsz(req) {
+		return false
+	}
+	http.Error(w, "ssz request bodies are not supported", http.StatusUnsupportedMediaType)
+	return true
+}
+
+// writeSsz writes an SSZ encoded response along with the fork version it was encoded for.
+func (p *Builder) writeSsz(w http.ResponseWriter, obj ssz.Marshaler, ver string) {
+	b, err := obj.MarshalSSZ()
+	if err != nil {
+		p.cfg.logger.WithError(err).Error("Could not marshal response")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(api.VersionHeader, ver)
+	w.Header().Set("Content-Type", api.OctetStreamMediaType)
+	if _, err := w.Write(b); err != nil {
+		p.cfg.logger.WithError(err).Error("Could not write response")
+	}
+}
+
 func (p *Builder) registerValidators(w http.ResponseWriter, req *http.Request) {
+	if p.rejectSszRequest(w, req) {
+		return
+	}
+	if httputil.IsRequestSsz(req) {
+		p.registerValidatorsSsz(w, req)
+		return
+	}
 	var registrations []structs.SignedValidatorRegistration
 	if err := json.NewDecoder(req.Body).Decode(&registrations); err != nil {
 		http.Error(w, "invalid request", http.StatusBadRequest)
@@ -303,6 +343,31 @@ func (p *Builder) registerValidators(w http.ResponseWriter, req *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+func (p *Builder) registerValidatorsSsz(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+	size := (&eth.SignedValidatorRegistrationV1{}).SizeSSZ()
+	if len(body) == 0 || len(body)%size != 0 {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+	for i := 0; i < len(body); i += size {
+		r := &eth.SignedValidatorRegistrationV1{}
+		if err := r.UnmarshalSSZ(body[i : i+size]); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		p.valLock.Lock()
+		p.validatorMap[hexutil.Encode(r.Message.Pubkey)] = r.Message
+		p.valLock.Unlock()
+	}
+	// TODO: Verify Signatures from validators
+	w.WriteHeader(http.StatusOK)
+}
+
 func (p *Builder) handleHeaderRequest(w http.ResponseWriter, req *http.Request) {
 	urlParams := gMux.Vars(req)
 	pHash := urlParams["parent_hash"]
@@ -323,12 +388,12 @@ func (p *Builder) handleHeaderRequest(w http.ResponseWriter, req *http.Request)
 	ax := types.Slot(slot)
 	currEpoch := types.Epoch(ax / params.BeaconConfig().SlotsPerEpoch)
 	if currEpoch >= params.BeaconConfig().DenebForkEpoch {
-		p.handleHeaderRequestDeneb(w)
+		p.handleHeaderRequestDeneb(w, req)
 		return
 	}
 
 	if currEpoch >= params.BeaconConfig().CapellaForkEpoch {
-		p.handleHeaderRequestCapella(w)
+		p.handleHeaderRequestCapella(w, req)
 		return
 	}
 
@@ -385,6 +450,11 @@ func (p *Builder) handleHeaderRequest(w http.ResponseWriter, req *http.Request)
 		return
 	}
 	sig := secKey.Sign(rt[:])
+	if p.respondWithSsz(req) {
+		p.writeSsz(w, &eth.SignedBuilderBid{Message: sszBid, Signature: sig.Marshal()}, "bellatrix")
+		p.currPayload = wObj
+		return
+	}
 	hdrResp := &builderAPI.ExecHeaderResponse{
 		Version: "bellatrix",
 		Data: struct {
@@ -406,7 +476,7 @@ func (p *Builder) handleHeaderRequest(w http.ResponseWriter, req *http.Request)
 	w.WriteHeader(http.StatusOK)
 }
 
-func (p *Builder) handleHeaderRequestCapella(w http.ResponseWriter) {
+func (p *Builder) handleHeaderRequestCapella(w http.ResponseWriter, req *http.Request) {
 	b, err := p.retrievePendingBlockCapella()
 	if err != nil {
 		p.cfg.logger.WithError(err).Error("Could not retrieve pending block")
@@ -466,6 +536,11 @@ func (p *Builder) handleHeaderRequestCapella(w http.ResponseWriter) {
 		return
 	}
 	sig := secKey.Sign(rt[:])
+	if p.respondWithSsz(req) {
+		p.writeSsz(w, &eth.SignedBuilderBidCapella{Message: sszBid, Signature: sig.Marshal()}, "capella")
+		p.currPayload = wObj
+		return
+	}
 	hdrResp := &ExecHeaderResponseCapella{
 		Version: "capella",
 		Data: struct {
@@ -487,7 +562,7 @@ func (p *Builder) handleHeaderRequestCapella(w http.ResponseWriter) {
 	w.WriteHeader(http.StatusOK)
 }
 
-func (p *Builder) handleHeaderRequestDeneb(w http.ResponseWriter) {
+func (p *Builder) handleHeaderRequestDeneb(w http.ResponseWriter, req *http.Request) {
 	b, err := p.retrievePendingBlockDeneb()
 	if err != nil {
 		p.cfg.logger.WithError(err).Error("Could not retrieve pending block")
@@ -555,6 +630,12 @@ func (p *Builder) handleHeaderRequestDeneb(w http.ResponseWriter) {
 		return
 	}
 	sig := secKey.Sign(rt[:])
+	if p.respondWithSsz(req) {
+		p.writeSsz(w, &eth.SignedBuilderBidDeneb{Message: sszBid, Signature: sig.Marshal()}, "deneb")
+		p.currPayload = wObj
+		p.blobBundle = b.BlobsBundle
+		return
+	}
 	hdrResp := &ExecHeaderResponseDeneb{
 		Version: "deneb",
 		Data: struct {
@@ -578,19 +659,29 @@ func (p *Builder) handleHeaderRequestDeneb(w http.ResponseWriter) {
 }
 
 func (p *Builder) handleBlindedBlock(w http.ResponseWriter, req *http.Request) {
-	sb := &builderAPI.SignedBlindedBeaconBlockBellatrix{
-		SignedBlindedBeaconBlockBellatrix: &eth.SignedBlindedBeaconBlockBellatrix{},
+	if p.rejectSszRequest(w, req) {
+		return
 	}
-	err := json.NewDecoder(req.Body).Decode(sb)
-	if err != nil {
-		p.cfg.logger.WithError(err).Error("Could not decode blinded block")
-		// TODO: Allow the method to unmarshal blinded blocks correctly
+	// The submitted block is not inspected, the cached payload is returned instead.
+	if !httputil.IsRequestSsz(req) {
+		sb := &builderAPI.SignedBlindedBeaconBlockBellatrix{
+			SignedBlindedBeaconBlockBellatrix: &eth.SignedBlindedBeaconBlockBellatrix{},
+		}
+		err := json.NewDecoder(req.Body).Decode(sb)
+		if err != nil {
+			p.cfg.logger.WithError(err).Error("Could not decode blinded block")
+			// TODO: Allow the method to unmarshal blinded blocks correctly
+		}
 	}
 	if p.currPayload == nil {
 		p.cfg.logger.Error("No payload is cached")
 		http.Error(w, "payload not found", http.StatusInternalServerError)
 		return
 	}
+	if p.respondWithSsz(req) {
+		p.writePayloadSsz(w)
+		return
+	}
 
 	resp, err := builderAPI.ExecutionPayloadResponseFromData(p.currPayload, p.blobBundle)
 	if err != nil {
@@ -607,6 +698,20 @@ func (p *Builder) handleBlindedBlock(w http.ResponseWriter, req *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+func (p *Builder) writePayloadSsz(w http.ResponseWriter) {
+	switch pb := p.currPayload.Proto().(type) {
+	case *v1.ExecutionPayload:
+		p.writeSsz(w, pb, "bellatrix")
+	case *v1.ExecutionPayloadCapella:
+		p.writeSsz(w, pb, "capella")
+	case *v1.ExecutionPayloadDeneb:
+		p.writeSsz(w, &v1.ExecutionPayloadDenebAndBlobsBundle{Payload: pb, BlobsBundle: p.blobBundle}, "deneb")
+	default:
+		p.cfg.logger.Errorf("Unsupported payload type %T", pb)
+		http.Error(w, "unsupported payload type", http.StatusInternalServerError)
+	}
+}
+
 func (p *Builder) retrievePendingBlock() (*v1.ExecutionPayload, error) {
 	result := &engine.ExecutableData{}
 	if p.currId == nil {
@@ -14,6 +14,7 @@ type config struct {
 	destinationUrl *url.URL
 	logger         *logrus.Logger
 	secret         string
+	sszDisabled    bool
 }
 
 type Option func(p *Builder) error
@@ -58,6 +59,15 @@ func WithJwtSecret(secret string) Option {
 	}
 }
 
+// WithoutSSZ makes the builder reject SSZ encoded requests with a 415 and only respond with JSON,
+// like a relay that doesn't support SSZ.
+func WithoutSSZ() Option {
+	return func(p *Builder) error {
+		p.cfg.sszDisabled = true
+		return nil
+	}
+}
+
 // WithLogger sets a custom logger for the proxy.
 func WithLogger(l *logrus.Logger) Option {
 	return func(p *Builder) error {
@@ -0,0 +1,15 @@
+package light_client
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/spectest/shared/deneb/light_client"
+)
+
+func TestMainnet_Deneb_LightClient_SingleMerkleProof(t *testing.T) {
+	light_client.RunLightClientSingleMerkleProofTests(t, "mainnet")
+}
+
+func TestMainnet_Deneb_LightClient_UpdateRanking(t *testing.T) {
+	light_client.RunLightClientUpdateRankingTests(t, "mainnet")
+}
@@ -0,0 +1,17 @@
+package light_client
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/spectest/shared/altair/ssz_static"
+	common "github.com/prysmaticlabs/prysm/v5/testing/spectest/shared/common/light_client"
+)
+
+func RunLightClientSingleMerkleProofTests(t *testing.T, config string) {
+	common.RunSingleMerkleProofTests(t, config, version.Altair, ssz_static.UnmarshalledSSZ)
+}
+
+func RunLightClientUpdateRankingTests(t *testing.T, config string) {
+	common.RunUpdateRankingTests(t, config, version.Altair)
+}
@@ -14,7 +14,7 @@ import (
 
 // RunSSZStaticTests executes "ssz_static" tests.
 func RunSSZStaticTests(t *testing.T, config string) {
-	common.RunSSZStaticTests(t, config, "altair", unmarshalledSSZ, customHtr)
+	common.RunSSZStaticTests(t, config, "altair", UnmarshalledSSZ, customHtr)
 }
 
 func customHtr(t *testing.T, htrs []common.HTR, object interface{}) []common.HTR {
@@ -29,8 +29,8 @@ func customHtr(t *testing.T, htrs []common.HTR, object interface{}) []common.HTR
 	return htrs
 }
 
-// unmarshalledSSZ unmarshalls serialized input.
-func unmarshalledSSZ(t *testing.T, serializedBytes []byte, folderName string) (interface{}, error) {
+// UnmarshalledSSZ unmarshalls serialized input.
+func UnmarshalledSSZ(t *testing.T, serializedBytes []byte, folderName string) (interface{}, error) {
 	var obj interface{}
 	switch folderName {
 	case "Attestation":
@@ -131,6 +131,10 @@ func (m *engineMock) ExecutionBlockByHash(_ context.Context, hash common.Hash, _
 	}, nil
 }
 
+func (m *engineMock) ExecutionBlockReceipts(context.Context, common.Hash) ([]*gethtypes.Receipt, error) {
+	return nil, nil
+}
+
 func (m *engineMock) GetTerminalBlockHash(context.Context, uint64) ([]byte, bool, error) {
 	return nil, false, nil
 }
@@ -0,0 +1,56 @@
+package light_client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	state_native "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/spectest/shared/common/merkle_proof"
+	"github.com/prysmaticlabs/prysm/v5/testing/spectest/shared/common/ssz_static"
+)
+
+// RunSingleMerkleProofTests executes "light_client/single_merkle_proof" tests, checking the sync committee and
+// finality proofs of beacon states against those computed by the beacon state.
+func RunSingleMerkleProofTests(t *testing.T, config string, fork int, unmarshaller ssz_static.Unmarshaller) {
+	folderPath := "light_client/single_merkle_proof"
+	skipWithoutTests(t, config, fork, folderPath)
+	merkle_proof.RunSingleMerkleProofTests(t, config, version.String(fork), folderPath, unmarshaller, stateProof)
+}
+
+func stateProof(t *testing.T, object interface{}, caseName string, _ uint64) ([][]byte, bool) {
+	var st state.BeaconState
+	var err error
+	switch s := object.(type) {
+	case *ethpb.BeaconStateAltair:
+		st, err = state_native.InitializeFromProtoUnsafeAltair(s)
+	case *ethpb.BeaconStateBellatrix:
+		st, err = state_native.InitializeFromProtoUnsafeBellatrix(s)
+	case *ethpb.BeaconStateCapella:
+		st, err = state_native.InitializeFromProtoUnsafeCapella(s)
+	case *ethpb.BeaconStateDeneb:
+		st, err = state_native.InitializeFromProtoUnsafeDeneb(s)
+	case *ethpb.BeaconStateElectra:
+		st, err = state_native.InitializeFromProtoUnsafeElectra(s)
+	default:
+		return nil, false
+	}
+	require.NoError(t, err)
+
+	var proof [][]byte
+	switch caseName {
+	case "current_sync_committee_merkle_proof":
+		proof, err = st.CurrentSyncCommitteeProof(context.Background())
+	case "next_sync_committee_merkle_proof":
+		proof, err = st.NextSyncCommitteeProof(context.Background())
+	case "finality_root_merkle_proof":
+		proof, err = st.FinalizedRootProof(context.Background())
+	default:
+		return nil, false
+	}
+	require.NoError(t, err)
+	return proof, true
+}
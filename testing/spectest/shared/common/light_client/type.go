@@ -0,0 +1,6 @@
+package light_client
+
+// UpdateRankingMeta is the meta.yaml of "light_client/update_ranking" tests.
+type UpdateRankingMeta struct {
+	UpdatesCount int `json:"updates_count"`
+}
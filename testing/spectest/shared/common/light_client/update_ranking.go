@@ -0,0 +1,86 @@
+package light_client
+
+import (
+	"fmt"
+	"path"
+	"testing"
+
+	"github.com/bazelbuild/rules_go/go/tools/bazel"
+	"github.com/golang/snappy"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/spectest/utils"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+// RunUpdateRankingTests executes "light_client/update_ranking" tests, which list updates from the best to the worst.
+func RunUpdateRankingTests(t *testing.T, config string, fork int) {
+	require.NoError(t, utils.SetConfig(t, config))
+	folderPath := "light_client/update_ranking/pyspec_tests"
+	skipWithoutTests(t, config, fork, folderPath)
+	testFolders, testsFolderPath := utils.TestFolders(t, config, version.String(fork), folderPath)
+	if len(testFolders) == 0 {
+		t.Fatalf("No test folders found for %s/%s/%s", config, version.String(fork), folderPath)
+	}
+
+	for _, folder := range testFolders {
+		t.Run(folder.Name(), func(t *testing.T) {
+			metaFile, err := util.BazelFileBytes(testsFolderPath, folder.Name(), "meta.yaml")
+			require.NoError(t, err)
+			meta := &UpdateRankingMeta{}
+			require.NoError(t, utils.UnmarshalYaml(metaFile, meta))
+
+			updates := make([]ethpb.LightClientUpdate, meta.UpdatesCount)
+			for i := range updates {
+				updates[i] = readUpdate(t, path.Join(testsFolderPath, folder.Name()), fmt.Sprintf("updates_%d", i), fork)
+			}
+			for i := range updates {
+				for j := i + 1; j < len(updates); j++ {
+					require.Equal(t, false, blockchain.IsBetterUpdate(updates[j], updates[i]), "update %d ranks above update %d", j, i)
+				}
+			}
+		})
+	}
+}
+
+func readUpdate(t *testing.T, folder, name string, fork int) ethpb.LightClientUpdate {
+	file, err := util.BazelFileBytes(folder, name+".ssz_snappy")
+	require.NoError(t, err)
+	enc, err := snappy.Decode(nil /* dst */, file)
+	require.NoError(t, err)
+	u, err := unmarshalUpdate(fork, enc)
+	require.NoError(t, err)
+	return u
+}
+
+// unmarshalUpdate decodes an update of the given fork into the type of that fork.
+func unmarshalUpdate(fork int, enc []byte) (ethpb.LightClientUpdate, error) {
+	var u ethpb.LightClientUpdate
+	switch fork {
+	case version.Altair, version.Bellatrix:
+		u = &ethpb.LightClientUpdateAltair{}
+	case version.Capella:
+		u = &ethpb.LightClientUpdateCapella{}
+	case version.Deneb:
+		u = &ethpb.LightClientUpdateDeneb{}
+	case version.Electra:
+		u = &ethpb.LightClientUpdateElectra{}
+	default:
+		return nil, fmt.Errorf("fork %s has no light client update", version.String(fork))
+	}
+	if err := u.UnmarshalSSZ(enc); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// skipWithoutTests skips a test of which the pinned spec tests have no cases. The spec tests generate the update
+// ranking tests for the minimal preset only, and releases that predate the Electra light client have no light client
+// tests of Electra.
+func skipWithoutTests(t *testing.T, config string, fork int, folderPath string) {
+	if _, err := bazel.Runfile(path.Join("tests", config, version.String(fork), folderPath)); err != nil {
+		t.Skipf("The spec tests have no %s tests for %s/%s", folderPath, config, version.String(fork))
+	}
+}
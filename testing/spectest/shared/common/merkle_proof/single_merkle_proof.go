@@ -25,17 +25,23 @@ type SingleMerkleProof struct {
 	Branch    []string `json:"branch"`
 }
 
+// LocalProof returns the proof computed by Prysm for the leaf of the given generalized index of an object, in the
+// test case of the given name, or false if Prysm does not compute such proofs.
+type LocalProof func(t *testing.T, object interface{}, caseName string, index uint64) ([][]byte, bool)
+
 func RunMerkleProofTests(t *testing.T, config, forkOrPhase string, unmarshaller ssz_static.Unmarshaller) {
-	runSingleMerkleProofTests(t, config, forkOrPhase, unmarshaller)
+	RunSingleMerkleProofTests(t, config, forkOrPhase, "merkle_proof/single_merkle_proof", unmarshaller, kzgCommitmentProof)
 }
 
-func runSingleMerkleProofTests(t *testing.T, config, forkOrPhase string, unmarshaller ssz_static.Unmarshaller) {
+// RunSingleMerkleProofTests executes the "single_merkle_proof" tests of the given folder. The proofs of the tests
+// are checked against the roots of their objects, and against the proofs computed by localProof.
+func RunSingleMerkleProofTests(t *testing.T, config, forkOrPhase, folderPath string, unmarshaller ssz_static.Unmarshaller, localProof LocalProof) {
 	require.NoError(t, utils.SetConfig(t, config))
 
-	testFolders, basePath := utils.TestFolders(t, config, forkOrPhase, "merkle_proof/single_merkle_proof")
+	testFolders, basePath := utils.TestFolders(t, config, forkOrPhase, folderPath)
 
 	if len(testFolders) == 0 {
-		t.Fatalf("No test folders found for %s/%s/merkle_proof", config, forkOrPhase)
+		t.Fatalf("No test folders found for %s/%s/%s", config, forkOrPhase, folderPath)
 	}
 
 	for _, folder := range testFolders {
@@ -76,20 +82,32 @@ func runSingleMerkleProofTests(t *testing.T, config, forkOrPhase string, unmarsh
 
 				index := proof.LeafIndex
 				require.Equal(t, true, trie.VerifyMerkleProof(root[:], leaf, index, branch))
-				body, err := consensus_blocks.NewBeaconBlockBody(object)
-				if err != nil {
+				if localProof == nil {
 					return
 				}
-				if index < consensus_blocks.KZGOffset || index > consensus_blocks.KZGOffset+field_params.MaxBlobsPerBlock {
+				local, ok := localProof(t, object, modeFolder.Name(), index)
+				if !ok {
 					return
 				}
-				localProof, err := consensus_blocks.MerkleProofKZGCommitment(body, int(index-consensus_blocks.KZGOffset))
-				require.NoError(t, err)
-				require.Equal(t, len(branch), len(localProof))
-				for i, root := range localProof {
+				require.Equal(t, len(branch), len(local))
+				for i, root := range local {
 					require.DeepEqual(t, branch[i], root)
 				}
 			})
 		}
 	}
 }
+
+// kzgCommitmentProof computes the inclusion proofs of the KZG commitments of block bodies.
+func kzgCommitmentProof(t *testing.T, object interface{}, _ string, index uint64) ([][]byte, bool) {
+	body, err := consensus_blocks.NewBeaconBlockBody(object)
+	if err != nil {
+		return nil, false
+	}
+	if index < consensus_blocks.KZGOffset || index > consensus_blocks.KZGOffset+field_params.MaxBlobsPerBlock {
+		return nil, false
+	}
+	proof, err := consensus_blocks.MerkleProofKZGCommitment(body, int(index-consensus_blocks.KZGOffset))
+	require.NoError(t, err)
+	return proof, true
+}
@@ -0,0 +1,17 @@
+package light_client
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	common "github.com/prysmaticlabs/prysm/v5/testing/spectest/shared/common/light_client"
+	"github.com/prysmaticlabs/prysm/v5/testing/spectest/shared/electra/ssz_static"
+)
+
+func RunLightClientSingleMerkleProofTests(t *testing.T, config string) {
+	common.RunSingleMerkleProofTests(t, config, version.Electra, ssz_static.UnmarshalledSSZ)
+}
+
+func RunLightClientUpdateRankingTests(t *testing.T, config string) {
+	common.RunUpdateRankingTests(t, config, version.Electra)
+}
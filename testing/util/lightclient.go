@@ -0,0 +1,138 @@
+package util
+
+import (
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+// NewLightClientUpdateAltair creates a light client update with minimum marshalable fields.
+func NewLightClientUpdateAltair() *ethpb.LightClientUpdateAltair {
+	return &ethpb.LightClientUpdateAltair{
+		AttestedHeader:          &ethpb.LightClientHeaderAltair{Beacon: HydrateBeaconHeader(nil)},
+		NextSyncCommittee:       emptyLightClientSyncCommittee(),
+		NextSyncCommitteeBranch: emptyLightClientBranch(fieldparams.NextSyncCommitteeBranchDepth),
+		FinalizedHeader:         &ethpb.LightClientHeaderAltair{Beacon: HydrateBeaconHeader(nil)},
+		FinalityBranch:          emptyLightClientBranch(fieldparams.FinalityBranchDepth),
+		SyncAggregate:           emptyLightClientSyncAggregate(),
+	}
+}
+
+// NewLightClientUpdateCapella creates a light client update with minimum marshalable fields.
+func NewLightClientUpdateCapella() *ethpb.LightClientUpdateCapella {
+	return &ethpb.LightClientUpdateCapella{
+		AttestedHeader:          newLightClientHeaderCapella(),
+		NextSyncCommittee:       emptyLightClientSyncCommittee(),
+		NextSyncCommitteeBranch: emptyLightClientBranch(fieldparams.NextSyncCommitteeBranchDepth),
+		FinalizedHeader:         newLightClientHeaderCapella(),
+		FinalityBranch:          emptyLightClientBranch(fieldparams.FinalityBranchDepth),
+		SyncAggregate:           emptyLightClientSyncAggregate(),
+	}
+}
+
+// NewLightClientUpdateDeneb creates a light client update with minimum marshalable fields.
+func NewLightClientUpdateDeneb() *ethpb.LightClientUpdateDeneb {
+	return &ethpb.LightClientUpdateDeneb{
+		AttestedHeader:          newLightClientHeaderDeneb(),
+		NextSyncCommittee:       emptyLightClientSyncCommittee(),
+		NextSyncCommitteeBranch: emptyLightClientBranch(fieldparams.NextSyncCommitteeBranchDepth),
+		FinalizedHeader:         newLightClientHeaderDeneb(),
+		FinalityBranch:          emptyLightClientBranch(fieldparams.FinalityBranchDepth),
+		SyncAggregate:           emptyLightClientSyncAggregate(),
+	}
+}
+
+// NewLightClientUpdateElectra creates a light client update with minimum marshalable fields.
+func NewLightClientUpdateElectra() *ethpb.LightClientUpdateElectra {
+	return &ethpb.LightClientUpdateElectra{
+		AttestedHeader:          newLightClientHeaderElectra(),
+		NextSyncCommittee:       emptyLightClientSyncCommittee(),
+		NextSyncCommitteeBranch: emptyLightClientBranch(fieldparams.NextSyncCommitteeBranchDepthElectra),
+		FinalizedHeader:         newLightClientHeaderElectra(),
+		FinalityBranch:          emptyLightClientBranch(fieldparams.FinalityBranchDepthElectra),
+		SyncAggregate:           emptyLightClientSyncAggregate(),
+	}
+}
+
+func newLightClientHeaderCapella() *ethpb.LightClientHeaderCapella {
+	return &ethpb.LightClientHeaderCapella{
+		Beacon: HydrateBeaconHeader(nil),
+		Execution: &enginev1.ExecutionPayloadHeaderCapella{
+			ParentHash:       make([]byte, fieldparams.RootLength),
+			FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+			StateRoot:        make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+			LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+			PrevRandao:       make([]byte, fieldparams.RootLength),
+			BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+			BlockHash:        make([]byte, fieldparams.RootLength),
+			TransactionsRoot: make([]byte, fieldparams.RootLength),
+			WithdrawalsRoot:  make([]byte, fieldparams.RootLength),
+		},
+		ExecutionBranch: emptyLightClientBranch(fieldparams.ExecutionBranchDepth),
+	}
+}
+
+func newLightClientHeaderDeneb() *ethpb.LightClientHeaderDeneb {
+	return &ethpb.LightClientHeaderDeneb{
+		Beacon: HydrateBeaconHeader(nil),
+		Execution: &enginev1.ExecutionPayloadHeaderDeneb{
+			ParentHash:       make([]byte, fieldparams.RootLength),
+			FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+			StateRoot:        make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+			LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+			PrevRandao:       make([]byte, fieldparams.RootLength),
+			BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+			BlockHash:        make([]byte, fieldparams.RootLength),
+			TransactionsRoot: make([]byte, fieldparams.RootLength),
+			WithdrawalsRoot:  make([]byte, fieldparams.RootLength),
+		},
+		ExecutionBranch: emptyLightClientBranch(fieldparams.ExecutionBranchDepth),
+	}
+}
+
+func newLightClientHeaderElectra() *ethpb.LightClientHeaderElectra {
+	return &ethpb.LightClientHeaderElectra{
+		Beacon: HydrateBeaconHeader(nil),
+		Execution: &enginev1.ExecutionPayloadHeaderElectra{
+			ParentHash:             make([]byte, fieldparams.RootLength),
+			FeeRecipient:           make([]byte, fieldparams.FeeRecipientLength),
+			StateRoot:              make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:           make([]byte, fieldparams.RootLength),
+			LogsBloom:              make([]byte, fieldparams.LogsBloomLength),
+			PrevRandao:             make([]byte, fieldparams.RootLength),
+			BaseFeePerGas:          make([]byte, fieldparams.RootLength),
+			BlockHash:              make([]byte, fieldparams.RootLength),
+			TransactionsRoot:       make([]byte, fieldparams.RootLength),
+			WithdrawalsRoot:        make([]byte, fieldparams.RootLength),
+			DepositReceiptsRoot:    make([]byte, fieldparams.RootLength),
+			WithdrawalRequestsRoot: make([]byte, fieldparams.RootLength),
+		},
+		ExecutionBranch: emptyLightClientBranch(fieldparams.ExecutionBranchDepth),
+	}
+}
+
+func emptyLightClientSyncCommittee() *ethpb.SyncCommittee {
+	pubkeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	return &ethpb.SyncCommittee{Pubkeys: pubkeys, AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength)}
+}
+
+func emptyLightClientSyncAggregate() *ethpb.SyncAggregate {
+	return &ethpb.SyncAggregate{
+		SyncCommitteeBits:      make([]byte, fieldparams.SyncAggregateSyncCommitteeBytesLength),
+		SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+	}
+}
+
+func emptyLightClientBranch(depth int) [][]byte {
+	branch := make([][]byte, depth)
+	for i := range branch {
+		branch[i] = make([]byte, fieldparams.RootLength)
+	}
+	return branch
+}
@@ -0,0 +1,367 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/sirupsen/logrus"
+)
+
+// healthCheckInterval is how often the beacon nodes behind a FailoverJsonRestHandler are health checked.
+const healthCheckInterval = 6 * time.Second
+
+// broadcastEndpoints are the endpoints submitting signed duties. In broadcast mode they are posted to all healthy
+// beacon nodes, so that a duty is still propagated when the current node is poorly peered.
+var broadcastEndpoints = map[string]bool{
+	"/eth/v1/beacon/pool/attestations":          true,
+	"/eth/v1/validator/aggregate_and_proofs":    true,
+	"/eth/v1/beacon/pool/sync_committees":       true,
+	"/eth/v1/validator/contribution_and_proofs": true,
+	"/eth/v1/beacon/blocks":                     true,
+	"/eth/v1/beacon/blinded_blocks":             true,
+}
+
+// FailoverJsonRestHandler is a JsonRestHandler that sends requests to one of several beacon nodes.
+// Requests go to the first healthy node in the list, so the first node acts as the primary. When the current node
+// fails a request, the request is retried against the other nodes and the handler switches to the first one that
+// serves it. A background health check switches back to the primary once it is healthy and synced again.
+//
+// In broadcast mode, signed duties are additionally submitted to all other healthy nodes in parallel, while everything
+// else, including the duties and the data to sign, still comes from the current node.
+type FailoverJsonRestHandler struct {
+	handlers  []BeaconApiJsonRestHandler
+	broadcast bool
+	lock      sync.RWMutex
+	current   int
+	// healthy holds the result of the last health check of each node. Nodes are assumed healthy until checked.
+	healthy []bool
+}
+
+// NewFailoverJsonRestHandler returns a FailoverJsonRestHandler for the given beacon node hosts, in order of preference.
+// If broadcast is true, signed duties are submitted to all healthy nodes.
+func NewFailoverJsonRestHandler(client http.Client, hosts []string, broadcast bool) *FailoverJsonRestHandler {
+	if len(hosts) == 0 {
+		// Without any host, requests fail the same way they do for a BeaconApiJsonRestHandler with an empty host.
+		hosts = []string{""}
+	}
+	// Requests carry the trace context of the duty they are made for, so that they are traced in the beacon node too.
+	client.Transport = tracing.NewTransport(client.Transport)
+	handlers := make([]BeaconApiJsonRestHandler, len(hosts))
+	for i, host := range hosts {
+		handlers[i] = BeaconApiJsonRestHandler{client: client, host: host}
+	}
+	healthy := make([]bool, len(hosts))
+	for i := range healthy {
+		healthy[i] = true
+	}
+	currentBeaconNode.WithLabelValues(hostLabel(handlers[0].host)).Set(1)
+	return &FailoverJsonRestHandler{
+		handlers:  handlers,
+		broadcast: broadcast,
+		healthy:   healthy,
+	}
+}
+
+// ParseHosts splits a comma separated list of beacon node REST API endpoints.
+func ParseHosts(endpoints string) []string {
+	var hosts []string
+	for _, h := range strings.Split(endpoints, ",") {
+		if h = strings.TrimSpace(h); h != "" {
+			hosts = append(hosts, h)
+		}
+	}
+	return hosts
+}
+
+// HttpClient returns the underlying HTTP client of the handler.
+func (f *FailoverJsonRestHandler) HttpClient() *http.Client {
+	return f.currentHandler().HttpClient()
+}
+
+// Host returns the host of the beacon node requests are currently sent to.
+func (f *FailoverJsonRestHandler) Host() string {
+	return f.currentHandler().Host()
+}
+
+// Get sends a GET request to the current beacon node, failing over to the other nodes if it fails.
+func (f *FailoverJsonRestHandler) Get(ctx context.Context, endpoint string, resp interface{}) error {
+	return f.do(ctx, func(h BeaconApiJsonRestHandler) error {
+		return h.Get(ctx, endpoint, resp)
+	})
+}
+
+// Post sends a POST request to the current beacon node, failing over to the other nodes if it fails.
+func (f *FailoverJsonRestHandler) Post(
+	ctx context.Context,
+	apiEndpoint string,
+	headers map[string]string,
+	data *bytes.Buffer,
+	resp interface{},
+) error {
+	if data == nil {
+		return errors.New("data is nil")
+	}
+	// The request body is consumed by each attempt, so every attempt gets its own reader over the same bytes.
+	body := data.Bytes()
+	post := func(h BeaconApiJsonRestHandler) error {
+		return h.Post(ctx, apiEndpoint, headers, bytes.NewBuffer(body), resp)
+	}
+	if f.broadcast && broadcastEndpoints[apiEndpoint] && len(f.handlers) > 1 {
+		return f.broadcastPost(ctx, apiEndpoint, post, func(h BeaconApiJsonRestHandler) error {
+			return h.Post(ctx, apiEndpoint, headers, bytes.NewBuffer(body), nil)
+		})
+	}
+	return f.do(ctx, post)
+}
+
+// GetSSZ sends a GET request preferring an SSZ response to the current beacon node, failing over to the other nodes
+// if it fails.
+func (f *FailoverJsonRestHandler) GetSSZ(ctx context.Context, endpoint string) ([]byte, http.Header, error) {
+	var body []byte
+	var header http.Header
+	err := f.do(ctx, func(h BeaconApiJsonRestHandler) error {
+		var err error
+		body, header, err = h.GetSSZ(ctx, endpoint)
+		return err
+	})
+	return body, header, err
+}
+
+// PostSSZ sends a POST request with an SSZ encoded body to the current beacon node, failing over to the other nodes
+// if it fails.
+func (f *FailoverJsonRestHandler) PostSSZ(
+	ctx context.Context,
+	apiEndpoint string,
+	headers map[string]string,
+	data *bytes.Buffer,
+) ([]byte, http.Header, error) {
+	if data == nil {
+		return nil, nil, errors.New("data is nil")
+	}
+	body := data.Bytes()
+	var respBody []byte
+	var respHeader http.Header
+	post := func(h BeaconApiJsonRestHandler) error {
+		var err error
+		respBody, respHeader, err = h.PostSSZ(ctx, apiEndpoint, headers, bytes.NewBuffer(body))
+		return err
+	}
+	var err error
+	if f.broadcast && broadcastEndpoints[apiEndpoint] && len(f.handlers) > 1 {
+		err = f.broadcastPost(ctx, apiEndpoint, post, func(h BeaconApiJsonRestHandler) error {
+			_, _, err := h.PostSSZ(ctx, apiEndpoint, headers, bytes.NewBuffer(body))
+			return err
+		})
+	} else {
+		err = f.do(ctx, post)
+	}
+	return respBody, respHeader, err
+}
+
+// broadcastPost posts to the current node, with failover, and to all other healthy nodes in parallel. Only the
+// current node's response is decoded. The request succeeds if any of the nodes accepted it; otherwise the current
+// node's error is returned.
+func (f *FailoverJsonRestHandler) broadcastPost(
+	ctx context.Context,
+	apiEndpoint string,
+	post func(h BeaconApiJsonRestHandler) error,
+	postDiscardingResponse func(h BeaconApiJsonRestHandler) error,
+) error {
+	f.lock.RLock()
+	current := f.current
+	var others []BeaconApiJsonRestHandler
+	for i, h := range f.handlers {
+		if i != current && f.healthy[i] {
+			others = append(others, h)
+		}
+	}
+	f.lock.RUnlock()
+
+	errs := make([]error, len(others))
+	var wg sync.WaitGroup
+	for i, h := range others {
+		wg.Add(1)
+		go func(i int, h BeaconApiJsonRestHandler) {
+			defer wg.Done()
+			errs[i] = postDiscardingResponse(h)
+			recordBroadcast(h, apiEndpoint, errs[i])
+		}(i, h)
+	}
+	err := f.do(ctx, post)
+	wg.Wait()
+
+	if err == nil {
+		return nil
+	}
+	for i, otherErr := range errs {
+		if otherErr == nil {
+			log.WithError(err).WithFields(logrus.Fields{
+				"endpoint":   apiEndpoint,
+				"acceptedBy": hostLabel(others[i].host),
+			}).Debug("Current beacon node failed to accept signed duty, but another beacon node accepted it")
+			return nil
+		}
+	}
+	return err
+}
+
+func recordBroadcast(h BeaconApiJsonRestHandler, apiEndpoint string, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+		log.WithError(err).WithFields(logrus.Fields{
+			"endpoint": apiEndpoint,
+			"host":     hostLabel(h.host),
+		}).Debug("Could not broadcast signed duty to beacon node")
+	}
+	beaconNodeBroadcastCount.WithLabelValues(hostLabel(h.host), apiEndpoint, result).Inc()
+}
+
+// do runs the request against the current node, then against each of the other nodes in order until one of them
+// serves it. Client error responses are returned as is, without failing over.
+func (f *FailoverJsonRestHandler) do(ctx context.Context, request func(h BeaconApiJsonRestHandler) error) error {
+	f.lock.RLock()
+	start := f.current
+	f.lock.RUnlock()
+
+	var err error
+	for i := 0; i < len(f.handlers); i++ {
+		idx := (start + i) % len(f.handlers)
+		err = request(f.handlers[idx])
+		if !isNodeFailure(err) || ctx.Err() != nil {
+			if i > 0 {
+				f.switchTo(idx, "request to the current beacon node failed")
+			}
+			return err
+		}
+		log.WithError(err).WithField("host", hostLabel(f.handlers[idx].host)).Debug("Beacon node failed to serve request")
+	}
+	return err
+}
+
+// MonitorHealth periodically health checks the beacon nodes and switches to the most preferred node that is
+// healthy and synced. It returns when the context is cancelled.
+func (f *FailoverJsonRestHandler) MonitorHealth(ctx context.Context) {
+	if len(f.handlers) < 2 {
+		return
+	}
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f.checkHealth(ctx)
+		}
+	}
+}
+
+func (f *FailoverJsonRestHandler) checkHealth(ctx context.Context) {
+	best := -1
+	healthy := make([]bool, len(f.handlers))
+	for i, h := range f.handlers {
+		healthy[i] = isNodeHealthy(ctx, h)
+		if healthy[i] {
+			beaconNodeHealthy.WithLabelValues(hostLabel(h.host)).Set(1)
+			if best == -1 {
+				best = i
+			}
+		} else {
+			beaconNodeHealthy.WithLabelValues(hostLabel(h.host)).Set(0)
+		}
+	}
+	f.lock.Lock()
+	f.healthy = healthy
+	current := f.current
+	f.lock.Unlock()
+	if best == -1 {
+		log.Warn("None of the beacon nodes are healthy")
+		return
+	}
+	if best < current {
+		f.switchTo(best, "more preferred beacon node is healthy again")
+	} else if best > current {
+		f.switchTo(best, "current beacon node is unhealthy")
+	}
+}
+
+func (f *FailoverJsonRestHandler) switchTo(idx int, reason string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.current == idx {
+		return
+	}
+	from, to := hostLabel(f.handlers[f.current].host), hostLabel(f.handlers[idx].host)
+	log.WithFields(logrus.Fields{
+		"from":   from,
+		"to":     to,
+		"reason": reason,
+	}).Warn("Switching beacon node")
+	beaconNodeFailoverCount.WithLabelValues(from, to).Inc()
+	currentBeaconNode.WithLabelValues(from).Set(0)
+	currentBeaconNode.WithLabelValues(to).Set(1)
+	f.current = idx
+}
+
+func (f *FailoverJsonRestHandler) currentHandler() BeaconApiJsonRestHandler {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.handlers[f.current]
+}
+
+// isNodeHealthy returns true if the node reports itself as healthy and is neither syncing nor cut off from its
+// execution client.
+func isNodeHealthy(ctx context.Context, h BeaconApiJsonRestHandler) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.host+"/eth/v1/node/health", nil)
+	if err != nil {
+		return false
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return false
+	}
+	if err := resp.Body.Close(); err != nil {
+		return false
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false
+	}
+	syncingResponse := structs.SyncStatusResponse{}
+	if err := h.Get(ctx, "/eth/v1/node/syncing", &syncingResponse); err != nil || syncingResponse.Data == nil {
+		return false
+	}
+	return !syncingResponse.Data.IsSyncing && !syncingResponse.Data.ElOffline
+}
+
+// isNodeFailure returns true for errors that mean the node could not serve the request, either because it could not
+// be reached or because it responded with a server error. Client errors are not the node's fault.
+func isNodeFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	errJson := &httputil.DefaultJsonError{}
+	if errors.As(err, &errJson) {
+		return errJson.Code >= http.StatusInternalServerError
+	}
+	return true
+}
+
+// hostLabel returns the host of the endpoint, so that credentials embedded in the URL don't end up in logs or
+// metric labels.
+func hostLabel(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return endpoint
+	}
+	return u.Host
+}
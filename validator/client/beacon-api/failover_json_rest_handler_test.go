@@ -0,0 +1,213 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+const (
+	testEndpoint          = "/example/rest/api/endpoint"
+	testBroadcastEndpoint = "/eth/v1/beacon/pool/attestations"
+)
+
+// testBeaconNode serves the health endpoints along with testEndpoint and testBroadcastEndpoint, which echo the
+// request body back.
+type testBeaconNode struct {
+	server    *httptest.Server
+	healthy   bool
+	syncing   bool
+	failCode  int
+	requested int
+}
+
+func newTestBeaconNode(t *testing.T) *testBeaconNode {
+	n := &testBeaconNode{healthy: true}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/node/health", func(w http.ResponseWriter, r *http.Request) {
+		if !n.healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+	mux.HandleFunc("/eth/v1/node/syncing", func(w http.ResponseWriter, r *http.Request) {
+		httputil.WriteJson(w, &structs.SyncStatusResponse{Data: &structs.SyncStatusResponseData{IsSyncing: n.syncing}})
+	})
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		n.requested++
+		if n.failCode != 0 {
+			httputil.HandleError(w, "failed", n.failCode)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		httputil.WriteJson(w, &structs.GetGenesisResponse{Data: &structs.Genesis{GenesisTime: string(body)}})
+	}
+	mux.HandleFunc(testEndpoint, handler)
+	mux.HandleFunc(testBroadcastEndpoint, handler)
+	n.server = httptest.NewServer(mux)
+	t.Cleanup(n.server.Close)
+	return n
+}
+
+func TestFailoverJsonRestHandler_Get(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("uses primary", func(t *testing.T) {
+		primary, secondary := newTestBeaconNode(t), newTestBeaconNode(t)
+		h := NewFailoverJsonRestHandler(http.Client{Timeout: time.Second}, []string{primary.server.URL, secondary.server.URL}, false)
+		require.NoError(t, h.Get(ctx, testEndpoint, &structs.GetGenesisResponse{}))
+		assert.Equal(t, 1, primary.requested)
+		assert.Equal(t, 0, secondary.requested)
+		assert.Equal(t, primary.server.URL, h.Host())
+	})
+	t.Run("fails over when primary is unreachable", func(t *testing.T) {
+		primary, secondary := newTestBeaconNode(t), newTestBeaconNode(t)
+		primary.server.Close()
+		h := NewFailoverJsonRestHandler(http.Client{Timeout: time.Second}, []string{primary.server.URL, secondary.server.URL}, false)
+		require.NoError(t, h.Get(ctx, testEndpoint, &structs.GetGenesisResponse{}))
+		assert.Equal(t, 1, secondary.requested)
+		assert.Equal(t, secondary.server.URL, h.Host())
+	})
+	t.Run("fails over on server error", func(t *testing.T) {
+		primary, secondary := newTestBeaconNode(t), newTestBeaconNode(t)
+		primary.failCode = http.StatusServiceUnavailable
+		h := NewFailoverJsonRestHandler(http.Client{Timeout: time.Second}, []string{primary.server.URL, secondary.server.URL}, false)
+		require.NoError(t, h.Get(ctx, testEndpoint, &structs.GetGenesisResponse{}))
+		assert.Equal(t, 1, primary.requested)
+		assert.Equal(t, 1, secondary.requested)
+		assert.Equal(t, secondary.server.URL, h.Host())
+	})
+	t.Run("does not fail over on client error", func(t *testing.T) {
+		primary, secondary := newTestBeaconNode(t), newTestBeaconNode(t)
+		primary.failCode = http.StatusNotFound
+		h := NewFailoverJsonRestHandler(http.Client{Timeout: time.Second}, []string{primary.server.URL, secondary.server.URL}, false)
+		err := h.Get(ctx, testEndpoint, &structs.GetGenesisResponse{})
+		errJson := &httputil.DefaultJsonError{}
+		require.Equal(t, true, errors.As(err, &errJson))
+		assert.Equal(t, http.StatusNotFound, errJson.Code)
+		assert.Equal(t, 0, secondary.requested)
+		assert.Equal(t, primary.server.URL, h.Host())
+	})
+	t.Run("all nodes unreachable", func(t *testing.T) {
+		primary, secondary := newTestBeaconNode(t), newTestBeaconNode(t)
+		primary.server.Close()
+		secondary.server.Close()
+		h := NewFailoverJsonRestHandler(http.Client{Timeout: time.Second}, []string{primary.server.URL, secondary.server.URL}, false)
+		require.ErrorContains(t, "failed to perform request", h.Get(ctx, testEndpoint, &structs.GetGenesisResponse{}))
+		assert.Equal(t, primary.server.URL, h.Host())
+	})
+}
+
+func TestFailoverJsonRestHandler_Post(t *testing.T) {
+	ctx := context.Background()
+	primary, secondary := newTestBeaconNode(t), newTestBeaconNode(t)
+	primary.failCode = http.StatusInternalServerError
+	h := NewFailoverJsonRestHandler(http.Client{Timeout: time.Second}, []string{primary.server.URL, secondary.server.URL}, false)
+
+	resp := &structs.GetGenesisResponse{}
+	require.NoError(t, h.Post(ctx, testEndpoint, nil, bytes.NewBufferString("body"), resp))
+	// The secondary received the full request body even though the primary consumed it first.
+	assert.Equal(t, "body", resp.Data.GenesisTime)
+	assert.Equal(t, 1, primary.requested)
+	assert.Equal(t, secondary.server.URL, h.Host())
+}
+
+func TestFailoverJsonRestHandler_Broadcast(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("signed duties go to all nodes", func(t *testing.T) {
+		primary, secondary, tertiary := newTestBeaconNode(t), newTestBeaconNode(t), newTestBeaconNode(t)
+		h := NewFailoverJsonRestHandler(http.Client{Timeout: time.Second}, []string{primary.server.URL, secondary.server.URL, tertiary.server.URL}, true)
+		resp := &structs.GetGenesisResponse{}
+		require.NoError(t, h.Post(ctx, testBroadcastEndpoint, nil, bytes.NewBufferString("body"), resp))
+		assert.Equal(t, "body", resp.Data.GenesisTime)
+		assert.Equal(t, 1, primary.requested)
+		assert.Equal(t, 1, secondary.requested)
+		assert.Equal(t, 1, tertiary.requested)
+	})
+	t.Run("other requests go to the current node only", func(t *testing.T) {
+		primary, secondary := newTestBeaconNode(t), newTestBeaconNode(t)
+		h := NewFailoverJsonRestHandler(http.Client{Timeout: time.Second}, []string{primary.server.URL, secondary.server.URL}, true)
+		require.NoError(t, h.Post(ctx, testEndpoint, nil, bytes.NewBufferString("body"), nil))
+		assert.Equal(t, 1, primary.requested)
+		assert.Equal(t, 0, secondary.requested)
+	})
+	t.Run("disabled", func(t *testing.T) {
+		primary, secondary := newTestBeaconNode(t), newTestBeaconNode(t)
+		h := NewFailoverJsonRestHandler(http.Client{Timeout: time.Second}, []string{primary.server.URL, secondary.server.URL}, false)
+		require.NoError(t, h.Post(ctx, testBroadcastEndpoint, nil, bytes.NewBufferString("body"), nil))
+		assert.Equal(t, 1, primary.requested)
+		assert.Equal(t, 0, secondary.requested)
+	})
+	t.Run("accepted by another node", func(t *testing.T) {
+		primary, secondary := newTestBeaconNode(t), newTestBeaconNode(t)
+		primary.failCode = http.StatusBadRequest
+		h := NewFailoverJsonRestHandler(http.Client{Timeout: time.Second}, []string{primary.server.URL, secondary.server.URL}, true)
+		require.NoError(t, h.Post(ctx, testBroadcastEndpoint, nil, bytes.NewBufferString("body"), nil))
+		assert.Equal(t, 1, secondary.requested)
+		// A client error from the current node does not make the handler fail over.
+		assert.Equal(t, primary.server.URL, h.Host())
+	})
+	t.Run("rejected by all nodes", func(t *testing.T) {
+		primary, secondary := newTestBeaconNode(t), newTestBeaconNode(t)
+		primary.failCode = http.StatusBadRequest
+		secondary.failCode = http.StatusBadRequest
+		h := NewFailoverJsonRestHandler(http.Client{Timeout: time.Second}, []string{primary.server.URL, secondary.server.URL}, true)
+		err := h.Post(ctx, testBroadcastEndpoint, nil, bytes.NewBufferString("body"), nil)
+		errJson := &httputil.DefaultJsonError{}
+		require.Equal(t, true, errors.As(err, &errJson))
+		assert.Equal(t, http.StatusBadRequest, errJson.Code)
+	})
+	t.Run("skips unhealthy nodes", func(t *testing.T) {
+		primary, secondary := newTestBeaconNode(t), newTestBeaconNode(t)
+		secondary.syncing = true
+		h := NewFailoverJsonRestHandler(http.Client{Timeout: time.Second}, []string{primary.server.URL, secondary.server.URL}, true)
+		h.checkHealth(ctx)
+		require.NoError(t, h.Post(ctx, testBroadcastEndpoint, nil, bytes.NewBufferString("body"), nil))
+		assert.Equal(t, 1, primary.requested)
+		assert.Equal(t, 0, secondary.requested)
+	})
+}
+
+func TestFailoverJsonRestHandler_CheckHealth(t *testing.T) {
+	ctx := context.Background()
+	primary, secondary := newTestBeaconNode(t), newTestBeaconNode(t)
+	h := NewFailoverJsonRestHandler(http.Client{Timeout: time.Second}, []string{primary.server.URL, secondary.server.URL}, false)
+
+	primary.syncing = true
+	h.checkHealth(ctx)
+	assert.Equal(t, secondary.server.URL, h.Host())
+
+	// Nothing changes while the primary is still syncing.
+	h.checkHealth(ctx)
+	assert.Equal(t, secondary.server.URL, h.Host())
+
+	// Return to the primary once it is synced.
+	primary.syncing = false
+	h.checkHealth(ctx)
+	assert.Equal(t, primary.server.URL, h.Host())
+
+	primary.healthy = false
+	h.checkHealth(ctx)
+	assert.Equal(t, secondary.server.URL, h.Host())
+
+	// Stay on the current node when none of the nodes are healthy.
+	secondary.healthy = false
+	h.checkHealth(ctx)
+	assert.Equal(t, secondary.server.URL, h.Host())
+}
+
+func TestParseHosts(t *testing.T) {
+	assert.DeepEqual(t, []string{"http://a:3500", "http://b:3500"}, ParseHosts("http://a:3500, http://b:3500,"))
+	assert.DeepEqual(t, []string{"http://a:3500"}, ParseHosts("http://a:3500"))
+}
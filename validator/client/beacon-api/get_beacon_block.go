@@ -7,10 +7,13 @@ import (
 	"fmt"
 	"net/http"
 	neturl "net/url"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -37,7 +40,16 @@ func (c beaconApiValidatorClient) getBeaconBlock(ctx context.Context, slot primi
 	// We try the blinded block endpoint first. If it fails, we assume that we got a full block and try the full block endpoint.
 	queryUrl := buildURL(fmt.Sprintf("/eth/v3/validator/blocks/%d", slot), queryParams)
 	produceBlockV3ResponseJson := structs.ProduceBlockV3Response{}
-	err := c.jsonRestHandler.Get(ctx, queryUrl, &produceBlockV3ResponseJson)
+	var err error
+	if features.Get().EnableBeaconRESTApiSSZ {
+		var sszBlock *ethpb.GenericBeaconBlock
+		sszBlock, err = c.getBeaconBlockV3SSZ(ctx, queryUrl, &produceBlockV3ResponseJson)
+		if err == nil && sszBlock != nil {
+			return sszBlock, nil
+		}
+	} else {
+		err = c.jsonRestHandler.Get(ctx, queryUrl, &produceBlockV3ResponseJson)
+	}
 	errJson := &httputil.DefaultJsonError{}
 	if err != nil {
 		if !errors.As(err, &errJson) {
@@ -164,6 +176,87 @@ func (c beaconApiValidatorClient) getBeaconBlock(ctx context.Context, slot primi
 	return response, nil
 }
 
+// getBeaconBlockV3SSZ requests the block from the v3 endpoint preferring an SSZ response. If the beacon node responds
+// with JSON instead, the response is decoded into jsonResp and no block is returned.
+func (c beaconApiValidatorClient) getBeaconBlockV3SSZ(
+	ctx context.Context,
+	queryUrl string,
+	jsonResp *structs.ProduceBlockV3Response,
+) (*ethpb.GenericBeaconBlock, error) {
+	body, header, err := c.jsonRestHandler.GetSSZ(ctx, queryUrl)
+	if err != nil {
+		return nil, err
+	}
+	if !isSSZResponse(header) {
+		if err := json.Unmarshal(body, jsonResp); err != nil {
+			return nil, errors.Wrap(err, "failed to decode block response json")
+		}
+		return nil, nil
+	}
+	blinded := strings.EqualFold(header.Get(api.ExecutionPayloadBlindedHeader), "true")
+	return decodeBeaconBlockSSZ(body, header.Get(api.VersionHeader), blinded)
+}
+
+// decodeBeaconBlockSSZ decodes an SSZ encoded block, as returned by the block production endpoints, into a generic
+// beacon block of the given consensus version.
+func decodeBeaconBlockSSZ(body []byte, ver string, blinded bool) (*ethpb.GenericBeaconBlock, error) {
+	switch ver {
+	case version.String(version.Phase0):
+		blk := &ethpb.BeaconBlock{}
+		if err := blk.UnmarshalSSZ(body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode phase0 block response ssz")
+		}
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Phase0{Phase0: blk}}, nil
+	case version.String(version.Altair):
+		blk := &ethpb.BeaconBlockAltair{}
+		if err := blk.UnmarshalSSZ(body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode altair block response ssz")
+		}
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Altair{Altair: blk}}, nil
+	case version.String(version.Bellatrix):
+		if blinded {
+			blk := &ethpb.BlindedBeaconBlockBellatrix{}
+			if err := blk.UnmarshalSSZ(body); err != nil {
+				return nil, errors.Wrap(err, "failed to decode blinded bellatrix block response ssz")
+			}
+			return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_BlindedBellatrix{BlindedBellatrix: blk}, IsBlinded: true}, nil
+		}
+		blk := &ethpb.BeaconBlockBellatrix{}
+		if err := blk.UnmarshalSSZ(body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bellatrix block response ssz")
+		}
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Bellatrix{Bellatrix: blk}}, nil
+	case version.String(version.Capella):
+		if blinded {
+			blk := &ethpb.BlindedBeaconBlockCapella{}
+			if err := blk.UnmarshalSSZ(body); err != nil {
+				return nil, errors.Wrap(err, "failed to decode blinded capella block response ssz")
+			}
+			return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_BlindedCapella{BlindedCapella: blk}, IsBlinded: true}, nil
+		}
+		blk := &ethpb.BeaconBlockCapella{}
+		if err := blk.UnmarshalSSZ(body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode capella block response ssz")
+		}
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Capella{Capella: blk}}, nil
+	case version.String(version.Deneb):
+		if blinded {
+			blk := &ethpb.BlindedBeaconBlockDeneb{}
+			if err := blk.UnmarshalSSZ(body); err != nil {
+				return nil, errors.Wrap(err, "failed to decode blinded deneb block response ssz")
+			}
+			return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_BlindedDeneb{BlindedDeneb: blk}, IsBlinded: true}, nil
+		}
+		blkContents := &ethpb.BeaconBlockContentsDeneb{}
+		if err := blkContents.UnmarshalSSZ(body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode deneb block response ssz")
+		}
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Deneb{Deneb: blkContents}}, nil
+	default:
+		return nil, errors.Errorf("unsupported consensus version `%s`", ver)
+	}
+}
+
 func (c beaconApiValidatorClient) fallBackToBlinded(
 	ctx context.Context,
 	slot primitives.Slot,
@@ -9,12 +9,15 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api/mock"
 	test_helpers "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api/test-helpers"
 	"go.uber.org/mock/gomock"
@@ -608,3 +611,90 @@ func TestGetBeaconBlock_FallbackToFullBlock(t *testing.T) {
 
 	assert.DeepEqual(t, expectedBeaconBlock, beaconBlock)
 }
+
+func TestGetBeaconBlock_SSZ(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableBeaconRESTApiSSZ: true})
+	defer resetCfg()
+
+	const slot = primitives.Slot(1)
+	randaoReveal := []byte{2}
+	graffiti := []byte{3}
+	queryUrl := fmt.Sprintf("/eth/v3/validator/blocks/%d?graffiti=%s&randao_reveal=%s", slot, hexutil.Encode(graffiti), hexutil.Encode(randaoReveal))
+	ctx := context.Background()
+
+	t.Run("capella", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		block := util.NewBeaconBlockCapella().Block
+		sszBytes, err := block.MarshalSSZ()
+		require.NoError(t, err)
+		header := http.Header{}
+		header.Set("Content-Type", api.OctetStreamMediaType)
+		header.Set(api.VersionHeader, "capella")
+		header.Set(api.ExecutionPayloadBlindedHeader, "false")
+
+		jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+		jsonRestHandler.EXPECT().GetSSZ(ctx, queryUrl).Return(sszBytes, header, nil).Times(1)
+
+		validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+		beaconBlock, err := validatorClient.getBeaconBlock(ctx, slot, randaoReveal, graffiti)
+		require.NoError(t, err)
+		assert.DeepEqual(t, &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Capella{Capella: block}}, beaconBlock)
+	})
+	t.Run("blinded deneb", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		block := util.NewBlindedBeaconBlockDeneb().Message
+		sszBytes, err := block.MarshalSSZ()
+		require.NoError(t, err)
+		header := http.Header{}
+		header.Set("Content-Type", api.OctetStreamMediaType)
+		header.Set(api.VersionHeader, "deneb")
+		header.Set(api.ExecutionPayloadBlindedHeader, "true")
+
+		jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+		jsonRestHandler.EXPECT().GetSSZ(ctx, queryUrl).Return(sszBytes, header, nil).Times(1)
+
+		validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+		beaconBlock, err := validatorClient.getBeaconBlock(ctx, slot, randaoReveal, graffiti)
+		require.NoError(t, err)
+		assert.DeepEqual(t, &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_BlindedDeneb{BlindedDeneb: block}, IsBlinded: true}, beaconBlock)
+	})
+	t.Run("json response", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		proto := test_helpers.GenerateProtoPhase0BeaconBlock()
+		blockJson, err := json.Marshal(test_helpers.GenerateJsonPhase0BeaconBlock())
+		require.NoError(t, err)
+		respJson, err := json.Marshal(structs.ProduceBlockV3Response{Version: "phase0", Data: blockJson})
+		require.NoError(t, err)
+		header := http.Header{}
+		header.Set("Content-Type", api.JsonMediaType)
+
+		jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+		jsonRestHandler.EXPECT().GetSSZ(ctx, queryUrl).Return(respJson, header, nil).Times(1)
+
+		validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+		beaconBlock, err := validatorClient.getBeaconBlock(ctx, slot, randaoReveal, graffiti)
+		require.NoError(t, err)
+		assert.DeepEqual(t, &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Phase0{Phase0: proto}}, beaconBlock)
+	})
+	t.Run("undecodable ssz", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		header := http.Header{}
+		header.Set("Content-Type", api.OctetStreamMediaType)
+		header.Set(api.VersionHeader, "altair")
+
+		jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+		jsonRestHandler.EXPECT().GetSSZ(ctx, queryUrl).Return([]byte{1, 2, 3}, header, nil).Times(1)
+
+		validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+		_, err := validatorClient.getBeaconBlock(ctx, slot, randaoReveal, graffiti)
+		assert.ErrorContains(t, "failed to decode altair block response ssz", err)
+	})
+}
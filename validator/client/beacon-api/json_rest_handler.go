@@ -13,9 +13,15 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 )
 
+// sszPreferredAcceptHeader asks for an SSZ response, but lets beacon nodes that don't support SSZ for the endpoint
+// respond with JSON instead.
+const sszPreferredAcceptHeader = "application/octet-stream;q=1.0,application/json;q=0.9"
+
 type JsonRestHandler interface {
 	Get(ctx context.Context, endpoint string, resp interface{}) error
 	Post(ctx context.Context, endpoint string, headers map[string]string, data *bytes.Buffer, resp interface{}) error
+	GetSSZ(ctx context.Context, endpoint string) ([]byte, http.Header, error)
+	PostSSZ(ctx context.Context, endpoint string, headers map[string]string, data *bytes.Buffer) ([]byte, http.Header, error)
 	HttpClient() *http.Client
 	Host() string
 }
@@ -102,6 +108,94 @@ func (c BeaconApiJsonRestHandler) Post(
 	return decodeResp(httpResp, resp)
 }
 
+// GetSSZ sends a GET request preferring an SSZ response, and returns the raw response body along with the response
+// headers. Beacon nodes that don't support SSZ for the endpoint respond with JSON, so the caller has to check the
+// Content-Type header before decoding the body.
+// If an HTTP error is returned, the body is decoded as a DefaultJsonError JSON object and returned as the error.
+func (c BeaconApiJsonRestHandler) GetSSZ(ctx context.Context, endpoint string) ([]byte, http.Header, error) {
+	url := c.host + endpoint
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create request for endpoint %s", url)
+	}
+	req.Header.Set("Accept", sszPreferredAcceptHeader)
+
+	httpResp, err := c.client.Do(req)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to perform request for endpoint %s", url)
+	}
+	defer func() {
+		if err := httpResp.Body.Close(); err != nil {
+			return
+		}
+	}()
+
+	return readRawResp(httpResp)
+}
+
+// PostSSZ sends a POST request with an SSZ encoded body, and returns the raw response body along with the response
+// headers.
+// If an HTTP error is returned, the body is decoded as a DefaultJsonError JSON object and returned as the error.
+func (c BeaconApiJsonRestHandler) PostSSZ(
+	ctx context.Context,
+	apiEndpoint string,
+	headers map[string]string,
+	data *bytes.Buffer,
+) ([]byte, http.Header, error) {
+	if data == nil {
+		return nil, nil, errors.New("data is nil")
+	}
+
+	url := c.host + apiEndpoint
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, data)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create request for endpoint %s", url)
+	}
+
+	for headerKey, headerValue := range headers {
+		req.Header.Set(headerKey, headerValue)
+	}
+	req.Header.Set("Content-Type", api.OctetStreamMediaType)
+
+	httpResp, err := c.client.Do(req)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to perform request for endpoint %s", url)
+	}
+	defer func() {
+		if err = httpResp.Body.Close(); err != nil {
+			return
+		}
+	}()
+
+	return readRawResp(httpResp)
+}
+
+func readRawResp(httpResp *http.Response) ([]byte, http.Header, error) {
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to read response body for %s", httpResp.Request.URL)
+	}
+
+	// non-2XX codes are a failure
+	if !strings.HasPrefix(httpResp.Status, "2") {
+		if httpResp.Header.Get("Content-Type") != api.JsonMediaType {
+			return nil, nil, &httputil.DefaultJsonError{Code: httpResp.StatusCode, Message: string(body)}
+		}
+		errorJson := &httputil.DefaultJsonError{}
+		if err = json.Unmarshal(body, errorJson); err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to decode response body into error json for %s", httpResp.Request.URL)
+		}
+		return nil, nil, errorJson
+	}
+
+	return body, httpResp.Header, nil
+}
+
+// isSSZResponse returns true if the response body is SSZ encoded.
+func isSSZResponse(header http.Header) bool {
+	return strings.HasPrefix(header.Get("Content-Type"), api.OctetStreamMediaType)
+}
+
 func decodeResp(httpResp *http.Response, resp interface{}) error {
 	body, err := io.ReadAll(httpResp.Body)
 	if err != nil {
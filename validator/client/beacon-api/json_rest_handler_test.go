@@ -218,3 +218,64 @@ func Test_decodeResp(t *testing.T) {
 		assert.ErrorContains(t, "failed to decode response body into error json", err)
 	})
 }
+
+func TestGetSSZ(t *testing.T) {
+	ctx := context.Background()
+	const endpoint = "/example/rest/api/endpoint"
+	sszBytes := []byte{1, 2, 3, 4, 5}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, true, httputil.RespondWithSsz(r))
+		w.Header().Set("Content-Type", api.OctetStreamMediaType)
+		w.Header().Set(api.VersionHeader, "capella")
+		_, err := w.Write(sszBytes)
+		require.NoError(t, err)
+	})
+	mux.HandleFunc("/error", func(w http.ResponseWriter, r *http.Request) {
+		httputil.HandleError(w, "bad request", http.StatusBadRequest)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	jsonRestHandler := BeaconApiJsonRestHandler{
+		client: http.Client{Timeout: time.Second * 5},
+		host:   server.URL,
+	}
+	body, header, err := jsonRestHandler.GetSSZ(ctx, endpoint)
+	require.NoError(t, err)
+	assert.DeepEqual(t, sszBytes, body)
+	assert.Equal(t, true, isSSZResponse(header))
+	assert.Equal(t, "capella", header.Get(api.VersionHeader))
+
+	_, _, err = jsonRestHandler.GetSSZ(ctx, "/error")
+	errJson := &httputil.DefaultJsonError{}
+	require.Equal(t, true, errors.As(err, &errJson))
+	assert.Equal(t, http.StatusBadRequest, errJson.Code)
+	assert.Equal(t, "bad request", errJson.Message)
+}
+
+func TestPostSSZ(t *testing.T) {
+	ctx := context.Background()
+	const endpoint = "/example/rest/api/endpoint"
+	dataBytes := []byte{1, 2, 3, 4, 5}
+	headers := map[string]string{"foo": "bar"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "bar", r.Header.Get("foo"))
+		assert.Equal(t, true, httputil.IsRequestSsz(r))
+		receivedBytes, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.DeepEqual(t, dataBytes, receivedBytes)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	jsonRestHandler := BeaconApiJsonRestHandler{
+		client: http.Client{Timeout: time.Second * 5},
+		host:   server.URL,
+	}
+	_, _, err := jsonRestHandler.PostSSZ(ctx, endpoint, headers, bytes.NewBuffer(dataBytes))
+	require.NoError(t, err)
+}
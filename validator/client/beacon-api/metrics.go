@@ -31,4 +31,36 @@ var (
 		},
 		[]string{"action"},
 	)
+	beaconNodeFailoverCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_failover_count",
+			Help:      "Number of times the validator client switched from one beacon node to another",
+		},
+		[]string{"from", "to"},
+	)
+	beaconNodeHealthy = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_healthy",
+			Help:      "Set to 1 if the beacon node was healthy and synced at the last health check, 0 otherwise",
+		},
+		[]string{"host"},
+	)
+	currentBeaconNode = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "current_beacon_node",
+			Help:      "Set to 1 for the beacon node that requests are currently sent to, 0 otherwise",
+		},
+		[]string{"host"},
+	)
+	beaconNodeBroadcastCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_broadcast_count",
+			Help:      "Number of signed duties broadcast to beacon nodes other than the current one, by result",
+		},
+		[]string{"host", "endpoint", "result"},
+	)
 )
@@ -5,10 +5,13 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -19,7 +22,9 @@ func (c beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *et
 	var beaconBlockRoot [32]byte
 
 	var err error
-	var marshalledSignedBeaconBlockJson []byte
+	// The JSON encoding is only computed if the block is not published as SSZ.
+	var marshalSignedBeaconBlockJson func() ([]byte, error)
+	var signedBeaconBlockSSZ ssz.Marshaler
 	blinded := false
 
 	switch blockType := in.Block.(type) {
@@ -30,9 +35,10 @@ func (c beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *et
 			return nil, errors.Wrap(err, "failed to compute block root for phase0 beacon block")
 		}
 
-		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockPhase0(blockType.Phase0)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshall phase0 beacon block")
+		signedBeaconBlockSSZ = blockType.Phase0
+		marshalSignedBeaconBlockJson = func() ([]byte, error) {
+			b, err := marshallBeaconBlockPhase0(blockType.Phase0)
+			return b, errors.Wrap(err, "failed to marshall phase0 beacon block")
 		}
 	case *ethpb.GenericSignedBeaconBlock_Altair:
 		consensusVersion = "altair"
@@ -41,9 +47,10 @@ func (c beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *et
 			return nil, errors.Wrap(err, "failed to compute block root for altair beacon block")
 		}
 
-		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockAltair(blockType.Altair)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshall altair beacon block")
+		signedBeaconBlockSSZ = blockType.Altair
+		marshalSignedBeaconBlockJson = func() ([]byte, error) {
+			b, err := marshallBeaconBlockAltair(blockType.Altair)
+			return b, errors.Wrap(err, "failed to marshall altair beacon block")
 		}
 	case *ethpb.GenericSignedBeaconBlock_Bellatrix:
 		consensusVersion = "bellatrix"
@@ -52,9 +59,10 @@ func (c beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *et
 			return nil, errors.Wrap(err, "failed to compute block root for bellatrix beacon block")
 		}
 
-		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockBellatrix(blockType.Bellatrix)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshall bellatrix beacon block")
+		signedBeaconBlockSSZ = blockType.Bellatrix
+		marshalSignedBeaconBlockJson = func() ([]byte, error) {
+			b, err := marshallBeaconBlockBellatrix(blockType.Bellatrix)
+			return b, errors.Wrap(err, "failed to marshall bellatrix beacon block")
 		}
 	case *ethpb.GenericSignedBeaconBlock_BlindedBellatrix:
 		blinded = true
@@ -64,9 +72,10 @@ func (c beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *et
 			return nil, errors.Wrap(err, "failed to compute block root for blinded bellatrix beacon block")
 		}
 
-		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockBlindedBellatrix(blockType.BlindedBellatrix)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshall blinded bellatrix beacon block")
+		signedBeaconBlockSSZ = blockType.BlindedBellatrix
+		marshalSignedBeaconBlockJson = func() ([]byte, error) {
+			b, err := marshallBeaconBlockBlindedBellatrix(blockType.BlindedBellatrix)
+			return b, errors.Wrap(err, "failed to marshall blinded bellatrix beacon block")
 		}
 	case *ethpb.GenericSignedBeaconBlock_Capella:
 		consensusVersion = "capella"
@@ -75,9 +84,10 @@ func (c beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *et
 			return nil, errors.Wrap(err, "failed to compute block root for capella beacon block")
 		}
 
-		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockCapella(blockType.Capella)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshall capella beacon block")
+		signedBeaconBlockSSZ = blockType.Capella
+		marshalSignedBeaconBlockJson = func() ([]byte, error) {
+			b, err := marshallBeaconBlockCapella(blockType.Capella)
+			return b, errors.Wrap(err, "failed to marshall capella beacon block")
 		}
 	case *ethpb.GenericSignedBeaconBlock_BlindedCapella:
 		blinded = true
@@ -87,9 +97,10 @@ func (c beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *et
 			return nil, errors.Wrap(err, "failed to compute block root for blinded capella beacon block")
 		}
 
-		marshalledSignedBeaconBlockJson, err = marshallBeaconBlockBlindedCapella(blockType.BlindedCapella)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshall blinded capella beacon block")
+		signedBeaconBlockSSZ = blockType.BlindedCapella
+		marshalSignedBeaconBlockJson = func() ([]byte, error) {
+			b, err := marshallBeaconBlockBlindedCapella(blockType.BlindedCapella)
+			return b, errors.Wrap(err, "failed to marshall blinded capella beacon block")
 		}
 	case *ethpb.GenericSignedBeaconBlock_Deneb:
 		consensusVersion = "deneb"
@@ -97,13 +108,15 @@ func (c beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *et
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to compute block root for deneb beacon block")
 		}
-		signedBlock, err := structs.SignedBeaconBlockContentsDenebFromConsensus(blockType.Deneb)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert deneb beacon block contents")
-		}
-		marshalledSignedBeaconBlockJson, err = json.Marshal(signedBlock)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshal deneb beacon block contents")
+
+		signedBeaconBlockSSZ = blockType.Deneb
+		marshalSignedBeaconBlockJson = func() ([]byte, error) {
+			signedBlock, err := structs.SignedBeaconBlockContentsDenebFromConsensus(blockType.Deneb)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to convert deneb beacon block contents")
+			}
+			b, err := json.Marshal(signedBlock)
+			return b, errors.Wrap(err, "failed to marshal deneb beacon block contents")
 		}
 	case *ethpb.GenericSignedBeaconBlock_BlindedDeneb:
 		blinded = true
@@ -112,13 +125,15 @@ func (c beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *et
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to compute block root for blinded deneb beacon block")
 		}
-		signedBlock, err := structs.SignedBlindedBeaconBlockDenebFromConsensus(blockType.BlindedDeneb)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert blinded deneb beacon block contents")
-		}
-		marshalledSignedBeaconBlockJson, err = json.Marshal(signedBlock)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to marshal blinded deneb beacon block contents")
+
+		signedBeaconBlockSSZ = blockType.BlindedDeneb
+		marshalSignedBeaconBlockJson = func() ([]byte, error) {
+			signedBlock, err := structs.SignedBlindedBeaconBlockDenebFromConsensus(blockType.BlindedDeneb)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to convert blinded deneb beacon block contents")
+			}
+			b, err := json.Marshal(signedBlock)
+			return b, errors.Wrap(err, "failed to marshal blinded deneb beacon block contents")
 		}
 	default:
 		return nil, errors.Errorf("unsupported block type %T", in.Block)
@@ -133,7 +148,18 @@ func (c beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *et
 	}
 
 	headers := map[string]string{"Eth-Consensus-Version": consensusVersion}
-	err = c.jsonRestHandler.Post(ctx, endpoint, headers, bytes.NewBuffer(marshalledSignedBeaconBlockJson), nil)
+	sent := false
+	if features.Get().EnableBeaconRESTApiSSZ && !sszUnsupportedByNode(c.jsonRestHandler.Host(), endpoint) {
+		sent, err = c.postBeaconBlockSSZ(ctx, endpoint, headers, signedBeaconBlockSSZ)
+	}
+	if !sent {
+		var marshalledSignedBeaconBlockJson []byte
+		marshalledSignedBeaconBlockJson, err = marshalSignedBeaconBlockJson()
+		if err != nil {
+			return nil, err
+		}
+		err = c.jsonRestHandler.Post(ctx, endpoint, headers, bytes.NewBuffer(marshalledSignedBeaconBlockJson), nil)
+	}
 	errJson := &httputil.DefaultJsonError{}
 	if err != nil {
 		if !errors.As(err, &errJson) {
@@ -149,6 +175,40 @@ func (c beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *et
 	return &ethpb.ProposeResponse{BlockRoot: beaconBlockRoot[:]}, nil
 }
 
+// sszUnsupportedEndpoints records, per beacon node host and endpoint, the endpoints that rejected an SSZ request
+// body, so that they are only sent JSON afterwards.
+var sszUnsupportedEndpoints sync.Map
+
+func sszUnsupportedByNode(host, endpoint string) bool {
+	_, ok := sszUnsupportedEndpoints.Load(host + endpoint)
+	return ok
+}
+
+// postBeaconBlockSSZ publishes the SSZ encoded block. It returns false if the block still has to be published as
+// JSON, either because it could not be encoded or because the beacon node does not accept SSZ for the endpoint.
+// Otherwise the error is the result of publishing the block.
+func (c beaconApiValidatorClient) postBeaconBlockSSZ(
+	ctx context.Context,
+	endpoint string,
+	headers map[string]string,
+	block ssz.Marshaler,
+) (bool, error) {
+	body, err := block.MarshalSSZ()
+	if err != nil {
+		log.WithError(err).Debug("Could not encode block as SSZ, publishing it as JSON")
+		return false, nil
+	}
+	host := c.jsonRestHandler.Host()
+	_, _, err = c.jsonRestHandler.PostSSZ(ctx, endpoint, headers, bytes.NewBuffer(body))
+	errJson := &httputil.DefaultJsonError{}
+	if err != nil && errors.As(err, &errJson) && errJson.Code == http.StatusUnsupportedMediaType {
+		log.WithField("endpoint", endpoint).Debug("Beacon node does not accept SSZ encoded blocks, falling back to JSON")
+		sszUnsupportedEndpoints.Store(host+endpoint, true)
+		return false, nil
+	}
+	return true, err
+}
+
 func marshallBeaconBlockPhase0(block *ethpb.SignedBeaconBlock) ([]byte, error) {
 	signedBeaconBlockJson := &structs.SignedBeaconBlock{
 		Signature: hexutil.Encode(block.Signature),
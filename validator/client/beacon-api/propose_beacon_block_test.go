@@ -1,14 +1,18 @@
 package beacon_api
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"net/http"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api/mock"
 	"go.uber.org/mock/gomock"
 )
@@ -123,3 +127,67 @@ func TestProposeBeaconBlock_UnsupportedBlockType(t *testing.T) {
 	_, err := validatorClient.proposeBeaconBlock(context.Background(), &ethpb.GenericSignedBeaconBlock{})
 	assert.ErrorContains(t, "unsupported block type", err)
 }
+
+func TestProposeBeaconBlock_SSZ(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableBeaconRESTApiSSZ: true})
+	defer resetCfg()
+
+	ctx := context.Background()
+	block := util.NewBeaconBlockCapella()
+	genericSignedBlock := &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_Capella{Capella: block}}
+	sszBytes, err := block.MarshalSSZ()
+	require.NoError(t, err)
+	expectedBlockRoot, err := block.Block.HashTreeRoot()
+	require.NoError(t, err)
+	headers := map[string]string{"Eth-Consensus-Version": "capella"}
+
+	t.Run("ssz accepted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+		jsonRestHandler.EXPECT().Host().Return("http://ssz-node").AnyTimes()
+		jsonRestHandler.EXPECT().PostSSZ(ctx, "/eth/v1/beacon/blocks", headers, bytes.NewBuffer(sszBytes)).Return(nil, nil, nil).Times(1)
+
+		validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+		proposeResponse, err := validatorClient.proposeBeaconBlock(ctx, genericSignedBlock)
+		require.NoError(t, err)
+		assert.DeepEqual(t, expectedBlockRoot[:], proposeResponse.BlockRoot)
+	})
+	t.Run("ssz rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+		jsonRestHandler.EXPECT().Host().Return("http://ssz-node").AnyTimes()
+		jsonRestHandler.EXPECT().PostSSZ(ctx, "/eth/v1/beacon/blocks", headers, gomock.Any()).Return(
+			nil, nil, &httputil.DefaultJsonError{Code: http.StatusBadRequest, Message: "invalid block"},
+		).Times(1)
+
+		validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+		_, err := validatorClient.proposeBeaconBlock(ctx, genericSignedBlock)
+		assert.ErrorContains(t, "invalid block", err)
+	})
+	t.Run("ssz not supported, falls back to json", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		jsonBytes, err := marshallBeaconBlockCapella(block)
+		require.NoError(t, err)
+
+		jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+		jsonRestHandler.EXPECT().Host().Return("http://json-node").AnyTimes()
+		// SSZ is only attempted once, the endpoint is sent JSON from then on.
+		jsonRestHandler.EXPECT().PostSSZ(ctx, "/eth/v1/beacon/blocks", headers, gomock.Any()).Return(
+			nil, nil, &httputil.DefaultJsonError{Code: http.StatusUnsupportedMediaType},
+		).Times(1)
+		jsonRestHandler.EXPECT().Post(ctx, "/eth/v1/beacon/blocks", headers, bytes.NewBuffer(jsonBytes), nil).Return(nil).Times(2)
+
+		validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+		for i := 0; i < 2; i++ {
+			proposeResponse, err := validatorClient.proposeBeaconBlock(ctx, genericSignedBlock)
+			require.NoError(t, err)
+			assert.DeepEqual(t, expectedBlockRoot[:], proposeResponse.BlockRoot)
+		}
+	})
+}
@@ -428,18 +428,18 @@ func signVoluntaryExit(
 
 // GetGraffiti gets the graffiti from cli or file for the validator public key.
 func (v *validator) GetGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
-	if v.proposerSettings != nil {
+	if ps := v.ProposerSettings(); ps != nil {
 		// Check proposer settings for specific key first
-		if v.proposerSettings.ProposeConfig != nil {
-			option, ok := v.proposerSettings.ProposeConfig[pubKey]
+		if ps.ProposeConfig != nil {
+			option, ok := ps.ProposeConfig[pubKey]
 			if ok && option.GraffitiConfig != nil {
 				return []byte(option.GraffitiConfig.Graffiti), nil
 			}
 		}
 		// Check proposer settings for default settings second
-		if v.proposerSettings.DefaultConfig != nil {
-			if v.proposerSettings.DefaultConfig.GraffitiConfig != nil {
-				return []byte(v.proposerSettings.DefaultConfig.GraffitiConfig.Graffiti), nil
+		if ps.DefaultConfig != nil {
+			if ps.DefaultConfig.GraffitiConfig != nil {
+				return []byte(ps.DefaultConfig.GraffitiConfig.Graffiti), nil
 			}
 		}
 	}
@@ -495,8 +495,8 @@ func (v *validator) SetGraffiti(ctx context.Context, pubkey [fieldparams.BLSPubk
 		return nil
 	}
 	settings := &proposer.Settings{}
-	if v.proposerSettings != nil {
-		settings = v.proposerSettings.Clone()
+	if ps := v.ProposerSettings(); ps != nil {
+		settings = ps.Clone()
 	}
 	if settings.ProposeConfig == nil {
 		settings.ProposeConfig = map[[48]byte]*proposer.Option{pubkey: {GraffitiConfig: &proposer.GraffitiConfig{Graffiti: string(graffiti)}}}
@@ -516,10 +516,11 @@ func (v *validator) SetGraffiti(ctx context.Context, pubkey [fieldparams.BLSPubk
 }
 
 func (v *validator) DeleteGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error {
-	if v.proposerSettings == nil || v.proposerSettings.ProposeConfig == nil {
+	settings := v.ProposerSettings()
+	if settings == nil || settings.ProposeConfig == nil {
 		return errors.New("attempted to delete graffiti without proposer settings, graffiti will default to flag options")
 	}
-	ps := v.proposerSettings.Clone()
+	ps := settings.Clone()
 	option, ok := ps.ProposeConfig[pubKey]
 	if !ok || option == nil {
 		return fmt.Errorf("graffiti not found in proposer settings for pubkey:%s", hexutil.Encode(pubKey[:]))
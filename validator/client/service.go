@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
 	beaconApi "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
@@ -32,7 +33,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
-	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -57,6 +57,7 @@ type ValidatorService struct {
 	emitAccountMetrics     bool
 	logValidatorBalances   bool
 	distributed            bool
+	beaconApiBroadcast     bool
 	interopKeysConfig      *local.InteropKeymanagerConfig
 	conn                   validatorHelpers.NodeConnection
 	grpcRetryDelay         time.Duration
@@ -103,6 +104,7 @@ type Config struct {
 	ProposerSettings           *proposer.Settings
 	BeaconApiEndpoint          string
 	BeaconApiTimeout           time.Duration
+	BeaconApiBroadcast         bool
 	ValidatorsRegBatchSize     int
 }
 
@@ -134,6 +136,7 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		proposerSettings:       cfg.ProposerSettings,
 		validatorsRegBatchSize: cfg.ValidatorsRegBatchSize,
 		distributed:            cfg.Distributed,
+		beaconApiBroadcast:     cfg.BeaconApiBroadcast,
 	}
 
 	dialOpts := ConstructDialOptions(
@@ -194,10 +197,12 @@ func (v *ValidatorService) Start() {
 		return
 	}
 
-	restHandler := beaconApi.NewBeaconApiJsonRestHandler(
+	restHandler := beaconApi.NewFailoverJsonRestHandler(
 		http.Client{Timeout: v.conn.GetBeaconApiTimeout()},
-		v.conn.GetBeaconApiUrl(),
+		beaconApi.ParseHosts(v.conn.GetBeaconApiUrl()),
+		v.beaconApiBroadcast,
 	)
+	go restHandler.MonitorHealth(v.ctx)
 
 	validatorClient := validatorClientFactory.NewValidatorClient(v.conn, restHandler)
 
@@ -268,8 +273,12 @@ func (v *ValidatorService) Keymanager() (keymanager.IKeymanager, error) {
 	return v.validator.Keymanager()
 }
 
-// ProposerSettings returns a deep copy of the underlying proposer settings in the validator
+// ProposerSettings returns a deep copy of the underlying proposer settings in the validator, or nil if the
+// validator is not running yet.
 func (v *ValidatorService) ProposerSettings() *proposer.Settings {
+	if v.validator == nil {
+		return nil
+	}
 	settings := v.validator.ProposerSettings()
 	if settings != nil {
 		return settings.Clone()
@@ -279,6 +288,9 @@ func (v *ValidatorService) ProposerSettings() *proposer.Settings {
 
 // SetProposerSettings sets the proposer settings on the validator service as well as the underlying validator
 func (v *ValidatorService) SetProposerSettings(ctx context.Context, settings *proposer.Settings) error {
+	if v.validator == nil {
+		return errors.New("validator is unavailable")
+	}
 	// validator service proposer settings is only used for pass through from node -> validator service -> validator.
 	// in memory use of proposer settings happens on validator.
 	v.proposerSettings = settings
@@ -322,14 +334,15 @@ func ConstructDialOptions(
 			grpcretry.WithMax(grpcRetries),
 			grpcretry.WithBackoff(grpcretry.BackoffLinear(grpcRetryDelay)),
 		),
-		grpc.WithStatsHandler(&ocgrpc.ClientHandler{}),
 		grpc.WithUnaryInterceptor(middleware.ChainUnaryClient(
+			tracing.UnaryClientInterceptor(),
 			grpcopentracing.UnaryClientInterceptor(),
 			grpcprometheus.UnaryClientInterceptor,
 			grpcretry.UnaryClientInterceptor(),
 			grpcutil.LogRequests,
 		)),
 		grpc.WithChainStreamInterceptor(
+			tracing.StreamClientInterceptor(),
 			grpcutil.LogStream,
 			grpcopentracing.StreamClientInterceptor(),
 			grpcprometheus.StreamClientInterceptor,
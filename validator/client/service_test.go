@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
 	"github.com/prysmaticlabs/prysm/v5/runtime"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -44,6 +45,12 @@ func TestStatus_NoConnectionError(t *testing.T) {
 	assert.ErrorContains(t, "no connection", validatorService.Status())
 }
 
+func TestProposerSettings_ValidatorUnavailable(t *testing.T) {
+	validatorService := &ValidatorService{}
+	assert.Equal(t, (*proposer.Settings)(nil), validatorService.ProposerSettings())
+	assert.ErrorContains(t, "validator is unavailable", validatorService.SetProposerSettings(context.Background(), &proposer.Settings{}))
+}
+
 func TestStart_GrpcHeaders(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ctx := context.Background()
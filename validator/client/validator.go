@@ -80,6 +80,7 @@ type validator struct {
 	prevBalanceLock                    sync.RWMutex
 	slashableKeysLock                  sync.RWMutex
 	attSelectionLock                   sync.Mutex
+	proposerSettingsLock               sync.RWMutex
 	eipImportBlacklistedPublicKeys     map[[fieldparams.BLSPubkeyLength]byte]bool
 	walletInitializedFeed              *event.Feed
 	submittedAtts                      map[submittedAttKey]*submittedAtt
@@ -1005,6 +1006,8 @@ func (v *validator) logDuties(slot primitives.Slot, currentEpochDuties []*ethpb.
 
 // ProposerSettings gets the current proposer settings saved in memory validator
 func (v *validator) ProposerSettings() *proposer.Settings {
+	v.proposerSettingsLock.RLock()
+	defer v.proposerSettingsLock.RUnlock()
 	return v.proposerSettings
 }
 
@@ -1013,6 +1016,8 @@ func (v *validator) SetProposerSettings(ctx context.Context, settings *proposer.
 	if v.db == nil {
 		return errors.New("db is not set")
 	}
+	v.proposerSettingsLock.Lock()
+	defer v.proposerSettingsLock.Unlock()
 	if err := v.db.SaveProposerSettings(ctx, settings); err != nil {
 		return err
 	}
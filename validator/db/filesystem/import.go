@@ -20,6 +20,12 @@ import (
 // by Ethereum validators and imports its data into Prysm's internal minimal representation of slashing
 // protection in the validator client's database.
 func (s *Store) ImportStandardProtectionJSON(ctx context.Context, r io.Reader) error {
+	return ImportMinimalStandardProtectionJSON(ctx, s, r)
+}
+
+// ImportMinimalStandardProtectionJSON imports an EIP-3076 compliant JSON file into a validator database
+// implementing the minimal slashing protection rules, such as the filesystem one.
+func ImportMinimalStandardProtectionJSON(ctx context.Context, validatorDB iface.ValidatorDB, r io.Reader) error {
 	// Read the JSON file
 	encodedJSON, err := io.ReadAll(r)
 	if err != nil {
@@ -38,7 +44,7 @@ func (s *Store) ImportStandardProtectionJSON(ctx context.Context, r io.Reader) e
 	}
 
 	// We validate the `MetadataV0` field of the slashing protection JSON file.
-	if err := helpers.ValidateMetadata(ctx, s, interchangeJSON); err != nil {
+	if err := helpers.ValidateMetadata(ctx, validatorDB, interchangeJSON); err != nil {
 		return errors.Wrap(err, "slashing protection JSON metadata was incorrect")
 	}
 
@@ -64,12 +70,12 @@ func (s *Store) ImportStandardProtectionJSON(ctx context.Context, r io.Reader) e
 		pubkey := ([fieldparams.BLSPubkeyLength]byte)(pubkeyBytes)
 
 		// Block proposals
-		if err := importBlockProposals(ctx, pubkey, item, s); err != nil {
+		if err := importBlockProposals(ctx, pubkey, item, validatorDB); err != nil {
 			return errors.Wrap(err, "could not import block proposals")
 		}
 
 		// Attestations
-		if err := importAttestations(ctx, pubkey, item, s); err != nil {
+		if err := importAttestations(ctx, pubkey, item, validatorDB); err != nil {
 			return errors.Wrap(err, "could not import attestations")
 		}
 	}
@@ -0,0 +1,208 @@
+package shared
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	"go.opencensus.io/trace"
+)
+
+const failedAttSharedProtectionErr = "attempted to make slashable attestation, rejected by shared slashing protection"
+
+// LowestSignedTargetEpoch returns the lowest signed target epoch for a public key, a boolean indicating if it exists and an error.
+func (s *Store) LowestSignedTargetEpoch(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (primitives.Epoch, bool, error) {
+	record, err := s.backend.Record(ctx, pubKey)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "could not get slashing protection record")
+	}
+
+	if record == nil || record.LastSignedAttestationTargetEpoch == nil {
+		return 0, false, nil
+	}
+
+	// Return the lowest (and unique) signed target epoch.
+	return primitives.Epoch(*record.LastSignedAttestationTargetEpoch), true, nil
+}
+
+// LowestSignedSourceEpoch returns the lowest signed source epoch for a public key, a boolean indicating if it exists and an error.
+func (s *Store) LowestSignedSourceEpoch(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (primitives.Epoch, bool, error) {
+	record, err := s.backend.Record(ctx, pubKey)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "could not get slashing protection record")
+	}
+
+	if record == nil {
+		return 0, false, nil
+	}
+
+	// Return the lowest (and unique) signed source epoch.
+	return primitives.Epoch(record.LastSignedAttestationSourceEpoch), true, nil
+}
+
+// AttestedPublicKeys returns the list of public keys in the shared backend which already attested.
+func (s *Store) AttestedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	pubkeys, err := s.backend.PublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get public keys")
+	}
+
+	attestedPublicKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(pubkeys))
+	for _, pubkey := range pubkeys {
+		record, err := s.backend.Record(ctx, pubkey)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get slashing protection record")
+		}
+
+		if record == nil || record.LastSignedAttestationTargetEpoch == nil {
+			continue
+		}
+
+		attestedPublicKeys = append(attestedPublicKeys, pubkey)
+	}
+
+	return attestedPublicKeys, nil
+}
+
+// SlashableAttestationCheck checks if an attestation is slashable by comparing it with the attesting
+// history for the given public key in the shared backend, following EIP-3076 minimal rules.
+// If it is not, it updates the shared backend.
+func (s *Store) SlashableAttestationCheck(
+	ctx context.Context,
+	indexedAtt ethpb.IndexedAtt,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	signingRoot32 [32]byte,
+	_ bool,
+	_ *prometheus.CounterVec,
+) error {
+	ctx, span := trace.StartSpan(ctx, "validator.postAttSignUpdate")
+	defer span.End()
+
+	if err := s.SaveAttestationForPubKey(ctx, pubKey, signingRoot32, indexedAtt); err != nil {
+		if strings.Contains(err.Error(), "could not sign attestation") {
+			return errors.Wrap(err, failedAttSharedProtectionErr)
+		}
+
+		return errors.Wrap(err, "could not save attestation history for validator public key")
+	}
+
+	return nil
+}
+
+// SaveAttestationForPubKey checks if the incoming attestation is valid regarding EIP-3076 minimal slashing protection.
+// If so, it updates the shared backend with the incoming source and target, and returns nil.
+// If not, it does not modify the shared backend and return an error.
+// The check and the update are done in a single backend transaction.
+func (s *Store) SaveAttestationForPubKey(
+	ctx context.Context,
+	pubkey [fieldparams.BLSPubkeyLength]byte,
+	_ [32]byte,
+	att ethpb.IndexedAtt,
+) error {
+	// If there is no attestation, return on error.
+	if att == nil || att.GetData() == nil || att.GetData().Source == nil || att.GetData().Target == nil {
+		return errors.New("incoming attestation does not contain source and/or target epoch")
+	}
+
+	incomingSourceEpochUInt64 := uint64(att.GetData().Source.Epoch)
+	incomingTargetEpochUInt64 := uint64(att.GetData().Target.Epoch)
+
+	return s.backend.Update(ctx, pubkey, func(record *Record) error {
+		// Based on EIP-3076 (minimal database), validator should refuse to sign any attestation
+		// with source epoch less than the recorded source epoch.
+		if incomingSourceEpochUInt64 < record.LastSignedAttestationSourceEpoch {
+			return errors.Errorf(
+				"could not sign attestation with source lower than recorded source epoch, %d < %d",
+				att.GetData().Source.Epoch,
+				record.LastSignedAttestationSourceEpoch,
+			)
+		}
+
+		// Based on EIP-3076 (minimal database), validator should refuse to sign any attestation
+		// with target epoch less than or equal to the recorded target epoch.
+		if record.LastSignedAttestationTargetEpoch != nil && incomingTargetEpochUInt64 <= *record.LastSignedAttestationTargetEpoch {
+			return errors.Errorf(
+				"could not sign attestation with target lower than or equal to recorded target epoch, %d <= %d",
+				att.GetData().Target.Epoch,
+				*record.LastSignedAttestationTargetEpoch,
+			)
+		}
+
+		record.LastSignedAttestationSourceEpoch = incomingSourceEpochUInt64
+		record.LastSignedAttestationTargetEpoch = &incomingTargetEpochUInt64
+		return nil
+	})
+}
+
+// SaveAttestationsForPubKey saves the attestation history for a public key WITHOUT checking if the incoming
+// attestations are valid regarding EIP-3076 minimal slashing protection.
+// Incoming sources and targets epochs are compared with recorded source and target epochs, and maximums are saved.
+func (s *Store) SaveAttestationsForPubKey(
+	ctx context.Context,
+	pubkey [fieldparams.BLSPubkeyLength]byte,
+	_ [][]byte,
+	atts []*ethpb.IndexedAttestation,
+) error {
+	// If there is no attestation, return early.
+	if len(atts) == 0 {
+		return nil
+	}
+
+	maxSourceEpochUInt64, maxTargetEpochUInt64 := uint64(0), uint64(0)
+	for _, att := range atts {
+		if att == nil || att.Data == nil || att.Data.Source == nil || att.Data.Target == nil {
+			return errors.New("incoming attestation does not contain source and/or target epoch")
+		}
+
+		if uint64(att.Data.Source.Epoch) > maxSourceEpochUInt64 {
+			maxSourceEpochUInt64 = uint64(att.Data.Source.Epoch)
+		}
+
+		if uint64(att.Data.Target.Epoch) > maxTargetEpochUInt64 {
+			maxTargetEpochUInt64 = uint64(att.Data.Target.Epoch)
+		}
+	}
+
+	return s.backend.Update(ctx, pubkey, func(record *Record) error {
+		// Compare the maximum incoming source and target epochs with what we have recorded.
+		if maxSourceEpochUInt64 > record.LastSignedAttestationSourceEpoch {
+			record.LastSignedAttestationSourceEpoch = maxSourceEpochUInt64
+		}
+
+		if record.LastSignedAttestationTargetEpoch == nil || maxTargetEpochUInt64 > *record.LastSignedAttestationTargetEpoch {
+			record.LastSignedAttestationTargetEpoch = &maxTargetEpochUInt64
+		}
+
+		return nil
+	})
+}
+
+// AttestationHistoryForPubKey returns the attestation history for a public key.
+func (s *Store) AttestationHistoryForPubKey(
+	ctx context.Context,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+) ([]*common.AttestationRecord, error) {
+	record, err := s.backend.Record(ctx, pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get slashing protection record")
+	}
+
+	// If there is no record or no target epoch, return an empty slice.
+	if record == nil || record.LastSignedAttestationTargetEpoch == nil {
+		return []*common.AttestationRecord{}, nil
+	}
+
+	// Return the (unique) attestation record.
+	return []*common.AttestationRecord{
+		{
+			PubKey: pubKey,
+			Source: primitives.Epoch(record.LastSignedAttestationSourceEpoch),
+			Target: primitives.Epoch(*record.LastSignedAttestationTargetEpoch),
+		},
+	}, nil
+}
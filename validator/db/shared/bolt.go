@@ -0,0 +1,109 @@
+package shared
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	bolt "go.etcd.io/bbolt"
+	"gopkg.in/yaml.v3"
+)
+
+// BoltBackendFileName is the name of the database file of the bolt backend.
+const BoltBackendFileName = "shared-slashing-protection.db"
+
+var slashingProtectionBucket = []byte("slashing-protection")
+
+// BoltBackend is a backend storing records in an embedded bolt database. Bolt locks its file for the
+// process that opened it, so the database can only be shared by stores living in the same process.
+// It is mostly useful for tests, and as a reference for backends using a database server.
+type BoltBackend struct {
+	db *bolt.DB
+}
+
+// Ensure the bolt backend implements the interface.
+var _ = Backend(&BoltBackend{})
+
+// NewBoltBackend opens, or creates if needed, a bolt backend in dirPath.
+func NewBoltBackend(dirPath string) (*BoltBackend, error) {
+	if err := file.MkdirAll(dirPath); err != nil {
+		return nil, errors.Wrapf(err, "could not create directory %s", dirPath)
+	}
+
+	db, err := bolt.Open(filepath.Join(dirPath, BoltBackendFileName), params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		Timeout: params.BeaconIoConfig().BoltTimeout,
+	})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(slashingProtectionBucket)
+		return err
+	}); err != nil {
+		return nil, errors.Wrap(err, "could not create bucket")
+	}
+
+	return &BoltBackend{db: db}, nil
+}
+
+// Close closes the underlying bolt database.
+func (b *BoltBackend) Close() error {
+	return b.db.Close()
+}
+
+// PublicKeys returns the public keys that have a record in the backend.
+func (b *BoltBackend) PublicKeys(_ context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	var publicKeys [][fieldparams.BLSPubkeyLength]byte
+	err := b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(slashingProtectionBucket).ForEach(func(k, _ []byte) error {
+			publicKeys = append(publicKeys, [fieldparams.BLSPubkeyLength]byte(k))
+			return nil
+		})
+	})
+	return publicKeys, err
+}
+
+// Record returns the record of a public key, or nil if there is none.
+func (b *BoltBackend) Record(_ context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (*Record, error) {
+	var record *Record
+	err := b.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(slashingProtectionBucket).Get(pubKey[:])
+		if enc == nil {
+			return nil
+		}
+		record = &Record{}
+		return yaml.Unmarshal(enc, record)
+	})
+	return record, err
+}
+
+// Update runs fn against the record of a public key in a bolt read-write transaction.
+// Bolt allows a single read-write transaction at a time, which serializes all updates.
+func (b *BoltBackend) Update(_ context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, fn func(*Record) error) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(slashingProtectionBucket)
+		record := &Record{}
+		if enc := bkt.Get(pubKey[:]); enc != nil {
+			if err := yaml.Unmarshal(enc, record); err != nil {
+				return errors.Wrap(err, "could not unmarshal slashing protection record")
+			}
+		}
+
+		if err := fn(record); err != nil {
+			return err
+		}
+
+		enc, err := yaml.Marshal(record)
+		if err != nil {
+			return errors.Wrap(err, "could not marshal slashing protection record")
+		}
+		return bkt.Put(pubKey[:], enc)
+	})
+}
@@ -0,0 +1,110 @@
+// Package shared defines a validator client database whose slashing protection data is stored in a
+// backend shared by several validator clients, such as the active and passive instances of an
+// active/passive setup. Slashing protection follows the EIP-3076 minimal rules, and every check is
+// run in a single backend transaction, so that two validator clients can never both sign
+// conflicting messages for the same public key.
+package shared
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
+	"github.com/sirupsen/logrus"
+)
+
+type (
+	// Record is the slashing protection record of a public key. It holds the same data as
+	// the files of the filesystem database.
+	Record = filesystem.ValidatorSlashingProtection
+
+	// Backend is a transactional store of slashing protection records, which may be shared
+	// by several validator clients.
+	Backend interface {
+		io.Closer
+
+		// PublicKeys returns the public keys that have a record in the backend.
+		PublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error)
+
+		// Record returns the record of a public key, or nil if there is none.
+		Record(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (*Record, error)
+
+		// Update reads the record of a public key, creating an empty one if needed, and passes it to fn.
+		// The record modified by fn is written back only if fn returns nil. No other update of the same
+		// public key, from this validator client or from another one, may happen between the read and
+		// the write.
+		Update(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, fn func(*Record) error) error
+	}
+
+	// Store is a validator client database keeping its slashing protection data in a shared backend.
+	// Everything else (genesis validators root, graffiti and proposer settings) belongs to a single
+	// validator client and is kept in a local filesystem database.
+	Store struct {
+		*filesystem.Store
+		backend Backend
+	}
+
+	// Config represents store's config object.
+	Config struct {
+		PubKeys [][fieldparams.BLSPubkeyLength]byte
+	}
+)
+
+// Ensure the shared store implements the interface.
+var _ = iface.ValidatorDB(&Store{})
+
+// Logging.
+var log = logrus.WithField("prefix", "db")
+
+// NewStore creates a new store, keeping local data under databaseParentPath and slashing protection data in backend.
+func NewStore(databaseParentPath string, backend Backend, config *Config) (*Store, error) {
+	local, err := filesystem.NewStore(databaseParentPath, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create local database")
+	}
+
+	s := &Store{
+		Store:   local,
+		backend: backend,
+	}
+
+	// Initialize the required public keys into the DB to ensure they're not empty.
+	if config != nil {
+		if err := s.UpdatePublicKeysBuckets(config.PubKeys); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// Close closes the connection to the shared backend.
+func (s *Store) Close() error {
+	return s.backend.Close()
+}
+
+// Backup creates a backup of the local database. Slashing protection data is not part of it, since it
+// lives in the shared backend, which should be backed up on its own.
+func (s *Store) Backup(ctx context.Context, outputDir string, permissionOverride bool) error {
+	log.Warn("Slashing protection data is stored in a shared database and is not included in the backup")
+	return s.Store.Backup(ctx, outputDir, permissionOverride)
+}
+
+// UpdatePublicKeysBuckets creates an empty record in the shared backend for each public key if needed.
+func (s *Store) UpdatePublicKeysBuckets(pubKeys [][fieldparams.BLSPubkeyLength]byte) error {
+	for _, pubKey := range pubKeys {
+		if err := s.backend.Update(context.Background(), pubKey, func(*Record) error { return nil }); err != nil {
+			return errors.Wrap(err, "could not create slashing protection record")
+		}
+	}
+
+	return nil
+}
+
+// ImportStandardProtectionJSON imports an EIP-3076 compliant JSON file into the shared backend.
+func (s *Store) ImportStandardProtectionJSON(ctx context.Context, r io.Reader) error {
+	return filesystem.ImportMinimalStandardProtectionJSON(ctx, s, r)
+}
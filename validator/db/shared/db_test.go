@@ -0,0 +1,173 @@
+package shared
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"sync"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+// postgresDSNEnv is the environment variable giving the data source name of a Postgres database to run the tests
+// of the SQL backend against. The tests drop and recreate the slashing protection table of the database.
+const postgresDSNEnv = "PRYSM_TEST_POSTGRES_DSN"
+
+// testBackends returns functions creating each backend the tests run against. The SQL backend is only tested if
+// a Postgres database is given by postgresDSNEnv.
+func testBackends(t *testing.T) map[string]func(t *testing.T) Backend {
+	backends := map[string]func(t *testing.T) Backend{
+		"bolt": func(t *testing.T) Backend {
+			backend, err := NewBoltBackend(t.TempDir())
+			require.NoError(t, err)
+			return backend
+		},
+	}
+	if dsn := os.Getenv(postgresDSNEnv); dsn != "" {
+		backends["postgres"] = func(t *testing.T) Backend {
+			db, err := sql.Open(PostgresDriverName, dsn)
+			require.NoError(t, err)
+			_, err = db.Exec("DROP TABLE IF EXISTS slashing_protection")
+			require.NoError(t, err)
+			backend, err := NewSQLBackend(context.Background(), db)
+			require.NoError(t, err)
+			return backend
+		}
+	} else {
+		t.Logf("%s is not set, skipping the SQL backend", postgresDSNEnv)
+	}
+	return backends
+}
+
+// setupStores returns n stores, each with its own local database, sharing the given backend.
+func setupStores(t *testing.T, n int, pubkeys [][fieldparams.BLSPubkeyLength]byte, backend Backend) []*Store {
+	t.Cleanup(func() {
+		require.NoError(t, backend.Close())
+	})
+
+	stores := make([]*Store, n)
+	for i := range stores {
+		var err error
+		stores[i], err = NewStore(t.TempDir(), backend, &Config{PubKeys: pubkeys})
+		require.NoError(t, err)
+	}
+	return stores
+}
+
+func TestStore_SharedBetweenValidatorClients(t *testing.T) {
+	for name, newBackend := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+			stores := setupStores(t, 2, [][fieldparams.BLSPubkeyLength]byte{pubKey}, newBackend(t))
+			active, passive := stores[0], stores[1]
+
+			blk := util.NewBeaconBlock()
+			blk.Block.Slot = 10
+			signedBlock, err := blocks.NewSignedBeaconBlock(blk)
+			require.NoError(t, err)
+			require.NoError(t, active.SlashableProposalCheck(ctx, pubKey, signedBlock, [32]byte{1}, false, nil))
+			require.ErrorContains(t, "could not sign proposal", passive.SlashableProposalCheck(ctx, pubKey, signedBlock, [32]byte{2}, false, nil))
+
+			att := &ethpb.IndexedAttestation{
+				Data: &ethpb.AttestationData{
+					Source: &ethpb.Checkpoint{Epoch: 1},
+					Target: &ethpb.Checkpoint{Epoch: 2},
+				},
+			}
+			require.NoError(t, active.SlashableAttestationCheck(ctx, att, pubKey, [32]byte{1}, false, nil))
+			require.ErrorContains(t, failedAttSharedProtectionErr, passive.SlashableAttestationCheck(ctx, att, pubKey, [32]byte{2}, false, nil))
+
+			target, exists, err := passive.LowestSignedTargetEpoch(ctx, pubKey)
+			require.NoError(t, err)
+			require.Equal(t, true, exists)
+			require.Equal(t, primitives.Epoch(2), target)
+		})
+	}
+}
+
+func TestStore_ConcurrentChecks(t *testing.T) {
+	const clients = 8
+	for name, newBackend := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+			stores := setupStores(t, clients, [][fieldparams.BLSPubkeyLength]byte{pubKey}, newBackend(t))
+
+			blk := util.NewBeaconBlock()
+			blk.Block.Slot = 10
+			signedBlock, err := blocks.NewSignedBeaconBlock(blk)
+			require.NoError(t, err)
+			att := &ethpb.IndexedAttestation{
+				Data: &ethpb.AttestationData{
+					Source: &ethpb.Checkpoint{Epoch: 1},
+					Target: &ethpb.Checkpoint{Epoch: 2},
+				},
+			}
+
+			var (
+				wg                          sync.WaitGroup
+				mu                          sync.Mutex
+				signedBlocks, signedAttests int
+			)
+			for i, store := range stores {
+				wg.Add(1)
+				go func(i int, store *Store) {
+					defer wg.Done()
+					blockErr := store.SlashableProposalCheck(ctx, pubKey, signedBlock, [32]byte{byte(i)}, false, nil)
+					attErr := store.SlashableAttestationCheck(ctx, att, pubKey, [32]byte{byte(i)}, false, nil)
+					mu.Lock()
+					defer mu.Unlock()
+					if blockErr == nil {
+						signedBlocks++
+					}
+					if attErr == nil {
+						signedAttests++
+					}
+				}(i, store)
+			}
+			wg.Wait()
+
+			require.Equal(t, 1, signedBlocks)
+			require.Equal(t, 1, signedAttests)
+		})
+	}
+}
+
+func TestStore_SaveAttestationsForPubKey(t *testing.T) {
+	for name, newBackend := range testBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+			store := setupStores(t, 1, nil, newBackend(t))[0]
+
+			atts := []*ethpb.IndexedAttestation{
+				{Data: &ethpb.AttestationData{Source: &ethpb.Checkpoint{Epoch: 3}, Target: &ethpb.Checkpoint{Epoch: 4}}},
+				{Data: &ethpb.AttestationData{Source: &ethpb.Checkpoint{Epoch: 1}, Target: &ethpb.Checkpoint{Epoch: 7}}},
+			}
+			require.NoError(t, store.SaveAttestationsForPubKey(ctx, pubKey, nil, atts))
+
+			// Recorded epochs never decrease.
+			atts = []*ethpb.IndexedAttestation{
+				{Data: &ethpb.AttestationData{Source: &ethpb.Checkpoint{Epoch: 2}, Target: &ethpb.Checkpoint{Epoch: 5}}},
+			}
+			require.NoError(t, store.SaveAttestationsForPubKey(ctx, pubKey, nil, atts))
+
+			history, err := store.AttestationHistoryForPubKey(ctx, pubKey)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(history))
+			require.Equal(t, primitives.Epoch(3), history[0].Source)
+			require.Equal(t, primitives.Epoch(7), history[0].Target)
+
+			proposed, err := store.ProposedPublicKeys(ctx)
+			require.NoError(t, err)
+			require.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}, proposed)
+		})
+	}
+}
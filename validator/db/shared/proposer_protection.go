@@ -0,0 +1,90 @@
+package shared
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+// ProposalHistoryForPubKey returns the proposal history for a given public key.
+func (s *Store) ProposalHistoryForPubKey(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) ([]*common.Proposal, error) {
+	record, err := s.backend.Record(ctx, publicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get slashing protection record")
+	}
+
+	// If there is no record or proposed block, return an empty slice.
+	if record == nil || record.LatestSignedBlockSlot == nil {
+		return []*common.Proposal{}, nil
+	}
+
+	// Return the (unique) proposal history.
+	return []*common.Proposal{
+		{
+			Slot: primitives.Slot(*record.LatestSignedBlockSlot),
+		},
+	}, nil
+}
+
+// SaveProposalHistoryForSlot checks if the incoming proposal is valid regarding EIP-3076 minimal slashing protection.
+// If so, it updates the shared backend with the incoming slot, and returns nil.
+// If not, it does not modify the shared backend and return an error.
+// The check and the update are done in a single backend transaction.
+func (s *Store) SaveProposalHistoryForSlot(
+	ctx context.Context,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	slot primitives.Slot,
+	_ []byte,
+) error {
+	return s.backend.Update(ctx, pubKey, func(record *Record) error {
+		slotUInt64 := uint64(slot)
+
+		// Based on EIP-3076 (minimal database), validator should refuse to sign any proposal
+		// with slot less than or equal to the latest signed block slot in the DB.
+		if record.LatestSignedBlockSlot != nil && slotUInt64 <= *record.LatestSignedBlockSlot {
+			return errors.Errorf(
+				"could not sign proposal with slot lower than or equal to recorded slot, %d <= %d",
+				slot,
+				*record.LatestSignedBlockSlot,
+			)
+		}
+
+		record.LatestSignedBlockSlot = &slotUInt64
+		return nil
+	})
+}
+
+// ProposedPublicKeys returns the list of public keys we have in the shared backend.
+// To be consistent with the complete, BoltDB implementation, pubkeys returned by
+// this function do not necessarily have proposed a block.
+func (s *Store) ProposedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	return s.backend.PublicKeys(ctx)
+}
+
+// SlashableProposalCheck checks if a block proposal is slashable by comparing it with the
+// block proposals history for the given public key in the shared backend, following EIP-3076 minimal rules.
+// If it is not, it updates the shared backend.
+func (s *Store) SlashableProposalCheck(
+	ctx context.Context,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	signedBlock interfaces.ReadOnlySignedBeaconBlock,
+	signingRoot [fieldparams.RootLength]byte,
+	_ bool,
+	_ *prometheus.CounterVec,
+) error {
+	if err := s.SaveProposalHistoryForSlot(ctx, pubKey, signedBlock.Block().Slot(), signingRoot[:]); err != nil {
+		if strings.Contains(err.Error(), "could not sign proposal") {
+			return errors.Wrapf(err, common.FailedBlockSignLocalErr)
+		}
+
+		return errors.Wrap(err, "failed to save updated proposal history")
+	}
+
+	return nil
+}
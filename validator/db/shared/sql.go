@@ -0,0 +1,206 @@
+package shared
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+)
+
+// PostgresDriverName is the database/sql driver name used to open the Postgres database of the SQL backend.
+// The driver is registered by github.com/lib/pq, which this package links in.
+const PostgresDriverName = "postgres"
+
+const (
+	// serializationFailureCode is the SQLSTATE of transactions aborted because they can't be serialized with
+	// concurrent transactions. Such transactions did not change anything and can be retried.
+	serializationFailureCode = "40001"
+	// maxUpdateAttempts is the maximum number of times an update is attempted when it can't be serialized.
+	maxUpdateAttempts = 5
+)
+
+// The schema mirrors the low watermarks table of Web3Signer's slashing protection database, which is all the
+// EIP-3076 minimal rules need. Epochs and slots are uint64, so they are stored as NUMERIC(20) instead of BIGINT.
+const (
+	createTableQuery = `CREATE TABLE IF NOT EXISTS slashing_protection (
+	public_key BYTEA PRIMARY KEY,
+	latest_signed_block_slot NUMERIC(20),
+	last_signed_attestation_source_epoch NUMERIC(20) NOT NULL DEFAULT 0,
+	last_signed_attestation_target_epoch NUMERIC(20)
+)`
+	insertRecordQuery = `INSERT INTO slashing_protection (public_key) VALUES ($1) ON CONFLICT DO NOTHING`
+	selectRecordQuery = `SELECT latest_signed_block_slot, last_signed_attestation_source_epoch, last_signed_attestation_target_epoch
+FROM slashing_protection WHERE public_key = $1`
+	updateRecordQuery = `UPDATE slashing_protection
+SET latest_signed_block_slot = $2, last_signed_attestation_source_epoch = $3, last_signed_attestation_target_epoch = $4
+WHERE public_key = $1`
+	selectPublicKeysQuery = `SELECT public_key FROM slashing_protection`
+)
+
+// SQLBackend is a backend storing records in a Postgres database, which can be shared by validator clients running
+// on different hosts. Updates run in serializable transactions, and lock the row of the public key until they commit.
+type SQLBackend struct {
+	db *sql.DB
+}
+
+// Ensure the SQL backend implements the interface.
+var _ = Backend(&SQLBackend{})
+
+// NewSQLBackend creates a backend from an opened database, creating its table if needed.
+func NewSQLBackend(ctx context.Context, db *sql.DB) (*SQLBackend, error) {
+	if _, err := db.ExecContext(ctx, createTableQuery); err != nil {
+		return nil, errors.Wrap(err, "could not create slashing protection table")
+	}
+
+	return &SQLBackend{db: db}, nil
+}
+
+// Close closes the underlying database.
+func (b *SQLBackend) Close() error {
+	return b.db.Close()
+}
+
+// PublicKeys returns the public keys that have a record in the backend.
+func (b *SQLBackend) PublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	rows, err := b.db.QueryContext(ctx, selectPublicKeysQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not query public keys")
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var publicKeys [][fieldparams.BLSPubkeyLength]byte
+	for rows.Next() {
+		var pubKey []byte
+		if err := rows.Scan(&pubKey); err != nil {
+			return nil, errors.Wrap(err, "could not scan public key")
+		}
+		if len(pubKey) != fieldparams.BLSPubkeyLength {
+			return nil, errors.Errorf("unexpected public key length %d", len(pubKey))
+		}
+		publicKeys = append(publicKeys, [fieldparams.BLSPubkeyLength]byte(pubKey))
+	}
+
+	return publicKeys, rows.Err()
+}
+
+// Record returns the record of a public key, or nil if there is none.
+func (b *SQLBackend) Record(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) (*Record, error) {
+	record, err := scanRecord(b.db.QueryRowContext(ctx, selectRecordQuery, pubKey[:]))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return record, err
+}
+
+// Update runs fn against the record of a public key in a serializable transaction. The row of the public key is
+// selected FOR UPDATE, so concurrent updates of the same public key from other validator clients wait for the
+// transaction to end. If the transaction can't be serialized, it is retried against the record committed by the
+// concurrent transaction, up to maxUpdateAttempts times. fn's result is only saved if the transaction commits.
+func (b *SQLBackend) Update(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, fn func(*Record) error) error {
+	var err error
+	for attempt := 1; attempt <= maxUpdateAttempts; attempt++ {
+		err = b.update(ctx, pubKey, fn)
+		if !isSerializationFailure(err) {
+			return err
+		}
+		log.WithError(err).WithField("attempt", attempt).Debug("Retrying slashing protection update")
+	}
+	return errors.Wrapf(err, "could not serialize slashing protection update after %d attempts", maxUpdateAttempts)
+}
+
+// update runs a single attempt of Update.
+func (b *SQLBackend) update(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, fn func(*Record) error) (err error) {
+	tx, err := b.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return errors.Wrap(err, "could not begin transaction")
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err = tx.ExecContext(ctx, insertRecordQuery, pubKey[:]); err != nil {
+		return errors.Wrap(err, "could not insert slashing protection record")
+	}
+
+	record, err := scanRecord(tx.QueryRowContext(ctx, selectRecordQuery+" FOR UPDATE", pubKey[:]))
+	if err != nil {
+		return err
+	}
+
+	if err = fn(record); err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(
+		ctx,
+		updateRecordQuery,
+		pubKey[:],
+		nullableUint64(record.LatestSignedBlockSlot),
+		strconv.FormatUint(record.LastSignedAttestationSourceEpoch, 10),
+		nullableUint64(record.LastSignedAttestationTargetEpoch),
+	); err != nil {
+		return errors.Wrap(err, "could not update slashing protection record")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "could not commit transaction")
+	}
+
+	return nil
+}
+
+// isSerializationFailure returns true if err is a Postgres serialization failure.
+func isSerializationFailure(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == serializationFailureCode
+}
+
+// scanRecord scans a row selected by selectRecordQuery.
+func scanRecord(row *sql.Row) (*Record, error) {
+	var slot, source, target sql.NullString
+	if err := row.Scan(&slot, &source, &target); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "could not scan slashing protection record")
+	}
+
+	record := &Record{}
+	var err error
+	if record.LatestSignedBlockSlot, err = parseNullableUint64(slot); err != nil {
+		return nil, errors.Wrap(err, "could not parse latest signed block slot")
+	}
+	if record.LastSignedAttestationSourceEpoch, err = strconv.ParseUint(source.String, 10, 64); err != nil {
+		return nil, errors.Wrap(err, "could not parse last signed attestation source epoch")
+	}
+	if record.LastSignedAttestationTargetEpoch, err = parseNullableUint64(target); err != nil {
+		return nil, errors.Wrap(err, "could not parse last signed attestation target epoch")
+	}
+
+	return record, nil
+}
+
+func parseNullableUint64(s sql.NullString) (*uint64, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	v, err := strconv.ParseUint(s.String, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func nullableUint64(v *uint64) sql.NullString {
+	if v == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: strconv.FormatUint(*v, 10), Valid: true}
+}
@@ -0,0 +1,153 @@
+package shared
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+const conflictingDriverName = "conflicting"
+
+func init() {
+	sql.Register(conflictingDriverName, &conflictingDriver{})
+}
+
+// conflictingDriver is a database/sql driver whose transactions fail to commit with a serialization failure
+// until commitFailures is exhausted. Queries return an empty record.
+type conflictingDriver struct {
+	sync.Mutex
+	commitFailures int
+	commits        int
+}
+
+func (d *conflictingDriver) Open(string) (driver.Conn, error) {
+	return &conflictingConn{driver: d}, nil
+}
+
+type conflictingConn struct {
+	driver *conflictingDriver
+}
+
+func (c *conflictingConn) Prepare(string) (driver.Stmt, error) { return conflictingStmt{}, nil }
+func (c *conflictingConn) Close() error                        { return nil }
+func (c *conflictingConn) Begin() (driver.Tx, error)           { return c, nil }
+func (c *conflictingConn) Rollback() error                     { return nil }
+
+// BeginTx accepts the serializable isolation level of the SQL backend.
+func (c *conflictingConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	return c, nil
+}
+
+func (c *conflictingConn) Commit() error {
+	c.driver.Lock()
+	defer c.driver.Unlock()
+	c.driver.commits++
+	if c.driver.commitFailures > 0 {
+		c.driver.commitFailures--
+		return &pq.Error{Code: serializationFailureCode}
+	}
+	return nil
+}
+
+type conflictingStmt struct{}
+
+func (conflictingStmt) Close() error { return nil }
+
+func (conflictingStmt) NumInput() int { return -1 }
+
+func (conflictingStmt) Exec([]driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(1), nil
+}
+
+func (conflictingStmt) Query([]driver.Value) (driver.Rows, error) { return &recordRows{}, nil }
+
+// recordRows returns a single empty record, as selected by selectRecordQuery.
+type recordRows struct {
+	done bool
+}
+
+func (*recordRows) Columns() []string {
+	return []string{"latest_signed_block_slot", "last_signed_attestation_source_epoch", "last_signed_attestation_target_epoch"}
+}
+
+func (*recordRows) Close() error { return nil }
+
+func (r *recordRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0], dest[1], dest[2] = nil, "0", nil
+	return nil
+}
+
+func TestSQLBackend_UpdateRetriesSerializationFailures(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+
+	tests := []struct {
+		name           string
+		commitFailures int
+		wantErr        string
+	}{
+		{name: "no failure"},
+		{name: "retried", commitFailures: maxUpdateAttempts - 1},
+		{name: "too many failures", commitFailures: maxUpdateAttempts, wantErr: "could not serialize slashing protection update"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := sql.Open(conflictingDriverName, "")
+			require.NoError(t, err)
+			d, ok := db.Driver().(*conflictingDriver)
+			require.Equal(t, true, ok)
+			d.commitFailures, d.commits = tt.commitFailures, 0
+			backend, err := NewSQLBackend(ctx, db)
+			require.NoError(t, err)
+
+			calls := 0
+			err = backend.Update(ctx, pubKey, func(*Record) error {
+				calls++
+				return nil
+			})
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				require.Equal(t, true, isSerializationFailure(err))
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, min(tt.commitFailures+1, maxUpdateAttempts), calls)
+			require.Equal(t, calls, d.commits)
+		})
+	}
+}
+
+func TestSQLBackend_UpdateDoesNotRetryOtherErrors(t *testing.T) {
+	db, err := sql.Open(conflictingDriverName, "")
+	require.NoError(t, err)
+	backend, err := NewSQLBackend(context.Background(), db)
+	require.NoError(t, err)
+
+	calls := 0
+	err = backend.Update(context.Background(), [fieldparams.BLSPubkeyLength]byte{1}, func(*Record) error {
+		calls++
+		return &pq.Error{Code: "40P01"}
+	})
+	require.Equal(t, true, err != nil)
+	require.Equal(t, 1, calls)
+}
+
+func TestIsSerializationFailure(t *testing.T) {
+	require.Equal(t, false, isSerializationFailure(nil))
+	require.Equal(t, false, isSerializationFailure(errors.New("foo")))
+	require.Equal(t, false, isSerializationFailure(&pq.Error{Code: "23505"}))
+	require.Equal(t, true, isSerializationFailure(&pq.Error{Code: serializationFailureCode}))
+	require.Equal(t, true, isSerializationFailure(errors.Wrap(&pq.Error{Code: serializationFailureCode}, "could not commit transaction")))
+}
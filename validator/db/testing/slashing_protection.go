@@ -0,0 +1,122 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
+)
+
+// SetupFunc instantiates a validator client database, for instance with SetupDB.
+type SetupFunc func(t testing.TB, pubkeys [][fieldparams.BLSPubkeyLength]byte) iface.ValidatorDB
+
+// RunSlashingProtectionConformanceTests checks that the database created by setup protects validators against
+// slashable proposals and attestations. Every database implementation must pass these tests, which only cover
+// the cases on which the complete and the minimal EIP-3076 rules agree.
+func RunSlashingProtectionConformanceTests(t *testing.T, setup SetupFunc) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+
+	proposalCheck := func(t *testing.T, db iface.ValidatorDB, slot primitives.Slot, signingRoot byte) error {
+		blk := util.NewBeaconBlock()
+		blk.Block.Slot = slot
+		signedBlock, err := blocks.NewSignedBeaconBlock(blk)
+		require.NoError(t, err)
+		return db.SlashableProposalCheck(ctx, pubKey, signedBlock, [fieldparams.RootLength]byte{signingRoot}, false, nil)
+	}
+
+	attestationCheck := func(db iface.ValidatorDB, source, target primitives.Epoch, signingRoot byte) error {
+		att := &ethpb.IndexedAttestation{
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: source},
+				Target: &ethpb.Checkpoint{Epoch: target},
+			},
+		}
+		return db.SlashableAttestationCheck(ctx, att, pubKey, [32]byte{signingRoot}, false, nil)
+	}
+
+	t.Run("proposals at increasing slots", func(t *testing.T) {
+		db := setup(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+		require.NoError(t, proposalCheck(t, db, 10, 1))
+		require.NoError(t, proposalCheck(t, db, 11, 2))
+		require.NoError(t, proposalCheck(t, db, 20, 3))
+	})
+
+	t.Run("double proposal", func(t *testing.T) {
+		db := setup(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+		require.NoError(t, proposalCheck(t, db, 10, 1))
+		require.NotNil(t, proposalCheck(t, db, 10, 2))
+	})
+
+	t.Run("proposal below the recorded slot", func(t *testing.T) {
+		db := setup(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+		require.NoError(t, proposalCheck(t, db, 10, 1))
+		require.NotNil(t, proposalCheck(t, db, 5, 2))
+	})
+
+	t.Run("rejected proposal is not recorded", func(t *testing.T) {
+		db := setup(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+		require.NoError(t, proposalCheck(t, db, 10, 1))
+		require.NotNil(t, proposalCheck(t, db, 5, 2))
+		require.NoError(t, proposalCheck(t, db, 11, 3))
+	})
+
+	t.Run("attestations at increasing epochs", func(t *testing.T) {
+		db := setup(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+		require.NoError(t, attestationCheck(db, 0, 1, 1))
+		require.NoError(t, attestationCheck(db, 1, 2, 2))
+		require.NoError(t, attestationCheck(db, 2, 4, 3))
+	})
+
+	t.Run("double vote", func(t *testing.T) {
+		db := setup(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+		require.NoError(t, attestationCheck(db, 0, 1, 1))
+		require.NotNil(t, attestationCheck(db, 0, 1, 2))
+	})
+
+	t.Run("surrounding vote", func(t *testing.T) {
+		db := setup(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+		require.NoError(t, attestationCheck(db, 2, 3, 1))
+		require.NotNil(t, attestationCheck(db, 1, 4, 2))
+	})
+
+	t.Run("surrounded vote", func(t *testing.T) {
+		db := setup(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+		require.NoError(t, attestationCheck(db, 1, 4, 1))
+		require.NotNil(t, attestationCheck(db, 2, 3, 2))
+	})
+
+	t.Run("rejected attestation is not recorded", func(t *testing.T) {
+		db := setup(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+		require.NoError(t, attestationCheck(db, 1, 4, 1))
+		require.NotNil(t, attestationCheck(db, 0, 10, 2))
+		require.NoError(t, attestationCheck(db, 2, 5, 3))
+	})
+
+	t.Run("history", func(t *testing.T) {
+		db := setup(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+		require.NoError(t, proposalCheck(t, db, 10, 1))
+		require.NoError(t, attestationCheck(db, 1, 4, 1))
+
+		proposals, err := db.ProposalHistoryForPubKey(ctx, pubKey)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(proposals))
+		require.Equal(t, primitives.Slot(10), proposals[0].Slot)
+
+		attestations, err := db.AttestationHistoryForPubKey(ctx, pubKey)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(attestations))
+		require.Equal(t, primitives.Epoch(1), attestations[0].Source)
+		require.Equal(t, primitives.Epoch(4), attestations[0].Target)
+
+		attested, err := db.AttestedPublicKeys(ctx)
+		require.NoError(t, err)
+		require.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}, attested)
+	})
+}
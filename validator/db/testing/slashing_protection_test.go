@@ -0,0 +1,65 @@
+package testing
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/shared"
+)
+
+// postgresDSNEnv is the environment variable giving the data source name of a Postgres database to run the
+// conformance tests of the shared SQL backend against. The tests drop and recreate its slashing protection table.
+const postgresDSNEnv = "PRYSM_TEST_POSTGRES_DSN"
+
+func TestSlashingProtectionConformance(t *testing.T) {
+	t.Run("complete", func(t *testing.T) {
+		RunSlashingProtectionConformanceTests(t, func(t testing.TB, pubkeys [][fieldparams.BLSPubkeyLength]byte) iface.ValidatorDB {
+			return SetupDB(t, pubkeys, false)
+		})
+	})
+
+	t.Run("minimal", func(t *testing.T) {
+		RunSlashingProtectionConformanceTests(t, func(t testing.TB, pubkeys [][fieldparams.BLSPubkeyLength]byte) iface.ValidatorDB {
+			return SetupDB(t, pubkeys, true)
+		})
+	})
+
+	t.Run("shared", func(t *testing.T) {
+		RunSlashingProtectionConformanceTests(t, func(t testing.TB, pubkeys [][fieldparams.BLSPubkeyLength]byte) iface.ValidatorDB {
+			backend, err := shared.NewBoltBackend(t.TempDir())
+			require.NoError(t, err)
+			db, err := shared.NewStore(t.TempDir(), backend, &shared.Config{PubKeys: pubkeys})
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				require.NoError(t, db.Close())
+			})
+			return db
+		})
+	})
+
+	t.Run("shared postgres", func(t *testing.T) {
+		dsn := os.Getenv(postgresDSNEnv)
+		if dsn == "" {
+			t.Skipf("%s is not set", postgresDSNEnv)
+		}
+		RunSlashingProtectionConformanceTests(t, func(t testing.TB, pubkeys [][fieldparams.BLSPubkeyLength]byte) iface.ValidatorDB {
+			sqlDB, err := sql.Open(shared.PostgresDriverName, dsn)
+			require.NoError(t, err)
+			_, err = sqlDB.Exec("DROP TABLE IF EXISTS slashing_protection")
+			require.NoError(t, err)
+			backend, err := shared.NewSQLBackend(context.Background(), sqlDB)
+			require.NoError(t, err)
+			db, err := shared.NewStore(t.TempDir(), backend, &shared.Config{PubKeys: pubkeys})
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				require.NoError(t, db.Close())
+			})
+			return db
+		})
+	})
+}
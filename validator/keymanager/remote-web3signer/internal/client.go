@@ -24,7 +24,8 @@ import (
 )
 
 const (
-	ethApiNamespace = "/api/v1/eth2/sign/"
+	ethApiNamespace  = "/api/v1/eth2/sign/"
+	keystoresApiPath = "/eth/v1/keystores"
 )
 
 type SignRequestJson []byte
@@ -34,10 +35,29 @@ type SignatureResponse struct {
 	Signature hexutil.Bytes `json:"signature"`
 }
 
+// ImportKeystoresRequest is the request body of the web3signer keymanager api for importing keystores.
+type ImportKeystoresRequest struct {
+	Keystores          []string `json:"keystores"`
+	Passwords          []string `json:"passwords"`
+	SlashingProtection string   `json:"slashing_protection,omitempty"`
+}
+
+// ImportKeystoresResponse is the response of the web3signer keymanager api for importing keystores.
+type ImportKeystoresResponse struct {
+	Data []*KeystoreStatus `json:"data"`
+}
+
+// KeystoreStatus is the status of a single keystore import, as returned by the web3signer keymanager api.
+type KeystoreStatus struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
 // HttpSignerClient defines the interface for interacting with a remote web3signer.
 type HttpSignerClient interface {
 	Sign(ctx context.Context, pubKey string, request SignRequestJson) (bls.Signature, error)
 	GetPublicKeys(ctx context.Context, url string) ([][48]byte, error)
+	ImportKeystores(ctx context.Context, request *ImportKeystoresRequest) ([]*KeystoreStatus, error)
 }
 
 // ApiClient a wrapper object around web3signer APIs. Please refer to the docs from Consensys' web3signer project.
@@ -112,6 +132,30 @@ func (client *ApiClient) GetPublicKeys(ctx context.Context, url string) ([][fiel
 	return decodedKeys, nil
 }
 
+// ImportKeystores is a wrapper method around the web3signer keymanager api for importing keystores.
+func (client *ApiClient) ImportKeystores(ctx context.Context, request *ImportKeystoresRequest) ([]*KeystoreStatus, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal import keystores request")
+	}
+	resp, err := client.doRequest(ctx, http.MethodPost, client.BaseURL.String()+keystoresApiPath, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		closeBody(resp.Body)
+		return nil, fmt.Errorf("could not import keystores into web3signer, Status: %v", resp.StatusCode)
+	}
+	var importResp ImportKeystoresResponse
+	if err := unmarshalResponse(resp.Body, &importResp); err != nil {
+		return nil, err
+	}
+	if len(importResp.Data) != len(request.Keystores) {
+		return nil, fmt.Errorf("web3signer returned %d statuses for %d keystores", len(importResp.Data), len(request.Keystores))
+	}
+	return importResp.Data, nil
+}
+
 // ReloadSignerKeys is a wrapper method around the web3signer reload api.
 func (client *ApiClient) ReloadSignerKeys(ctx context.Context) error {
 	const requestPath = "/reload"
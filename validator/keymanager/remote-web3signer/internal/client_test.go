@@ -173,6 +173,45 @@ func TestClient_GetPublicKeys_EncodingError(t *testing.T) {
 	assert.Nil(t, resp)
 }
 
+func TestClient_ImportKeystores_HappyPath(t *testing.T) {
+	j := `{"data":[{"status":"imported","message":""},{"status":"duplicate","message":"already imported"}]}`
+	r := io.NopCloser(bytes.NewReader([]byte(j)))
+	mock := &mockTransport{mockResponse: &http.Response{
+		StatusCode: 200,
+		Body:       r,
+	}}
+	u, err := url.Parse("example.com")
+	assert.NoError(t, err)
+	cl := internal.ApiClient{BaseURL: u, RestClient: &http.Client{Transport: mock}}
+	resp, err := cl.ImportKeystores(context.Background(), &internal.ImportKeystoresRequest{
+		Keystores: []string{"{}", "{}"},
+		Passwords: []string{"pass1", "pass2"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp))
+	assert.Equal(t, "imported", resp[0].Status)
+	assert.Equal(t, "duplicate", resp[1].Status)
+	assert.Equal(t, "already imported", resp[1].Message)
+}
+
+func TestClient_ImportKeystores_WrongNumberOfStatuses(t *testing.T) {
+	j := `{"data":[{"status":"imported","message":""}]}`
+	r := io.NopCloser(bytes.NewReader([]byte(j)))
+	mock := &mockTransport{mockResponse: &http.Response{
+		StatusCode: 200,
+		Body:       r,
+	}}
+	u, err := url.Parse("example.com")
+	assert.NoError(t, err)
+	cl := internal.ApiClient{BaseURL: u, RestClient: &http.Client{Transport: mock}}
+	resp, err := cl.ImportKeystores(context.Background(), &internal.ImportKeystoresRequest{
+		Keystores: []string{"{}", "{}"},
+		Passwords: []string{"pass1", "pass2"},
+	})
+	assert.ErrorContains(t, err, "web3signer returned 1 statuses for 2 keystores")
+	assert.Nil(t, resp)
+}
+
 // TODO: not really in use, should be revisited
 func TestClient_ReloadSignerKeys_HappyPath(t *testing.T) {
 	mock := &mockTransport{mockResponse: &http.Response{
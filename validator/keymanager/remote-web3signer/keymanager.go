@@ -3,9 +3,13 @@ package remote_web3signer
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/go-playground/validator/v10"
@@ -39,8 +43,15 @@ type SetupConfig struct {
 	// a static list of public keys to be passed by the user to determine what accounts should sign.
 	// This will provide a layer of safety against slashing if the web3signer is shared across validators.
 	ProvidedPublicKeys [][48]byte
+
+	// If set, the keymanager syncs its public keys from the web3signer's public keys api at this interval,
+	// instead of using the URL or the keylist.
+	PublicKeysSyncInterval time.Duration
 }
 
+// publicKeysApiPath is the path of the web3signer api that lists the public keys the web3signer can sign with.
+const publicKeysApiPath = "/api/v1/eth2/publicKeys"
+
 // Keymanager defines the web3signer keymanager.
 type Keymanager struct {
 	client                internal.HttpSignerClient
@@ -50,18 +61,25 @@ type Keymanager struct {
 	accountsChangedFeed   *event.Feed
 	validator             *validator.Validate
 	publicKeysUrlCalled   bool
+	baseEndpoint          string
+	syncInterval          time.Duration
+	publicKeysSynced      bool
+	lock                  sync.RWMutex
 }
 
 // NewKeymanager instantiates a new web3signer key manager.
-func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	if cfg.BaseEndpoint == "" || !bytesutil.IsValidRoot(cfg.GenesisValidatorsRoot) {
 		return nil, fmt.Errorf("invalid setup config, one or more configs are empty: BaseEndpoint: %v, GenesisValidatorsRoot: %#x", cfg.BaseEndpoint, cfg.GenesisValidatorsRoot)
 	}
+	if cfg.PublicKeysSyncInterval > 0 && (cfg.PublicKeysURL != "" || len(cfg.ProvidedPublicKeys) > 0) {
+		return nil, errors.New("invalid setup config, public keys can't be synced from web3signer when a public keys URL or keylist is set")
+	}
 	client, err := internal.NewApiClient(cfg.BaseEndpoint)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create apiClient")
 	}
-	return &Keymanager{
+	km := &Keymanager{
 		client:                internal.HttpSignerClient(client),
 		genesisValidatorsRoot: cfg.GenesisValidatorsRoot,
 		accountsChangedFeed:   new(event.Feed),
@@ -69,13 +87,31 @@ func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
 		providedPublicKeys:    cfg.ProvidedPublicKeys,
 		validator:             validator.New(),
 		publicKeysUrlCalled:   false,
-	}, nil
+		baseEndpoint:          strings.TrimSuffix(cfg.BaseEndpoint, "/"),
+		syncInterval:          cfg.PublicKeysSyncInterval,
+	}
+	if km.syncInterval > 0 {
+		go km.listenForPublicKeyChanges(ctx)
+	}
+	return km, nil
 }
 
 // FetchValidatingPublicKeys fetches the validating public keys
 // from the remote server or from the provided keys if there are no existing public keys set
 // or provides the existing keys in the keymanager.
 func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	if km.syncInterval > 0 {
+		km.lock.RLock()
+		synced := km.publicKeysSynced
+		km.lock.RUnlock()
+		if !synced {
+			if err := km.syncPublicKeys(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	km.lock.Lock()
+	defer km.lock.Unlock()
 	if km.publicKeysURL != "" && !km.publicKeysUrlCalled {
 		providedPublicKeys, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
 		if err != nil {
@@ -89,6 +125,60 @@ func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldpa
 	return km.providedPublicKeys, nil
 }
 
+// listenForPublicKeyChanges periodically syncs the public keys of the keymanager from the web3signer,
+// until the context is canceled.
+func (km *Keymanager) listenForPublicKeyChanges(ctx context.Context) {
+	ticker := time.NewTicker(km.syncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := km.syncPublicKeys(ctx); err != nil {
+				log.WithError(err).Error("Could not sync public keys from web3signer")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// syncPublicKeys replaces the public keys of the keymanager with the public keys the web3signer can sign with,
+// and notifies subscribers if they changed since the last sync.
+func (km *Keymanager) syncPublicKeys(ctx context.Context) error {
+	url := km.baseEndpoint + publicKeysApiPath
+	publicKeys, err := km.client.GetPublicKeys(ctx, url)
+	if err != nil {
+		erroredResponsesTotal.Inc()
+		return errors.Wrapf(err, "could not get public keys from web3signer: %v", url)
+	}
+	km.lock.Lock()
+	changed := km.publicKeysSynced && !equalPublicKeys(km.providedPublicKeys, publicKeys)
+	km.providedPublicKeys = publicKeys
+	km.publicKeysSynced = true
+	km.lock.Unlock()
+	if changed {
+		log.WithField("numKeys", len(publicKeys)).Info("Public keys changed in web3signer")
+		km.accountsChangedFeed.Send(publicKeys)
+	}
+	return nil
+}
+
+func equalPublicKeys(a, b [][fieldparams.BLSPubkeyLength]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	keys := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(a))
+	for _, key := range a {
+		keys[key] = true
+	}
+	for _, key := range b {
+		if !keys[key] {
+			return false
+		}
+	}
+	return true
+}
+
 // Sign signs the message by using a remote web3signer server.
 func (km *Keymanager) Sign(ctx context.Context, request *validatorpb.SignRequest) (bls.Signature, error) {
 	signRequest, err := getSignRequestJson(ctx, km.validator, request, km.genesisValidatorsRoot)
@@ -421,6 +511,7 @@ func DisplayRemotePublicKeys(validatingPubKeys [][48]byte) {
 
 // AddPublicKeys imports a list of public keys into the keymanager for web3signer use. Returns status with message.
 func (km *Keymanager) AddPublicKeys(pubKeys []string) []*keymanager.KeyStatus {
+	km.lock.Lock()
 	importedRemoteKeysStatuses := make([]*keymanager.KeyStatus, len(pubKeys))
 	for i, pubkey := range pubKeys {
 		found := false
@@ -459,14 +550,73 @@ func (km *Keymanager) AddPublicKeys(pubKeys []string) []*keymanager.KeyStatus {
 		}
 		log.Debug("Added pubkey to keymanager for web3signer", "pubkey", pubkey)
 	}
-	km.accountsChangedFeed.Send(km.providedPublicKeys)
+	publicKeys := km.providedPublicKeys
+	km.lock.Unlock()
+	km.accountsChangedFeed.Send(publicKeys)
 	return importedRemoteKeysStatuses
 }
 
+// ImportRemoteKeystores imports keystores into the web3signer through its keymanager api, along with the optional
+// slashing protection history, and adds the public keys of the imported keystores to the keymanager.
+func (km *Keymanager) ImportRemoteKeystores(
+	ctx context.Context, keystores []string, passwords []string, slashingProtection string,
+) ([]*keymanager.KeyStatus, error) {
+	if len(keystores) != len(passwords) {
+		return nil, fmt.Errorf("number of keystores (%d) does not match number of passwords (%d)", len(keystores), len(passwords))
+	}
+	importedStatuses, err := km.client.ImportKeystores(ctx, &internal.ImportKeystoresRequest{
+		Keystores:          keystores,
+		Passwords:          passwords,
+		SlashingProtection: slashingProtection,
+	})
+	if err != nil {
+		erroredResponsesTotal.Inc()
+		return nil, errors.Wrap(err, "could not import keystores into web3signer")
+	}
+	statuses := make([]*keymanager.KeyStatus, len(importedStatuses))
+	pubKeys := make([]string, 0, len(importedStatuses))
+	for i, status := range importedStatuses {
+		statuses[i] = &keymanager.KeyStatus{
+			Status:  keymanager.KeyStatusType(status.Status),
+			Message: status.Message,
+		}
+		if statuses[i].Status != keymanager.StatusImported && statuses[i].Status != keymanager.StatusDuplicate {
+			continue
+		}
+		pubKey, err := keystorePublicKey(keystores[i])
+		if err != nil {
+			log.WithError(err).Error("Could not read public key of keystore imported into web3signer")
+			continue
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	// Keys that are already known to the keymanager are reported as duplicates by AddPublicKeys, which is expected
+	// when the keys are synced from the web3signer.
+	if len(pubKeys) > 0 {
+		km.AddPublicKeys(pubKeys)
+	}
+	return statuses, nil
+}
+
+// keystorePublicKey returns the hex encoded public key of an EIP-2335 keystore.
+func keystorePublicKey(keystore string) (string, error) {
+	var ks keymanager.Keystore
+	if err := json.Unmarshal([]byte(keystore), &ks); err != nil {
+		return "", errors.Wrap(err, "could not decode keystore")
+	}
+	pubKey, err := hex.DecodeString(strings.TrimPrefix(ks.Pubkey, "0x"))
+	if err != nil {
+		return "", errors.Wrap(err, "could not decode keystore public key")
+	}
+	return hexutil.Encode(pubKey), nil
+}
+
 // DeletePublicKeys removes a list of public keys from the keymanager for web3signer use. Returns status with message.
 func (km *Keymanager) DeletePublicKeys(pubKeys []string) []*keymanager.KeyStatus {
+	km.lock.Lock()
 	deletedRemoteKeysStatuses := make([]*keymanager.KeyStatus, len(pubKeys))
 	if len(km.providedPublicKeys) == 0 {
+		km.lock.Unlock()
 		for i := range deletedRemoteKeysStatuses {
 			deletedRemoteKeysStatuses[i] = &keymanager.KeyStatus{
 				Status:  keymanager.StatusNotFound,
@@ -509,6 +659,8 @@ func (km *Keymanager) DeletePublicKeys(pubKeys []string) []*keymanager.KeyStatus
 			}
 		}
 	}
-	km.accountsChangedFeed.Send(km.providedPublicKeys)
+	publicKeys := km.providedPublicKeys
+	km.lock.Unlock()
+	km.accountsChangedFeed.Send(publicKeys)
 	return deletedRemoteKeysStatuses
 }
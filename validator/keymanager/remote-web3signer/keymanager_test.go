@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
@@ -21,6 +22,9 @@ import (
 type MockClient struct {
 	Signature       string
 	PublicKeys      []string
+	PublicKeysURL   string
+	ImportRequest   *internal.ImportKeystoresRequest
+	ImportStatuses  []*internal.KeystoreStatus
 	isThrowingError bool
 }
 
@@ -31,7 +35,8 @@ func (mc *MockClient) Sign(_ context.Context, _ string, _ internal.SignRequestJs
 	}
 	return bls.SignatureFromBytes(decoded)
 }
-func (mc *MockClient) GetPublicKeys(_ context.Context, _ string) ([][48]byte, error) {
+func (mc *MockClient) GetPublicKeys(_ context.Context, url string) ([][48]byte, error) {
+	mc.PublicKeysURL = url
 	var keys [][48]byte
 	for _, pk := range mc.PublicKeys {
 		decoded, err := hex.DecodeString(strings.TrimPrefix(pk, "0x"))
@@ -46,6 +51,14 @@ func (mc *MockClient) GetPublicKeys(_ context.Context, _ string) ([][48]byte, er
 	return keys, nil
 }
 
+func (mc *MockClient) ImportKeystores(_ context.Context, request *internal.ImportKeystoresRequest) ([]*internal.KeystoreStatus, error) {
+	if mc.isThrowingError {
+		return nil, fmt.Errorf("mock error")
+	}
+	mc.ImportRequest = request
+	return mc.ImportStatuses, nil
+}
+
 func TestKeymanager_Sign(t *testing.T) {
 	client := &MockClient{
 		Signature: "0xb3baa751d0a9132cfe93e4e3d5ff9075111100e3789dca219ade5a24d27e19d16b3353149da1833e9b691bb38634e8dc04469be7032132906c927d7e1a49b414730612877bc6b2810c8f202daf793d1ab0d6b5cb21d52f9e52e883859887a5d9",
@@ -331,3 +344,101 @@ func TestKeymanager_DeletePublicKeys(t *testing.T) {
 		require.Equal(t, keymanager.StatusNotFound, status.Status)
 	}
 }
+
+func TestNewKeymanager_SyncWithKeyList(t *testing.T) {
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	config := &SetupConfig{
+		BaseEndpoint:           "http://example.com",
+		GenesisValidatorsRoot:  root,
+		PublicKeysURL:          "http://example2.com/api/v1/eth2/publicKeys",
+		PublicKeysSyncInterval: time.Minute,
+	}
+	_, err = NewKeymanager(context.Background(), config)
+	require.ErrorContains(t, "public keys can't be synced from web3signer", err)
+}
+
+func TestKeymanager_FetchValidatingPublicKeys_SyncedFromWeb3Signer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	key1 := "0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"
+	key2 := "0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"
+	client := &MockClient{PublicKeys: []string{key1}}
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	config := &SetupConfig{
+		BaseEndpoint:           "http://example.com/",
+		GenesisValidatorsRoot:  root,
+		PublicKeysSyncInterval: time.Hour,
+	}
+	km, err := NewKeymanager(ctx, config)
+	require.NoError(t, err)
+	km.client = client
+
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "http://example.com/api/v1/eth2/publicKeys", client.PublicKeysURL)
+	require.Equal(t, 1, len(keys))
+	require.Equal(t, key1, hexutil.Encode(keys[0][:]))
+
+	keysChan := make(chan [][48]byte, 1)
+	sub := km.SubscribeAccountChanges(keysChan)
+	defer sub.Unsubscribe()
+	client.PublicKeys = []string{key1, key2}
+	require.NoError(t, km.syncPublicKeys(ctx))
+	changedKeys := <-keysChan
+	require.Equal(t, 2, len(changedKeys))
+
+	keys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(keys))
+	require.Equal(t, key2, hexutil.Encode(keys[1][:]))
+}
+
+func TestKeymanager_ImportRemoteKeystores(t *testing.T) {
+	ctx := context.Background()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	config := &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+	}
+	km, err := NewKeymanager(ctx, config)
+	require.NoError(t, err)
+	client := &MockClient{
+		ImportStatuses: []*internal.KeystoreStatus{
+			{Status: "imported"},
+			{Status: "error", Message: "invalid password"},
+		},
+	}
+	km.client = client
+
+	keystores := []string{
+		`{"pubkey":"a2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"}`,
+		`{"pubkey":"b89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"}`,
+	}
+	t.Run("ok", func(t *testing.T) {
+		statuses, err := km.ImportRemoteKeystores(ctx, keystores, []string{"pass1", "pass2"}, "{}")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(statuses))
+		require.Equal(t, keymanager.StatusImported, statuses[0].Status)
+		require.Equal(t, keymanager.StatusError, statuses[1].Status)
+		require.Equal(t, "invalid password", statuses[1].Message)
+		require.DeepEqual(t, keystores, client.ImportRequest.Keystores)
+		require.Equal(t, "{}", client.ImportRequest.SlashingProtection)
+
+		keys, err := km.FetchValidatingPublicKeys(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(keys))
+		require.Equal(t, "0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820", hexutil.Encode(keys[0][:]))
+	})
+	t.Run("mismatched passwords", func(t *testing.T) {
+		_, err := km.ImportRemoteKeystores(ctx, keystores, []string{"pass1"}, "")
+		require.ErrorContains(t, "does not match number of passwords", err)
+	})
+	t.Run("web3signer error", func(t *testing.T) {
+		client.isThrowingError = true
+		_, err := km.ImportRemoteKeystores(ctx, keystores, []string{"pass1", "pass2"}, "")
+		require.ErrorContains(t, "could not import keystores into web3signer", err)
+	})
+}
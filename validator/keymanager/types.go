@@ -64,6 +64,11 @@ type PublicKeyAdder interface {
 	AddPublicKeys(publicKeys []string) []*KeyStatus
 }
 
+// RemoteKeystoreImporter allows importing keystores into the remote signer used by the keymanager.
+type RemoteKeystoreImporter interface {
+	ImportRemoteKeystores(ctx context.Context, keystores []string, passwords []string, slashingProtection string) ([]*KeyStatus, error)
+}
+
 // KeyStatus is a json representation of the status fields for the keymanager apis
 type KeyStatus struct {
 	Status  KeyStatusType `json:"status"`
@@ -27,6 +27,7 @@ var (
 
 	_ = keymanager.PublicKeyAdder(&remoteweb3signer.Keymanager{})
 	_ = keymanager.PublicKeyDeleter(&remoteweb3signer.Keymanager{})
+	_ = keymanager.RemoteKeystoreImporter(&remoteweb3signer.Keymanager{})
 )
 
 func TestKeystoreContainsPath(t *testing.T) {
@@ -5,6 +5,7 @@ package node
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"net"
 	"net/http"
@@ -31,7 +32,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
-	"github.com/prysmaticlabs/prysm/v5/config/proposer"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer/loader"
 	"github.com/prysmaticlabs/prysm/v5/container/slice"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
@@ -50,6 +50,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/shared"
 	g "github.com/prysmaticlabs/prysm/v5/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
@@ -81,6 +82,7 @@ func NewValidatorClient(cliCtx *cli.Context) (*ValidatorClient, error) {
 		"validator", // service name
 		cliCtx.String(cmd.TracingProcessNameFlag.Name),
 		cliCtx.String(cmd.TracingEndpointFlag.Name),
+		cliCtx.String(cmd.TracingProtocolFlag.Name),
 		cliCtx.Float64(cmd.TraceSampleFractionFlag.Name),
 		cliCtx.Bool(cmd.EnableTracingFlag.Name),
 	); err != nil {
@@ -155,6 +157,7 @@ func newRouter(cliCtx *cli.Context) *mux.Router {
 		allowedOrigins = strings.Split(flags.GPRCGatewayCorsDomain.Value, ",")
 	}
 	r := mux.NewRouter()
+	r.Use(tracing2.HttpMiddleware)
 	r.Use(server.NormalizeQueryValuesHandler)
 	r.Use(server.CorsHandler(allowedOrigins))
 	return r
@@ -201,6 +204,9 @@ func (c *ValidatorClient) Close() {
 
 	c.services.StopAll()
 	log.Info("Stopping Prysm validator")
+	if err := tracing2.Shutdown(); err != nil {
+		log.WithError(err).Error("Could not shut down tracing")
+	}
 	c.cancel()
 	close(c.stop)
 }
@@ -440,7 +446,10 @@ func (c *ValidatorClient) initializeDB(cliCtx *cli.Context) error {
 
 	// Create / get the database.
 	var valDB iface.ValidatorDB
-	if useMinimalSlashingProtection {
+	if sharedDataSourceName := cliCtx.String(flags.SharedSlashingProtectionDBFlag.Name); sharedDataSourceName != "" {
+		log.WithField("databasePath", fileSystemDataDir).Info("Checking DB, using shared slashing protection database")
+		valDB, err = openSharedDB(cliCtx.Context, fileSystemDataDir, sharedDataSourceName)
+	} else if useMinimalSlashingProtection {
 		log.WithField("databasePath", fileSystemDataDir).Info("Checking DB")
 		valDB, err = filesystem.NewStore(fileSystemDataDir, nil)
 	} else {
@@ -523,7 +532,21 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		return err
 	}
 
-	ps, err := proposerSettings(c.cliCtx, c.db)
+	beaconApiBroadcast, err := BeaconApiBroadcast(c.cliCtx)
+	if err != nil {
+		return err
+	}
+
+	l, err := loader.NewProposerSettingsLoader(
+		c.cliCtx,
+		c.db,
+		loader.WithBuilderConfig(),
+		loader.WithGasLimit(),
+	)
+	if err != nil {
+		return err
+	}
+	ps, err := l.Load(c.cliCtx)
 	if err != nil {
 		return err
 	}
@@ -549,14 +572,35 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		ProposerSettings:           ps,
 		BeaconApiTimeout:           time.Second * 30,
 		BeaconApiEndpoint:          c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
+		BeaconApiBroadcast:         beaconApiBroadcast,
 		ValidatorsRegBatchSize:     c.cliCtx.Int(flags.ValidatorsRegistrationBatchSizeFlag.Name),
 		Distributed:                c.cliCtx.Bool(flags.EnableDistributed.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
 	}
+	if err := c.services.RegisterService(validatorService); err != nil {
+		return err
+	}
+
+	// Registered after the validator service, so that it starts once the validator holds the proposer settings.
+	if r := loader.NewReloader(c.cliCtx, l, validatorService); r != nil {
+		return c.services.RegisterService(r)
+	}
+	return nil
+}
 
-	return c.services.RegisterService(validatorService)
+// BeaconApiBroadcast returns whether signed duties are submitted to all beacon nodes. Broadcasting is only implemented
+// for the beacon REST API, so the flag is rejected when the validator client talks to a beacon node over gRPC.
+func BeaconApiBroadcast(cliCtx *cli.Context) (bool, error) {
+	if !cliCtx.Bool(flags.BroadcastToAllBeaconNodesFlag.Name) {
+		return false, nil
+	}
+	if !cliCtx.Bool(features.EnableBeaconRESTApi.Name) {
+		return false, fmt.Errorf("--%s requires --%s, broadcasting over gRPC is not supported",
+			flags.BroadcastToAllBeaconNodesFlag.Name, features.EnableBeaconRESTApi.Name)
+	}
+	return true, nil
 }
 
 func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error) {
@@ -603,23 +647,16 @@ func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error
 				web3signerConfig.ProvidedPublicKeys = validatorKeys
 			}
 		}
+		if cliCtx.IsSet(flags.Web3SignerKeysSyncIntervalFlag.Name) {
+			if cliCtx.IsSet(flags.Web3SignerPublicValidatorKeysFlag.Name) {
+				return nil, fmt.Errorf("--%s and --%s can't be used together", flags.Web3SignerKeysSyncIntervalFlag.Name, flags.Web3SignerPublicValidatorKeysFlag.Name)
+			}
+			web3signerConfig.PublicKeysSyncInterval = cliCtx.Duration(flags.Web3SignerKeysSyncIntervalFlag.Name)
+		}
 	}
 	return web3signerConfig, nil
 }
 
-func proposerSettings(cliCtx *cli.Context, db iface.ValidatorDB) (*proposer.Settings, error) {
-	l, err := loader.NewProposerSettingsLoader(
-		cliCtx,
-		db,
-		loader.WithBuilderConfig(),
-		loader.WithGasLimit(),
-	)
-	if err != nil {
-		return nil, err
-	}
-	return l.Load(cliCtx)
-}
-
 func (c *ValidatorClient) registerRPCService(router *mux.Router) error {
 	var vs *client.ValidatorService
 	if err := c.services.FetchService(&vs); err != nil {
@@ -784,6 +821,22 @@ func setWalletPasswordFilePath(cliCtx *cli.Context) error {
 	return nil
 }
 
+// openSharedDB opens a database keeping local data in dataDir and slashing protection data in the shared
+// Postgres database given by dataSourceName, a lib/pq connection string or URL.
+func openSharedDB(ctx context.Context, dataDir, dataSourceName string) (iface.ValidatorDB, error) {
+	sqlDB, err := sql.Open(shared.PostgresDriverName, dataSourceName)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open shared slashing protection database")
+	}
+
+	backend, err := shared.NewSQLBackend(ctx, sqlDB)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not connect to shared slashing protection database")
+	}
+
+	return shared.NewStore(dataDir, backend, nil)
+}
+
 func clearDB(ctx context.Context, dataDir string, force bool, isDatabaseMinimal bool) error {
 	var (
 		valDB iface.ValidatorDB
@@ -8,10 +8,12 @@ import (
 	"path"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
@@ -222,6 +224,7 @@ func TestWeb3SignerConfig(t *testing.T) {
 	type args struct {
 		baseURL          string
 		publicKeysOrURLs []string
+		keysSyncInterval string
 	}
 	tests := []struct {
 		name       string
@@ -259,6 +262,30 @@ func TestWeb3SignerConfig(t *testing.T) {
 				ProvidedPublicKeys:    nil,
 			},
 		},
+		{
+			name: "happy path with keys sync",
+			args: &args{
+				baseURL:          "http://localhost:8545",
+				keysSyncInterval: "1m",
+			},
+			want: &remoteweb3signer.SetupConfig{
+				BaseEndpoint:           "http://localhost:8545",
+				GenesisValidatorsRoot:  nil,
+				PublicKeysURL:          "",
+				ProvidedPublicKeys:     nil,
+				PublicKeysSyncInterval: time.Minute,
+			},
+		},
+		{
+			name: "keys sync with public keys",
+			args: &args{
+				baseURL:          "http://localhost:8545",
+				publicKeysOrURLs: []string{"http://localhost:8545/api/v1/eth2/publicKeys"},
+				keysSyncInterval: "1m",
+			},
+			want:       nil,
+			wantErrMsg: "--validators-external-signer-keys-sync-interval and --validators-external-signer-public-keys can't be used together",
+		},
 		{
 			name: "Bad base URL",
 			args: &args{
@@ -331,6 +358,10 @@ func TestWeb3SignerConfig(t *testing.T) {
 			for _, key := range tt.args.publicKeysOrURLs {
 				require.NoError(t, set.Set(flags.Web3SignerPublicValidatorKeysFlag.Name, key))
 			}
+			set.Duration(flags.Web3SignerKeysSyncIntervalFlag.Name, 0, "")
+			if tt.args.keysSyncInterval != "" {
+				require.NoError(t, set.Set(flags.Web3SignerKeysSyncIntervalFlag.Name, tt.args.keysSyncInterval))
+			}
 			cliCtx := cli.NewContext(&app, set, nil)
 			got, err := Web3SignerConfig(cliCtx)
 			if tt.wantErrMsg != "" {
@@ -341,3 +372,32 @@ func TestWeb3SignerConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestBeaconApiBroadcast(t *testing.T) {
+	tests := []struct {
+		name       string
+		broadcast  bool
+		restApi    bool
+		want       bool
+		wantErrMsg string
+	}{
+		{name: "disabled", restApi: true},
+		{name: "enabled with REST API", broadcast: true, restApi: true, want: true},
+		{name: "enabled with gRPC", broadcast: true, wantErrMsg: "--broadcast-to-all-beacon-nodes requires --enable-beacon-rest-api"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := cli.App{}
+			set := flag.NewFlagSet(tt.name, 0)
+			set.Bool(flags.BroadcastToAllBeaconNodesFlag.Name, tt.broadcast, "")
+			set.Bool(features.EnableBeaconRESTApi.Name, tt.restApi, "")
+			got, err := BeaconApiBroadcast(cli.NewContext(&app, set, nil))
+			if tt.wantErrMsg != "" {
+				require.ErrorContains(t, tt.wantErrMsg, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
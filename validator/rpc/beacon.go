@@ -54,7 +54,8 @@ func (s *Server) registerBeaconClient() error {
 		s.beaconApiTimeout,
 	)
 
-	restHandler := beaconApi.NewBeaconApiJsonRestHandler(http.Client{Timeout: s.beaconApiTimeout}, s.beaconApiEndpoint)
+	restHandler := beaconApi.NewFailoverJsonRestHandler(http.Client{Timeout: s.beaconApiTimeout}, beaconApi.ParseHosts(s.beaconApiEndpoint), false)
+	go restHandler.MonitorHealth(s.ctx)
 
 	s.beaconChainClient = beaconChainClientFactory.NewBeaconChainClient(conn, restHandler)
 	s.beaconNodeClient = nodeClientFactory.NewNodeClient(conn, restHandler)
@@ -3,6 +3,7 @@ package rpc
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -426,8 +427,9 @@ func (s *Server) ListRemoteKeys(w http.ResponseWriter, r *http.Request) {
 }
 
 // ImportRemoteKeys imports a list of public keys defined for web3signer keymanager type.
+// Keystores in the request are imported into the web3signer, and their statuses follow the statuses of the public keys.
 func (s *Server) ImportRemoteKeys(w http.ResponseWriter, r *http.Request) {
-	_, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.ImportRemoteKeys")
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.ImportRemoteKeys")
 	defer span.End()
 
 	if s.validatorService == nil {
@@ -459,10 +461,15 @@ func (s *Server) ImportRemoteKeys(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if len(req.Keystores) != len(req.Passwords) {
+		httputil.HandleError(w, fmt.Sprintf("Number of keystores (%d) does not match number of passwords (%d)", len(req.Keystores), len(req.Passwords)), http.StatusBadRequest)
+		return
+	}
+
 	adder, ok := km.(keymanager.PublicKeyAdder)
 	if !ok {
 		statuses := make([]*keymanager.KeyStatus, len(req.RemoteKeys))
-		for i := 0; i < len(req.RemoteKeys); i++ {
+		for i := 0; i < len(statuses); i++ {
 			statuses[i] = &keymanager.KeyStatus{
 				Status:  keymanager.StatusError,
 				Message: "Keymanager kind cannot import public keys for web3signer keymanager type.",
@@ -473,9 +480,13 @@ func (s *Server) ImportRemoteKeys(w http.ResponseWriter, r *http.Request) {
 	}
 
 	remoteKeys := make([]string, len(req.RemoteKeys))
+	remoteKeyIndices := make(map[string]int, len(req.RemoteKeys))
 	isUrlUsed := false
 	for i, obj := range req.RemoteKeys {
 		remoteKeys[i] = obj.Pubkey
+		if pubKey, err := hexutil.Decode(obj.Pubkey); err == nil {
+			remoteKeyIndices[hexutil.Encode(pubKey)] = i
+		}
 		if obj.Url != "" {
 			isUrlUsed = true
 		}
@@ -484,7 +495,64 @@ func (s *Server) ImportRemoteKeys(w http.ResponseWriter, r *http.Request) {
 		log.Warnf("Setting the remote signer base url within the request is not supported. The remote signer url can only be set from the --%s flag.", flags.Web3SignerURLFlag.Name)
 	}
 
-	httputil.WriteJson(w, &RemoteKeysResponse{Data: adder.AddPublicKeys(remoteKeys)})
+	// Every keystore has to belong to one of the remote keys of the request, whose status reports the import of the
+	// keystore. This keeps one status per requested remote key.
+	keystoreKeyIndices := make([]int, len(req.Keystores))
+	for i, ks := range req.Keystores {
+		k := &keymanager.Keystore{}
+		if err := json.Unmarshal([]byte(ks), k); err != nil {
+			httputil.HandleError(w, fmt.Sprintf("Could not decode keystore %d: %v", i, err), http.StatusBadRequest)
+			return
+		}
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(k.Pubkey, "0x"))
+		if err != nil {
+			httputil.HandleError(w, fmt.Sprintf("Could not decode public key of keystore %d: %v", i, err), http.StatusBadRequest)
+			return
+		}
+		j, ok := remoteKeyIndices[hexutil.Encode(pubKey)]
+		if !ok {
+			httputil.HandleError(w, fmt.Sprintf("Public key of keystore %d is not one of the remote keys of the request", i), http.StatusBadRequest)
+			return
+		}
+		keystoreKeyIndices[i] = j
+	}
+
+	statuses := make([]*keymanager.KeyStatus, len(remoteKeys))
+	// Keystores are imported first, so that the remote keys are not added when the request fails.
+	if len(req.Keystores) > 0 {
+		importer, ok := km.(keymanager.RemoteKeystoreImporter)
+		if !ok {
+			httputil.HandleError(w, "Keymanager kind cannot import keystores into web3signer.", http.StatusInternalServerError)
+			return
+		}
+		keystoreStatuses, err := importer.ImportRemoteKeystores(ctx, req.Keystores, req.Passwords, req.SlashingProtection)
+		if err != nil {
+			httputil.HandleError(w, "Could not import keystores into web3signer: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if len(keystoreStatuses) != len(req.Keystores) {
+			httputil.HandleError(w, fmt.Sprintf("Web3signer returned %d statuses for %d keystores", len(keystoreStatuses), len(req.Keystores)), http.StatusInternalServerError)
+			return
+		}
+		// The keymanager already adds the public keys of the imported keystores.
+		for i, status := range keystoreStatuses {
+			statuses[keystoreKeyIndices[i]] = status
+		}
+	}
+	var keysToAdd []string
+	var keysToAddIndices []int
+	for i, pubKey := range remoteKeys {
+		if statuses[i] == nil {
+			keysToAdd = append(keysToAdd, pubKey)
+			keysToAddIndices = append(keysToAddIndices, i)
+		}
+	}
+	if len(keysToAdd) > 0 {
+		for i, status := range adder.AddPublicKeys(keysToAdd) {
+			statuses[keysToAddIndices[i]] = status
+		}
+	}
+	httputil.WriteJson(w, &RemoteKeysResponse{Data: statuses})
 }
 
 // DeleteRemoteKeys deletes a list of public keys defined for web3signer keymanager type.
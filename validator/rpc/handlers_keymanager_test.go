@@ -1410,6 +1410,157 @@ func TestServer_ImportRemoteKeys(t *testing.T) {
 			require.Equal(t, fmt.Sprintf("%v", expectedStatuses[i].Status), strings.ToLower(string(resp.Data[i].Status)))
 		}
 	})
+	t.Run("mismatched keystores and passwords", func(t *testing.T) {
+		var body bytes.Buffer
+		b, err := json.Marshal(&ImportRemoteKeysRequest{Keystores: []string{"{}"}})
+		require.NoError(t, err)
+		body.Write(b)
+		req := httptest.NewRequest(http.MethodPost, "/eth/v1/remotekeys", &body)
+		w := httptest.NewRecorder()
+		w.Body = &bytes.Buffer{}
+		s.ImportRemoteKeys(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.StringContains(t, "Number of keystores (1) does not match number of passwords (0)", w.Body.String())
+	})
+}
+
+func TestServer_ImportRemoteKeys_Keystores(t *testing.T) {
+	ctx := context.Background()
+	pubkey := "0x93247f2209abcacf57b75a51dafae777f9dd38bc7053d1af526f220a7489a6d3a2753e5f3e8b1cfe39b56f43611df74a"
+	failedPubkey := "0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"
+	remotePubkey := "0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"
+	keystore := fmt.Sprintf(`{"pubkey":"%s"}`, strings.TrimPrefix(pubkey, "0x"))
+	failedKeystore := fmt.Sprintf(`{"pubkey":"%s"}`, failedPubkey)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/eth/v1/keystores", r.URL.Path)
+		req := make(map[string]interface{})
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.DeepEqual(t, []interface{}{keystore, failedKeystore}, req["keystores"])
+		require.DeepEqual(t, []interface{}{"password", "password"}, req["passwords"])
+		_, err := w.Write([]byte(`{"data":[{"status":"imported","message":""},{"status":"error","message":"invalid password"}]}`))
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	w := wallet.NewWalletForWeb3Signer()
+	root := make([]byte, fieldparams.RootLength)
+	root[0] = 1
+	config := &remoteweb3signer.SetupConfig{
+		BaseEndpoint:          srv.URL,
+		GenesisValidatorsRoot: root,
+	}
+	km, err := w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{ListenForChanges: false, Web3SignerConfig: config})
+	require.NoError(t, err)
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		Wallet: w,
+		Validator: &mock.Validator{
+			Km: km,
+		},
+		Web3SignerConfig: config,
+	})
+	require.NoError(t, err)
+	s := &Server{
+		walletInitialized: true,
+		wallet:            w,
+		validatorService:  vs,
+	}
+
+	t.Run("keystore without remote key", func(t *testing.T) {
+		var body bytes.Buffer
+		b, err := json.Marshal(&ImportRemoteKeysRequest{
+			RemoteKeys: []*RemoteKey{{Pubkey: remotePubkey}},
+			Keystores:  []string{keystore},
+			Passwords:  []string{"password"},
+		})
+		require.NoError(t, err)
+		body.Write(b)
+		req := httptest.NewRequest(http.MethodPost, "/eth/v1/remotekeys", &body)
+		rec := httptest.NewRecorder()
+		rec.Body = &bytes.Buffer{}
+		s.ImportRemoteKeys(rec, req)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.StringContains(t, "Public key of keystore 0 is not one of the remote keys of the request", rec.Body.String())
+	})
+	t.Run("one status per remote key", func(t *testing.T) {
+		var body bytes.Buffer
+		b, err := json.Marshal(&ImportRemoteKeysRequest{
+			RemoteKeys: []*RemoteKey{{Pubkey: remotePubkey}, {Pubkey: failedPubkey}, {Pubkey: pubkey}},
+			Keystores:  []string{keystore, failedKeystore},
+			Passwords:  []string{"password", "password"},
+		})
+		require.NoError(t, err)
+		body.Write(b)
+		req := httptest.NewRequest(http.MethodPost, "/eth/v1/remotekeys", &body)
+		rec := httptest.NewRecorder()
+		rec.Body = &bytes.Buffer{}
+		s.ImportRemoteKeys(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		resp := &RemoteKeysResponse{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), resp))
+		require.Equal(t, 3, len(resp.Data))
+		require.Equal(t, keymanager.StatusImported, resp.Data[0].Status)
+		require.Equal(t, keymanager.StatusError, resp.Data[1].Status)
+		require.Equal(t, "invalid password", resp.Data[1].Message)
+		require.Equal(t, keymanager.StatusImported, resp.Data[2].Status)
+
+		keys, err := km.FetchValidatingPublicKeys(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(keys))
+		require.Equal(t, pubkey, hexutil.Encode(keys[0][:]))
+		require.Equal(t, remotePubkey, hexutil.Encode(keys[1][:]))
+	})
+}
+
+func TestServer_ImportRemoteKeys_KeystoreImportFails(t *testing.T) {
+	ctx := context.Background()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	w := wallet.NewWalletForWeb3Signer()
+	root := make([]byte, fieldparams.RootLength)
+	root[0] = 1
+	config := &remoteweb3signer.SetupConfig{
+		BaseEndpoint:          srv.URL,
+		GenesisValidatorsRoot: root,
+	}
+	km, err := w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{ListenForChanges: false, Web3SignerConfig: config})
+	require.NoError(t, err)
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		Wallet: w,
+		Validator: &mock.Validator{
+			Km: km,
+		},
+		Web3SignerConfig: config,
+	})
+	require.NoError(t, err)
+	s := &Server{
+		walletInitialized: true,
+		wallet:            w,
+		validatorService:  vs,
+	}
+
+	pubkey := "0x93247f2209abcacf57b75a51dafae777f9dd38bc7053d1af526f220a7489a6d3a2753e5f3e8b1cfe39b56f43611df74a"
+	var body bytes.Buffer
+	b, err := json.Marshal(&ImportRemoteKeysRequest{
+		RemoteKeys: []*RemoteKey{{Pubkey: pubkey}},
+		Keystores:  []string{fmt.Sprintf(`{"pubkey":"%s"}`, strings.TrimPrefix(pubkey, "0x"))},
+		Passwords:  []string{"password"},
+	})
+	require.NoError(t, err)
+	body.Write(b)
+	req := httptest.NewRequest(http.MethodPost, "/eth/v1/remotekeys", &body)
+	rec := httptest.NewRecorder()
+	rec.Body = &bytes.Buffer{}
+	s.ImportRemoteKeys(rec, req)
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.StringContains(t, "Could not import keystores into web3signer", rec.Body.String())
+
+	// The remote keys of the failed request are not added.
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(keys))
 }
 
 func TestServer_DeleteRemoteKeys(t *testing.T) {
@@ -75,6 +75,10 @@ type RemoteKey struct {
 
 type ImportRemoteKeysRequest struct {
 	RemoteKeys []*RemoteKey `json:"remote_keys"`
+	// Keystores are imported into the web3signer itself, along with their passwords and slashing protection history.
+	Keystores          []string `json:"keystores,omitempty"`
+	Passwords          []string `json:"passwords,omitempty"`
+	SlashingProtection string   `json:"slashing_protection,omitempty"`
 }
 
 type DeleteRemoteKeysRequest struct {